@@ -0,0 +1,21 @@
+data "juju_access" "alice_development" {
+  user  = "alice"
+  model = juju_model.development.name
+
+  lifecycle {
+    postcondition {
+      condition     = self.access != "admin"
+      error_message = "alice must not administer the development model."
+    }
+  }
+}
+
+data "juju_access" "alice_database" {
+  user      = "alice"
+  offer_url = juju_offer.database.url
+}
+
+data "juju_access" "alice_cloud" {
+  user  = "alice"
+  cloud = "aws"
+}
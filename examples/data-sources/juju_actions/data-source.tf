@@ -0,0 +1,12 @@
+data "juju_actions" "postgresql" {
+  model            = juju_model.development.name
+  application_name = juju_application.postgresql.name
+}
+
+locals {
+  postgresql_actions = { for action in data.juju_actions.postgresql.actions : action.name => jsondecode(action.params) }
+}
+
+output "create_backup_params" {
+  value = keys(lookup(local.postgresql_actions["create-backup"], "properties", {}))
+}
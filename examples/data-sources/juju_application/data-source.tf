@@ -0,0 +1,8 @@
+data "juju_application" "postgresql" {
+  model = "database"
+  name  = "postgresql"
+}
+
+output "postgresql_leader_address" {
+  value = one([for unit in data.juju_application.postgresql.units : unit.private_address if unit.leader])
+}
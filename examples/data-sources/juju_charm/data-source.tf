@@ -0,0 +1,16 @@
+data "juju_charm" "postgresql" {
+  name    = "postgresql"
+  channel = "14/stable"
+  base    = "ubuntu@22.04"
+}
+
+resource "juju_application" "postgresql" {
+  model = juju_model.development.name
+
+  charm {
+    name     = data.juju_charm.postgresql.name
+    channel  = data.juju_charm.postgresql.channel
+    revision = data.juju_charm.postgresql.revision
+    base     = "ubuntu@22.04"
+  }
+}
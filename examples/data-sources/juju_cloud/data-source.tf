@@ -0,0 +1,14 @@
+data "juju_cloud" "aws" {
+  name = "aws"
+}
+
+resource "juju_model" "per_region" {
+  for_each = toset([for region in data.juju_cloud.aws.regions : region.name])
+
+  name = "development-${each.key}"
+
+  cloud {
+    name   = data.juju_cloud.aws.name
+    region = each.key
+  }
+}
@@ -0,0 +1,5 @@
+data "juju_clouds" "all" {}
+
+output "cloud_regions" {
+  value = { for cloud in data.juju_clouds.all.clouds : cloud.name => cloud.regions }
+}
@@ -0,0 +1,9 @@
+data "juju_controller" "this" {}
+
+output "controller_api_addresses" {
+  value = data.juju_controller.this.api_addresses
+}
+
+output "controller_is_juju_3" {
+  value = tonumber(split(".", data.juju_controller.this.version)[0]) >= 3
+}
@@ -0,0 +1,7 @@
+data "juju_credentials" "aws" {
+  cloud = "aws"
+}
+
+output "models_by_credential" {
+  value = { for credential in data.juju_credentials.aws.credentials : credential.name => credential.models }
+}
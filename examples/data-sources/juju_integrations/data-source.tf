@@ -0,0 +1,26 @@
+data "juju_integrations" "legacy" {
+  model = "legacy"
+}
+
+# Adopt the integrations of the model, with Terraform 1.7 or later.
+import {
+  for_each = { for integration in data.juju_integrations.legacy.integrations : integration.import_id => integration }
+
+  to = juju_integration.legacy[each.key]
+  id = each.value.import_id
+}
+
+resource "juju_integration" "legacy" {
+  for_each = { for integration in data.juju_integrations.legacy.integrations : integration.import_id => integration }
+
+  model = data.juju_integrations.legacy.model
+
+  dynamic "application" {
+    for_each = each.value.applications
+    content {
+      name      = application.value.offer_url == null ? application.value.name : null
+      endpoint  = application.value.offer_url == null ? application.value.endpoint : null
+      offer_url = application.value.offer_url
+    }
+  }
+}
@@ -2,3 +2,7 @@ data "juju_machine" "this" {
   model      = juju_model.development.name
   machine_id = "2"
 }
+
+output "machine_addresses" {
+  value = data.juju_machine.this.ip_addresses
+}
@@ -0,0 +1,8 @@
+data "juju_machines" "jammy" {
+  model = juju_model.development.name
+  base  = "ubuntu@22.04"
+}
+
+output "machine_hostnames" {
+  value = { for machine in data.juju_machines.jammy.machines : machine.machine_id => machine.hostname }
+}
@@ -1,3 +1,7 @@
 data "juju_model" "this" {
   name = "development"
 }
+
+data "juju_model" "by_uuid" {
+  uuid = "8ba36c8f-ec8e-4b2b-b4a5-1a2ff6d8e5e1"
+}
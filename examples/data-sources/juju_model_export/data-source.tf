@@ -0,0 +1,15 @@
+data "juju_model_export" "legacy" {
+  model = "legacy"
+}
+
+# Adopt the applications of the model, with Terraform 1.7 or later.
+import {
+  for_each = { for app in data.juju_model_export.legacy.applications : app.name => app }
+
+  to = juju_application.legacy[each.key]
+  id = each.value.import_id
+}
+
+output "integration_import_ids" {
+  value = data.juju_model_export.legacy.integrations[*].import_id
+}
@@ -0,0 +1,27 @@
+provider "juju" {
+  alias = "hub"
+
+  controller_addresses = "10.0.0.10:17070"
+}
+
+# The offer is read from the controller of the offer.
+data "juju_offer_consume_details" "database" {
+  provider = juju.hub
+
+  offer_url = "hub:admin/database.postgresql"
+}
+
+# The offer is consumed from a model of the default controller.
+resource "juju_integration" "database" {
+  model                 = juju_model.development.name
+  offer_consume_details = data.juju_offer_consume_details.database.consume_details
+
+  application {
+    name     = juju_application.app.name
+    endpoint = "database"
+  }
+
+  application {
+    offer_url = "hub:admin/database.postgresql"
+  }
+}
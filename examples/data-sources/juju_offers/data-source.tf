@@ -0,0 +1,18 @@
+data "juju_offers" "database" {
+  model = "admin/database"
+}
+
+resource "juju_integration" "database" {
+  for_each = { for offer in data.juju_offers.database.offers : offer.name => offer }
+
+  model = juju_model.development.name
+
+  application {
+    name     = juju_application.app.name
+    endpoint = "database"
+  }
+
+  application {
+    offer_url = each.value.url
+  }
+}
@@ -0,0 +1,16 @@
+data "juju_secret" "tls" {
+  model = juju_model.development.name
+  label = "tls"
+}
+
+resource "juju_application" "ingress" {
+  model = juju_model.development.name
+
+  charm {
+    name = "nginx-ingress-integrator"
+  }
+
+  config = {
+    tls-secret = data.juju_secret.tls.secret_id
+  }
+}
@@ -0,0 +1,8 @@
+data "juju_secrets" "postgresql" {
+  model = juju_model.development.name
+  owner = "application-postgresql"
+}
+
+output "postgresql_secrets" {
+  value = { for secret in data.juju_secrets.postgresql.secrets : secret.label => secret.secret_id }
+}
@@ -0,0 +1,13 @@
+data "juju_spaces" "this" {
+  model = juju_model.development.name
+}
+
+resource "juju_application" "postgresql" {
+  model = juju_model.development.name
+
+  charm {
+    name = "postgresql"
+  }
+
+  constraints = contains([for space in data.juju_spaces.this.spaces : space.name], "database") ? "spaces=database" : null
+}
@@ -0,0 +1,15 @@
+data "juju_status" "postgresql" {
+  model            = juju_model.development.name
+  application_name = juju_application.postgresql.name
+
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for unit in self.units : unit.workload_status == "active"])
+      error_message = "All of the postgresql units must be active."
+    }
+  }
+}
+
+output "postgresql_addresses" {
+  value = [for unit in data.juju_status.postgresql.units : unit.private_address]
+}
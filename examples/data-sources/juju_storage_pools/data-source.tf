@@ -0,0 +1,16 @@
+data "juju_storage_pools" "this" {
+  model = juju_model.development.name
+}
+
+locals {
+  pool_names   = [for pool in data.juju_storage_pools.this.pools : pool.name]
+  storage_pool = contains(local.pool_names, "ebs-ssd") ? "ebs-ssd" : "rootfs"
+}
+
+resource "juju_storage" "pgdata" {
+  model = juju_model.development.name
+  unit  = "postgresql/0"
+  name  = "pgdata"
+  pool  = local.storage_pool
+  size  = 10240
+}
@@ -0,0 +1,8 @@
+data "juju_subnets" "database" {
+  model = juju_model.development.name
+  space = "database"
+}
+
+output "database_cidrs" {
+  value = [for subnet in data.juju_subnets.database.subnets : subnet.cidr]
+}
@@ -0,0 +1,7 @@
+data "juju_users" "all" {
+  include_disabled = true
+}
+
+output "superusers" {
+  value = [for user in data.juju_users.all.users : user.name if user.access == "superuser"]
+}
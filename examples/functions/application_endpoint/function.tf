@@ -0,0 +1,3 @@
+output "database_endpoint" {
+  value = provider::juju::application_endpoint(juju_application.database.name, "db")
+}
@@ -0,0 +1,14 @@
+variable "release" {
+  description = "The Ubuntu release of the applications of the module."
+  type        = string
+  default     = "22.04"
+}
+
+resource "juju_application" "database" {
+  model = juju_model.development.name
+
+  charm {
+    name = "postgresql"
+    base = provider::juju::base("ubuntu", var.release)
+  }
+}
@@ -0,0 +1,15 @@
+variable "constraints" {
+  description = "Constraints overriding the default constraints of the module."
+  type        = string
+  default     = ""
+}
+
+resource "juju_application" "database" {
+  model = juju_model.development.name
+
+  charm {
+    name = "postgresql"
+  }
+
+  constraints = provider::juju::merge_constraints("arch=amd64 mem=4G cores=2", var.constraints)
+}
@@ -0,0 +1,17 @@
+variable "database" {
+  description = "The endpoint of the database, e.g. mysql:db."
+  type        = string
+}
+
+resource "juju_integration" "wordpress_db" {
+  model = juju_model.development.name
+
+  application {
+    name = juju_application.wordpress.name
+  }
+
+  application {
+    name     = provider::juju::parse_application_endpoint(var.database).application
+    endpoint = provider::juju::parse_application_endpoint(var.database).endpoint
+  }
+}
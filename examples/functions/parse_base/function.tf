@@ -0,0 +1,3 @@
+output "machine_release" {
+  value = provider::juju::parse_base(juju_machine.this.base).track
+}
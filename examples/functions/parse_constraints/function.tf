@@ -0,0 +1,3 @@
+output "model_memory" {
+  value = provider::juju::parse_constraints(juju_model.development.constraints).mem
+}
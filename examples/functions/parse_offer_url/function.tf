@@ -0,0 +1,3 @@
+output "offering_model" {
+  value = provider::juju::parse_offer_url(juju_offer.mysql.url).model
+}
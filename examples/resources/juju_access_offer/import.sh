@@ -0,0 +1,3 @@
+# Access Offers can be imported using the offer URL,
+# access and comma separated list of users
+$ terraform import juju_access_offer.db admin/development.db:consume:user-one,user-two
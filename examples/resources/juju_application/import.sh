@@ -1,2 +1,6 @@
-# Applications can be imported using the format: `model_name:application_name`, for example:
+# Applications can be imported using the format: `model_uuid:application_name`, for example:
+$ terraform import juju_application.wordpress 8e1c4a5b-3f2d-4c6e-9a7b-0d1e2f3a4b5c:wordpress
+
+# The name of the model is accepted in place of its UUID, the ID of the
+# imported application holds the model UUID.
 $ terraform import juju_application.wordpress development:wordpress
@@ -33,4 +33,16 @@ resource "juju_application" "placement_example" {
   config = {
     external-hostname = "..."
   }
-}
\ No newline at end of file
+}
+resource "juju_application" "resources_example" {
+  name  = "resources-example"
+  model = juju_model.development.name
+  charm {
+    name    = "juju-qa-test"
+    channel = "latest/stable"
+  }
+
+  resources = {
+    foo-file = "file:./foo-file.txt"
+  }
+}
@@ -0,0 +1,3 @@
+# Application resources can be imported using the model, application
+# and resource names
+$ terraform import juju_application_resource.image development:grafana:grafana-image
@@ -0,0 +1,6 @@
+resource "juju_application_resource" "image" {
+  model       = juju_model.development.name
+  application = juju_application.grafana.name
+  name        = "grafana-image"
+  revision    = var.grafana_image_revision
+}
@@ -0,0 +1,13 @@
+resource "time_rotating" "daily" {
+  rotation_days = 1
+}
+
+resource "juju_backup" "daily" {
+  notes         = "daily backup"
+  download_path = "/var/backups/juju/controller.tar.gz"
+
+  # A new backup is created every day.
+  triggers = {
+    rotation = time_rotating.daily.id
+  }
+}
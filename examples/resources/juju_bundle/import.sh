@@ -0,0 +1,3 @@
+# Bundles can be imported using the model name, the bundle and a comma
+# separated list of the applications the bundle deployed
+$ terraform import juju_bundle.observability development:cos-lite:grafana,loki,prometheus
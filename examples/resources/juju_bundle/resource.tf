@@ -0,0 +1,15 @@
+resource "juju_bundle" "observability" {
+  model   = juju_model.development.name
+  bundle  = "cos-lite"
+  channel = "latest/stable"
+
+  overlays = [
+    "${path.module}/overlays/config.yaml",
+    "${path.module}/overlays/storage.yaml",
+  ]
+}
+
+resource "juju_bundle" "legacy" {
+  model  = juju_model.development.name
+  bundle = "${path.module}/bundles/legacy.yaml"
+}
@@ -0,0 +1,2 @@
+# Clouds can be imported by their name
+$ terraform import juju_cloud.openstack my-openstack
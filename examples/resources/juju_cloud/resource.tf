@@ -0,0 +1,11 @@
+resource "juju_cloud" "openstack" {
+  name       = "my-openstack"
+  type       = "openstack"
+  auth_types = ["userpass"]
+  endpoint   = "https://keystone.example.com:5000/v3"
+
+  region {
+    name     = "RegionOne"
+    endpoint = "https://keystone.example.com:5000/v3"
+  }
+}
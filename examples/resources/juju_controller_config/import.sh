@@ -0,0 +1,2 @@
+# The controller config can be imported using the ID controller
+$ terraform import juju_controller_config.this controller
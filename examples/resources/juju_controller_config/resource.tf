@@ -0,0 +1,6 @@
+resource "juju_controller_config" "this" {
+  config = {
+    "audit-log-capture-args" = "true"
+    "agent-ratelimit-max"    = "20"
+  }
+}
@@ -0,0 +1,14 @@
+resource "juju_exec" "vacuum" {
+  model   = juju_model.development.name
+  command = "sudo -u postgres vacuumdb --all --analyze"
+  units   = ["postgresql/leader"]
+  timeout = "30m"
+
+  triggers = {
+    revision = juju_application.postgresql.charm[0].revision
+  }
+}
+
+output "vacuum_output" {
+  value = juju_exec.vacuum.results[0].stdout
+}
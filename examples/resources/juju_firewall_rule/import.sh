@@ -0,0 +1,2 @@
+# Firewall rules can be imported using the model name and the service
+$ terraform import juju_firewall_rule.ssh development:ssh
@@ -0,0 +1,11 @@
+resource "juju_firewall_rule" "ssh" {
+  model     = juju_model.development.name
+  service   = "ssh"
+  allowlist = ["192.168.1.0/24", "10.0.0.0/8"]
+}
+
+resource "juju_firewall_rule" "offers" {
+  model     = juju_model.development.name
+  service   = "juju-application-offer"
+  allowlist = ["10.0.0.0/8"]
+}
@@ -1,2 +1,10 @@
-# Integrations can be imported by using the format: model_name:provider_app_name:endpoint:requirer_app_name:endpoint, for example:
+# Integrations can be imported by using the format: model_uuid:provider_app_name:endpoint:requirer_app_name:endpoint, for example:
+$ terraform import juju_integration.wordpress_db 8e1c4a5b-3f2d-4c6e-9a7b-0d1e2f3a4b5c:percona-cluster:server:wordpress:db
+
+# The name of the model is accepted in place of its UUID, the ID of the
+# imported integration holds the model UUID.
 $ terraform import juju_integration.wordpress_db development:percona-cluster:server:wordpress:db
+
+# Cross model integrations are imported using the SAAS name of the offer
+# in place of the provider application name
+$ terraform import juju_integration.wordpress_db development:mysql:db:wordpress:db
@@ -0,0 +1,2 @@
+# Relations can be imported using the target object and the relation
+$ terraform import juju_jaas_access_relation.platform_members group-platform:member
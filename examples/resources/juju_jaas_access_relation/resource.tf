@@ -0,0 +1,13 @@
+# Users are made members of a group.
+resource "juju_jaas_access_relation" "platform_members" {
+  target_object = "group-${juju_jaas_group.platform.name}"
+  relation      = "member"
+  objects       = ["user-alice@canonical.com", "user-bob@canonical.com"]
+}
+
+# The members of the group administer a model.
+resource "juju_jaas_access_relation" "development_admins" {
+  target_object = "model-alice@canonical.com/development"
+  relation      = "administrator"
+  objects       = ["group-${juju_jaas_group.platform.name}#member"]
+}
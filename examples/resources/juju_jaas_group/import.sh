@@ -0,0 +1,2 @@
+# Groups can be imported using the UUID of the group
+$ terraform import juju_jaas_group.platform 0f5c9d4e-5e5c-4a7c-9a5e-1b1c5f0e2d3a
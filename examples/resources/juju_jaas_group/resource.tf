@@ -0,0 +1,3 @@
+resource "juju_jaas_group" "platform" {
+  name = "platform"
+}
@@ -0,0 +1,2 @@
+# Roles can be imported using the UUID of the role
+$ terraform import juju_jaas_role.model_admin 6c1f0a2b-3d4e-4f5a-8b6c-7d8e9f0a1b2c
@@ -0,0 +1,3 @@
+resource "juju_jaas_role" "model_admin" {
+  name = "model-admin"
+}
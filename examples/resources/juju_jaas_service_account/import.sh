@@ -0,0 +1,2 @@
+# Service accounts can be imported using the client ID of the service account
+$ terraform import juju_jaas_service_account.ci 2f6a0f3c-1d4b-4b8e-9f6e-5c1a7d2e8b90
@@ -0,0 +1,23 @@
+resource "juju_jaas_service_account" "ci" {
+  client_id = "2f6a0f3c-1d4b-4b8e-9f6e-5c1a7d2e8b90"
+
+  cloud_credential {
+    cloud     = "aws"
+    name      = "ci"
+    auth_type = "access-key"
+    attributes = {
+      access-key = var.aws_access_key
+      secret-key = var.aws_secret_key
+    }
+  }
+}
+
+resource "juju_jaas_group" "deployers" {
+  name = "deployers"
+}
+
+resource "juju_jaas_access_relation" "ci_deployers" {
+  target_object = "group-${juju_jaas_group.deployers.name}"
+  relation      = "member"
+  objects       = ["serviceaccount-${juju_jaas_service_account.ci.client_id}"]
+}
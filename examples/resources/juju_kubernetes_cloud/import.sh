@@ -0,0 +1,4 @@
+# Kubernetes clouds can be imported using the cloud name, and the name of
+# its credential when it differs from the cloud name
+$ terraform import juju_kubernetes_cloud.this my-k8s-cloud
+$ terraform import juju_kubernetes_cloud.this my-k8s-cloud:my-credential
@@ -0,0 +1,15 @@
+resource "juju_kubernetes_cloud" "this" {
+  name            = "my-k8s"
+  kubeconfig_path = pathexpand("~/.kube/config")
+  context         = "microk8s"
+  storage_class   = "microk8s-hostpath"
+}
+
+resource "juju_model" "this" {
+  name       = "development"
+  credential = juju_kubernetes_cloud.this.credential
+
+  cloud {
+    name = juju_kubernetes_cloud.this.id
+  }
+}
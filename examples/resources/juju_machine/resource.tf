@@ -3,4 +3,28 @@ resource "juju_machine" "this_machine" {
   base        = "ubuntu@22.04"
   name        = "this_machine"
   constraints = "tags=my-machine-tag"
-}
\ No newline at end of file
+
+  # Wait for the machine to be started, so its
+  # ip_addresses are known once it is created.
+  wait_for_started = true
+  start_timeout    = "20m"
+
+  annotations = {
+    team = "platform"
+  }
+}
+resource "juju_machine" "bare_metal" {
+  model                = juju_model.development.name
+  name                 = "bare_metal"
+  ssh_address          = "ubuntu@10.10.0.15"
+  public_key_file      = pathexpand("~/.ssh/id_ed25519.pub")
+  private_key_file     = pathexpand("~/.ssh/id_ed25519")
+  provisioning_timeout = "10m"
+}
+
+resource "juju_machine" "container" {
+  model          = juju_model.development.name
+  name           = "container"
+  container_type = "lxd"
+  parent_machine = juju_machine.this_machine.machine_id
+}
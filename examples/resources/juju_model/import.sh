@@ -1,2 +1,5 @@
 # Models can be imported using the model name
 $ terraform import juju_model.development development
+
+# Models owned by another user are imported using the qualified model name
+$ terraform import juju_model.development bob/development
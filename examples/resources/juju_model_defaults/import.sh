@@ -0,0 +1,4 @@
+# Model defaults can be imported with the cloud name, or the cloud
+# name and region separated by a colon
+$ terraform import juju_model_defaults.aws aws
+$ terraform import juju_model_defaults.aws_eu_west_1 aws:eu-west-1
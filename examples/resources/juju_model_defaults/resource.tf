@@ -0,0 +1,17 @@
+resource "juju_model_defaults" "aws" {
+  cloud = "aws"
+
+  config = {
+    default-base = "ubuntu@22.04"
+    apt-mirror   = "http://mirror.example.com/ubuntu"
+  }
+}
+
+resource "juju_model_defaults" "aws_eu_west_1" {
+  cloud  = "aws"
+  region = "eu-west-1"
+
+  config = {
+    apt-mirror = "http://eu-west-1.mirror.example.com/ubuntu"
+  }
+}
@@ -0,0 +1,9 @@
+resource "juju_model_migration" "development" {
+  model                   = juju_model.development.name
+  target_controller_uuid  = "4d3a9a0e-8a3b-4c5d-9e4f-1a2b3c4d5e6f"
+  target_controller_alias = "production"
+  target_addresses        = ["10.0.0.1:17070", "10.0.0.2:17070"]
+  target_ca_certificate   = file("~/production-ca.crt")
+  target_username         = "admin"
+  target_password         = var.production_password
+}
@@ -1,7 +1,7 @@
 resource "juju_offer" "this" {
   model            = juju_model.development.name
   application_name = juju_application.percona-cluster.name
-  endpoint         = server
+  endpoints        = ["server"]
 }
 
 // an offer can then be used in an integration as below:
@@ -0,0 +1,3 @@
+# Secret accesses can be imported using the model name, the secret URI
+# and a comma separated list of applications
+$ terraform import juju_secret_access.tls development:secret:coj8mulh8b41e8nv6p90:traefik,grafana
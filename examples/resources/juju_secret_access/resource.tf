@@ -0,0 +1,5 @@
+resource "juju_secret_access" "tls" {
+  model        = juju_model.development.name
+  secret_id    = "secret:coj8mulh8b41e8nv6p90"
+  applications = [juju_application.traefik.name, juju_application.grafana.name]
+}
@@ -0,0 +1,2 @@
+# Secret backends can be imported by their name
+$ terraform import juju_secret_backend.vault vault
@@ -0,0 +1,15 @@
+resource "juju_secret_backend" "vault" {
+  name         = "vault"
+  backend_type = "vault"
+  config = {
+    endpoint = "https://vault.example.com:8200"
+    token    = var.vault_token
+    ca-cert  = file("vault-ca.pem")
+  }
+  token_rotate_interval = "24h"
+}
+
+resource "juju_model" "development" {
+  name           = "development"
+  secret_backend = juju_secret_backend.vault.name
+}
@@ -0,0 +1,2 @@
+# Spaces can be imported using the model name and the space name
+$ terraform import juju_space.storage development:storage
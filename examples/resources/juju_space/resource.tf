@@ -0,0 +1,4 @@
+resource "juju_space" "storage" {
+  model = juju_model.development.name
+  name  = "storage"
+}
@@ -1,2 +1,2 @@
-# Keys can be imported with the name of the model and the username of the key
-$ terraform import juju_ssh_key.dev-user ssh_key:development:dev-user
\ No newline at end of file
+# Keys can be imported with the name of the model and the fingerprint of the key
+$ terraform import juju_ssh_key.dev-user sshkey:development:2c:7f:b3:5e:9a:1d:44:c0:8e:61:0b:f2:3a:97:d5:18
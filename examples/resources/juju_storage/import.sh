@@ -0,0 +1,2 @@
+# Storage can be imported using the model name and the storage ID
+$ terraform import juju_storage.pgdata development:pgdata/0
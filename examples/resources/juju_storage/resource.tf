@@ -0,0 +1,16 @@
+resource "juju_storage" "pgdata" {
+  model = juju_model.development.name
+  name  = "pgdata"
+  unit  = "postgresql/0"
+  pool  = "lxd"
+  size  = 10240
+}
+
+# Detach the storage, keeping its data, to attach it to another unit
+# later.
+resource "juju_storage" "archive" {
+  model    = juju_model.development.name
+  name     = "archive"
+  unit     = "postgresql/1"
+  attached = false
+}
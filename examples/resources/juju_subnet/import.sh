@@ -0,0 +1,2 @@
+# Subnets can be imported using the model name and the CIDR of the subnet
+$ terraform import juju_subnet.storage development:10.10.20.0/24
@@ -0,0 +1,5 @@
+resource "juju_subnet" "storage" {
+  model = juju_model.development.name
+  cidr  = "10.10.20.0/24"
+  space = juju_space.storage.name
+}
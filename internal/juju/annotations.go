@@ -0,0 +1,46 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"fmt"
+
+	"github.com/juju/juju/api"
+	apiannotations "github.com/juju/juju/api/client/annotations"
+	"github.com/juju/names/v4"
+)
+
+// setAnnotations sets the annotations of an entity, e.g. a model or
+// a machine. An annotation with an empty value is removed.
+func setAnnotations(conn api.Connection, tag names.Tag, annotations map[string]string) error {
+	client := apiannotations.NewClient(conn)
+	results, err := client.Set(map[string]map[string]string{
+		tag.String(): annotations,
+	})
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+// getAnnotations returns the annotations of an entity.
+func getAnnotations(conn api.Connection, tag names.Tag) (map[string]string, error) {
+	client := apiannotations.NewClient(conn)
+	results, err := client.Get([]string{tag.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected one set of %s annotations, received %d", tag.Kind(), len(results))
+	}
+	if results[0].Error.Error != nil {
+		return nil, results[0].Error.Error
+	}
+	return results[0].Annotations, nil
+}
@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"os"
 	"reflect"
 	"sort"
 	"strconv"
@@ -30,6 +31,7 @@ import (
 	apiclient "github.com/juju/juju/api/client/client"
 	apimodelconfig "github.com/juju/juju/api/client/modelconfig"
 	apiresources "github.com/juju/juju/api/client/resources"
+	apispaces "github.com/juju/juju/api/client/spaces"
 	apicommoncharm "github.com/juju/juju/api/common/charm"
 	"github.com/juju/juju/cmd/juju/application/utils"
 	"github.com/juju/juju/core/base"
@@ -45,6 +47,19 @@ import (
 	goyaml "gopkg.in/yaml.v2"
 )
 
+// ApplicationAvailableTimeout is the time to wait for an application
+// to be in the model once deployed.
+const ApplicationAvailableTimeout = time.Second * 60
+
+// unitsVisibleTimeout is how long a create or update waits for the
+// units of an application to be as requested.
+const unitsVisibleTimeout = 5 * time.Minute
+
+// createPendingKey is the annotation of the applications deployed by a
+// create which has not completed yet. Retrying the create resumes it,
+// rather than failing as the application exists.
+const createPendingKey = "terraform-create-pending"
+
 var ApplicationNotFoundError = &applicationNotFoundError{}
 
 // ApplicationNotFoundError
@@ -67,6 +82,9 @@ type applicationsClient struct {
 type ConfigEntry struct {
 	Value     interface{}
 	IsDefault bool
+	// Sensitive is true if the charm declares the entry
+	// as a secret.
+	Sensitive bool
 }
 
 // EqualConfigEntries compare two juju configuration entries.
@@ -106,12 +124,18 @@ type CreateApplicationInput struct {
 	CharmBase       string
 	CharmSeries     string
 	CharmRevision   int
-	Units           int
-	Trust           bool
-	Expose          map[string]interface{}
-	Config          map[string]string
-	Placement       string
-	Constraints     constraints.Value
+	// CharmForceBase deploys the charm on the requested base even
+	// if the charm does not declare support for it.
+	CharmForceBase bool
+	Units          int
+	Trust          bool
+	Expose         map[string]interface{}
+	Config         map[string]string
+	Placement      string
+	Constraints    constraints.Value
+	// Resources maps a charm resource name to either a charmhub
+	// revision number, or a local file prefixed with "file:".
+	Resources map[string]string
 }
 
 // validateAndTransform returns transformedCreateApplicationInput which
@@ -125,9 +149,17 @@ func (input CreateApplicationInput) validateAndTransform() (parsed transformedCr
 	parsed.constraints = input.Constraints
 	parsed.config = input.Config
 	parsed.expose = input.Expose
+	parsed.forceBase = input.CharmForceBase
 	parsed.trust = input.Trust
 	parsed.units = input.Units
 
+	for name, value := range input.Resources {
+		if err = validateResourceValue(name, value); err != nil {
+			return
+		}
+	}
+	parsed.resources = input.Resources
+
 	appName := input.ApplicationName
 	if appName == "" {
 		appName = input.CharmName
@@ -185,7 +217,9 @@ type transformedCreateApplicationInput struct {
 	config          map[string]string
 	constraints     constraints.Value
 	expose          map[string]interface{}
+	forceBase       bool
 	placement       []*instance.Placement
+	resources       map[string]string
 	units           int
 	trust           bool
 }
@@ -197,6 +231,9 @@ type CreateApplicationResponse struct {
 type ReadApplicationInput struct {
 	ModelName string
 	AppName   string
+	// CachedStatus shares the status of the model with the other
+	// reads of a refresh, see ReadModelStatus.
+	CachedStatus bool
 }
 
 type ReadApplicationResponse struct {
@@ -210,8 +247,52 @@ type ReadApplicationResponse struct {
 	Config      map[string]ConfigEntry
 	Constraints constraints.Value
 	Expose      map[string]interface{}
-	Principal   bool
-	Placement   string
+	// Resources maps the application resource names to their
+	// charmhub revision, or the fingerprint of uploaded files.
+	Resources map[string]ResourceEntry
+	Principal bool
+	Placement string
+	Machines  []string
+	// ServiceProviderID and ServiceAddress describe the kubernetes
+	// service of an application in a CAAS model.
+	ServiceProviderID string
+	ServiceAddress    string
+	// EndpointBindings maps the endpoints of the application to the
+	// space they are bound to, the default space being keyed by "".
+	EndpointBindings map[string]string
+	// UnitDetails holds the units of the application, sorted by
+	// unit number.
+	UnitDetails []ApplicationUnit
+}
+
+// ApplicationUnit describes a unit of a deployed application.
+type ApplicationUnit struct {
+	Name            string
+	Machine         string
+	Leader          bool
+	PublicAddress   string
+	PrivateAddress  string
+	WorkloadStatus  string
+	WorkloadMessage string
+	AgentStatus     string
+	AgentMessage    string
+}
+
+type ReadApplicationStatusInput struct {
+	ModelName string
+	AppName   string
+}
+
+type ReadApplicationStatusResponse struct {
+	Status  string
+	Message string
+	// Units holds the units of the application, sorted by unit
+	// number.
+	Units []ApplicationUnit
+}
+
+type ListApplicationsInput struct {
+	ModelName string
 }
 
 type UpdateApplicationInput struct {
@@ -221,14 +302,28 @@ type UpdateApplicationInput struct {
 	Units     *int
 	Revision  *int
 	Channel   string
+	// ForceBase refreshes the charm even if the new charm does not
+	// declare support for the base of the deployed application.
+	ForceBase bool
 	Trust     *bool
 	Expose    map[string]interface{}
 	// Unexpose indicates what endpoints to unexpose
 	Unexpose []string
 	Config   map[string]string
+	// UnsetConfig indicates what config keys to reset to the
+	// charm default value
+	UnsetConfig []string
 	//Series    string // Unsupported today
 	Placement   map[string]interface{}
 	Constraints *constraints.Value
+	// Resources holds the resources to be updated, keyed by name.
+	// Values are either a charmhub revision number or a local file
+	// prefixed with "file:".
+	Resources map[string]string
+	// Machines indicates the machines to run one unit each on.
+	// When set, units are added or removed to match the machines
+	// and Units is ignored.
+	Machines []string
 }
 
 type DestroyApplicationInput struct {
@@ -256,6 +351,8 @@ func resolveCharmURL(charmName string) (*charm.URL, error) {
 }
 
 func (c applicationsClient) CreateApplication(ctx context.Context, input *CreateApplicationInput) (*CreateApplicationResponse, error) {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return nil, err
@@ -268,11 +365,22 @@ func (c applicationsClient) CreateApplication(ctx context.Context, input *Create
 	}
 
 	applicationAPIClient := apiapplication.NewClient(conn)
-	if applicationAPIClient.BestAPIVersion() >= 19 {
-		err = c.deployFromRepository(applicationAPIClient, transformedInput)
-	} else {
+	resume, err := c.createPending(conn, applicationAPIClient, transformedInput)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resume:
+		c.Tracef(fmt.Sprintf("resuming the create of application %q", transformedInput.applicationName))
+		err = c.resumeCreate(conn, applicationAPIClient, transformedInput)
+	case applicationAPIClient.BestAPIVersion() >= 19:
+		err = c.deployFromRepository(conn, applicationAPIClient, transformedInput)
+	default:
 		err = c.legacyDeploy(ctx, conn, applicationAPIClient, transformedInput)
 		err = jujuerrors.Annotate(err, "legacy deploy method")
+		if err == nil {
+			err = markCreatePending(conn, transformedInput.applicationName)
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -281,33 +389,285 @@ func (c applicationsClient) CreateApplication(ctx context.Context, input *Create
 	// If we have managed to deploy something, now we have
 	// to check if we have to expose something
 	err = c.processExpose(applicationAPIClient, transformedInput.applicationName, transformedInput.expose)
+	if err == nil {
+		err = setAnnotations(conn, names.NewApplicationTag(transformedInput.applicationName),
+			map[string]string{createPendingKey: ""})
+	}
 
 	return &CreateApplicationResponse{
 		AppName: transformedInput.applicationName,
 	}, err
 }
 
-func (c applicationsClient) deployFromRepository(applicationAPIClient *apiapplication.Client, transformedInput transformedCreateApplicationInput) error {
+// markCreatePending marks an application as deployed by a create which
+// has not completed, until the create completes.
+func markCreatePending(conn api.Connection, appName string) error {
+	return setAnnotations(conn, names.NewApplicationTag(appName), map[string]string{createPendingKey: "true"})
+}
+
+// createPending returns whether the application exists, deployed by a
+// create which did not complete, e.g. as exposing it failed. Such an
+// application is resumed rather than deployed again. The applications
+// deployed otherwise fail to deploy, as they exist.
+func (c applicationsClient) createPending(conn api.Connection, applicationAPIClient *apiapplication.Client, input transformedCreateApplicationInput) (bool, error) {
+	annotations, err := getAnnotations(conn, names.NewApplicationTag(input.applicationName))
+	if jujuerrors.Is(err, jujuerrors.NotFound) || params.IsCodeNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if annotations[createPendingKey] == "" {
+		return false, nil
+	}
+	charmURL, _, err := applicationAPIClient.GetCharmURLOrigin("", input.applicationName)
+	if err != nil {
+		return false, err
+	}
+	if charmURL.Name != input.charmName {
+		return false, fmt.Errorf("application %q was deployed from charm %q by a create which did not complete, "+
+			"remove it to deploy charm %q", input.applicationName, charmURL.Name, input.charmName)
+	}
+	return true, nil
+}
+
+// resumeCreate applies what a create which did not complete may not
+// have applied once the application was deployed: its local resources,
+// and its config, trust and constraints, which may have changed since.
+func (c applicationsClient) resumeCreate(conn api.Connection, applicationAPIClient *apiapplication.Client, input transformedCreateApplicationInput) error {
+	config := make(map[string]string, len(input.config)+1)
+	for key, value := range input.config {
+		config[key] = value
+	}
+	config["trust"] = fmt.Sprintf("%v", input.trust)
+	if err := applicationAPIClient.SetConfig(model.GenerationMaster, input.applicationName, "", config); err != nil {
+		return err
+	}
+	if err := applicationAPIClient.SetConstraints(input.applicationName, input.constraints); err != nil {
+		return err
+	}
+
+	var resourcesAPIClient *apiresources.Client
+	for name, value := range input.resources {
+		filename, isFile := ResourceFilename(value)
+		if !isFile {
+			continue
+		}
+		if resourcesAPIClient == nil {
+			var err error
+			if resourcesAPIClient, err = apiresources.NewClient(conn); err != nil {
+				return err
+			}
+		}
+		if err := uploadResourceFile(resourcesAPIClient, input.applicationName, name, filename, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrecheckApplication checks, without deploying anything, that an
+// application can be deployed as the input describes: its charm
+// resolves in its channel for its base, the machines it is placed on
+// exist and the spaces it uses exist. The problems found are returned
+// as warnings for plans to report, an error is returned when the checks
+// cannot be made, e.g. when the model does not exist yet.
+func (c applicationsClient) PrecheckApplication(input *CreateApplicationInput) ([]string, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	transformedInput, err := input.validateAndTransform()
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []string
+	warning, err := c.precheckCharm(conn, transformedInput)
+	if err != nil {
+		return nil, err
+	}
+	if warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	// The placement is checked against the current machines of the
+	// model, not a status cached by the refresh.
+	status, err := c.ReadModelStatus(input.ModelName, conn, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, placement := range transformedInput.placement {
+		if warning := precheckPlacement(status, placement); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	var usedSpaces []string
+	if spaces, ok := transformedInput.expose["spaces"].(string); ok {
+		usedSpaces = splitCommaDelimitedList(spaces)
+	}
+	usedSpaces = append(usedSpaces, transformedInput.constraints.IncludeSpaces()...)
+	usedSpaces = append(usedSpaces, transformedInput.constraints.ExcludeSpaces()...)
+	if len(usedSpaces) == 0 {
+		return warnings, nil
+	}
+	modelSpaces, err := apispaces.NewAPI(conn).ListSpaces()
+	if err != nil {
+		return nil, err
+	}
+	known := set.NewStrings()
+	for _, space := range modelSpaces {
+		known.Add(space.Name)
+	}
+	for _, space := range set.NewStrings(usedSpaces...).SortedValues() {
+		if !known.Contains(space) {
+			warnings = append(warnings, fmt.Sprintf("space %q does not exist in model %q", space, input.ModelName))
+		}
+	}
+	return warnings, nil
+}
+
+// precheckCharm resolves the charm of an application as deploying it
+// would, and returns a warning when it cannot be resolved or does not
+// support the requested base.
+func (c applicationsClient) precheckCharm(conn api.Connection, input transformedCreateApplicationInput) (string, error) {
+	channel, err := charm.ParseChannel(input.charmChannel)
+	if err != nil {
+		return "", err
+	}
+	charmURL, err := resolveCharmURL(input.charmName)
+	if err != nil {
+		return "", err
+	}
+	platformCons, err := apimodelconfig.NewClient(conn).GetModelConstraints()
+	if err != nil {
+		return "", err
+	}
+	platform := utils.MakePlatform(input.constraints, input.charmBase, platformCons)
+
+	urlForOrigin := charmURL
+	if input.charmRevision != UnspecifiedRevision {
+		urlForOrigin = urlForOrigin.WithRevision(input.charmRevision)
+	}
+	if !input.charmBase.Empty() {
+		series, err := base.GetSeriesFromBase(input.charmBase)
+		if err != nil {
+			return "", err
+		}
+		urlForOrigin = urlForOrigin.WithSeries(series)
+	}
+	origin, err := utils.DeduceOrigin(urlForOrigin, channel, platform)
+	if err != nil {
+		return "", err
+	}
+
+	_, resolvedOrigin, supportedBases, err := resolveCharm(apicharms.NewClient(conn), charmURL, origin)
+	if err != nil {
+		return fmt.Sprintf("charm %q cannot be resolved in channel %q: %s", input.charmName, channel, err), nil
+	}
+	if resolvedOrigin.Type == "bundle" {
+		return fmt.Sprintf("%q is a bundle, not a charm", input.charmName), nil
+	}
+	if input.charmBase.Empty() || input.forceBase || len(supportedBases) == 0 {
+		return "", nil
+	}
+	displayBases := make([]string, 0, len(supportedBases))
+	for _, supported := range supportedBases {
+		if input.charmBase.IsCompatible(supported) {
+			return "", nil
+		}
+		displayBases = append(displayBases, supported.DisplayString())
+	}
+	return fmt.Sprintf("charm %q does not support base %q in channel %q, it supports %s",
+		input.charmName, input.charmBase.DisplayString(), channel, strings.Join(displayBases, ", ")), nil
+}
+
+// precheckPlacement returns a warning when an application is placed on
+// a machine which does not exist.
+func precheckPlacement(status *params.FullStatus, placement *instance.Placement) string {
+	if placement.Scope != instance.MachineScope && placement.Directive == "" {
+		// A new machine or container.
+		return ""
+	}
+	if placement.Scope != instance.MachineScope && !names.IsValidMachine(placement.Directive) {
+		// A directive for the cloud, e.g. zone=us-east-1a.
+		return ""
+	}
+	machineID := placement.Directive
+	for id, machine := range status.Machines {
+		if id == machineID {
+			return ""
+		}
+		if _, ok := machine.Containers[machineID]; ok {
+			return ""
+		}
+	}
+	return fmt.Sprintf("machine %q of placement %q does not exist", machineID, placement)
+}
+
+func (c applicationsClient) deployFromRepository(conn api.Connection, applicationAPIClient *apiapplication.Client, transformedInput transformedCreateApplicationInput) error {
 	settingsForYaml := map[interface{}]interface{}{transformedInput.applicationName: transformedInput.config}
 	configYaml, err := goyaml.Marshal(settingsForYaml)
 	if err != nil {
 		return jujuerrors.Trace(err)
 	}
 
+	// DeployFromRepository treats any resource value which is not a
+	// revision number as the name of a local file.
+	var deployResources map[string]string
+	if len(transformedInput.resources) > 0 {
+		deployResources = make(map[string]string, len(transformedInput.resources))
+		for name, value := range transformedInput.resources {
+			if filename, isFile := ResourceFilename(value); isFile {
+				value = filename
+			}
+			deployResources[name] = value
+		}
+	}
+
 	c.Tracef("Calling DeployFromRepository")
-	_, _, errs := applicationAPIClient.DeployFromRepository(apiapplication.DeployFromRepositoryArg{
+	_, pendingUploads, errs := applicationAPIClient.DeployFromRepository(apiapplication.DeployFromRepositoryArg{
 		CharmName:       transformedInput.charmName,
 		ApplicationName: transformedInput.applicationName,
 		Base:            &transformedInput.charmBase,
 		Channel:         &transformedInput.charmChannel,
 		ConfigYAML:      string(configYaml),
 		Cons:            transformedInput.constraints,
+		Force:           transformedInput.forceBase,
 		NumUnits:        &transformedInput.units,
 		Placement:       transformedInput.placement,
+		Resources:       deployResources,
 		Revision:        &transformedInput.charmRevision,
 		Trust:           transformedInput.trust,
 	})
-	return errors.Join(errs...)
+	if len(errs) != 0 {
+		return errors.Join(errs...)
+	}
+	// The resources are uploaded once the application is deployed.
+	if err := markCreatePending(conn, transformedInput.applicationName); err != nil {
+		return err
+	}
+	if len(pendingUploads) == 0 {
+		return nil
+	}
+
+	resourcesAPIClient, err := apiresources.NewClient(conn)
+	if err != nil {
+		return err
+	}
+	for _, pending := range pendingUploads {
+		if pending.Type != charmresources.TypeFile.String() {
+			return jujuerrors.NotSupportedf("uploading resource %q of type %q", pending.Name, pending.Type)
+		}
+		c.Tracef("Uploading resource", map[string]interface{}{"name": pending.Name, "filename": pending.Filename})
+		if err := uploadResourceFile(resourcesAPIClient, transformedInput.applicationName, pending.Name, pending.Filename, ""); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // TODO (hml) 23-Feb-2024
@@ -377,9 +737,14 @@ func (c applicationsClient) legacyDeploy(ctx context.Context, conn api.Connectio
 	}
 	c.Tracef("resolveCharm returned", map[string]interface{}{"resolvedURL": resolvedURL, "resolvedOrigin": resolvedOrigin, "supportedBases": supportedBases})
 
-	baseToUse, err := c.baseToUse(modelconfigAPIClient, userSuppliedBase, resolvedOrigin.Base, supportedBases)
-	if err != nil {
-		c.Warnf("failed to get a suggested operating system from resolved charm response", map[string]interface{}{"err": err})
+	// When forced, the user supplied base is used as is, even if
+	// the charm does not declare support for it.
+	baseToUse := userSuppliedBase
+	if !transformedInput.forceBase || userSuppliedBase.Empty() {
+		baseToUse, err = c.baseToUse(modelconfigAPIClient, userSuppliedBase, resolvedOrigin.Base, supportedBases)
+		if err != nil {
+			c.Warnf("failed to get a suggested operating system from resolved charm response", map[string]interface{}{"err": err})
+		}
 	}
 	// Double check we got what was requested.
 	if !userSuppliedBase.Empty() && !userSuppliedBase.IsCompatible(baseToUse) {
@@ -416,7 +781,7 @@ func (c applicationsClient) legacyDeploy(ctx context.Context, conn api.Connectio
 	return retry.Call(retry.CallArgs{
 		Func: func() error {
 			c.Tracef("AddCharm ", map[string]interface{}{"resolvedURL": resolvedURL, "resolvedOrigin": resolvedOrigin})
-			resultOrigin, err := charmsAPIClient.AddCharm(resolvedURL, resolvedOrigin, false)
+			resultOrigin, err := charmsAPIClient.AddCharm(resolvedURL, resolvedOrigin, transformedInput.forceBase)
 			if err != nil {
 				err2 := typedError(err)
 				// If the charm is AlreadyExists, keep going, we
@@ -433,7 +798,7 @@ func (c applicationsClient) legacyDeploy(ctx context.Context, conn api.Connectio
 				Origin: resultOrigin,
 			}
 
-			resources, err := c.processResources(charmsAPIClient, conn, charmID, transformedInput.applicationName)
+			resources, err := c.processResources(charmsAPIClient, conn, charmID, transformedInput.applicationName, transformedInput.resources)
 			if err != nil && !jujuerrors.Is(err, jujuerrors.AlreadyExists) {
 				return err
 			}
@@ -643,7 +1008,7 @@ func splitCommaDelimitedList(list string) []string {
 
 // processResources is a helper function to process the charm
 // metadata and request the download of any additional resource.
-func (c applicationsClient) processResources(charmsAPIClient *apicharms.Client, conn api.Connection, charmID apiapplication.CharmID, appName string) (map[string]string, error) {
+func (c applicationsClient) processResources(charmsAPIClient *apicharms.Client, conn api.Connection, charmID apiapplication.CharmID, appName string, userResources map[string]string) (map[string]string, error) {
 	charmInfo, err := charmsAPIClient.CharmInfo(charmID.URL.String())
 	if err != nil {
 		return nil, typedError(err)
@@ -659,39 +1024,73 @@ func (c applicationsClient) processResources(charmsAPIClient *apicharms.Client,
 		return nil, err
 	}
 
-	return addPendingResources(appName, charmInfo.Meta.Resources, charmID, resourcesAPIClient)
+	return addPendingResources(appName, charmInfo.Meta.Resources, userResources, charmID, resourcesAPIClient)
 }
 
-// ReadApplicationWithRetryOnNotFound calls ReadApplication until
-// successful, or the count is exceeded when the error is of type
-// not found. Delay indicates how long to wait between attempts.
+// ReadApplicationWithRetryOnNotFound waits until the application is
+// in the model, as the AllWatcher reports it, then reads it. The
+// application is waited for until ApplicationAvailableTimeout is
+// exceeded.
 func (c applicationsClient) ReadApplicationWithRetryOnNotFound(ctx context.Context, input *ReadApplicationInput) (*ReadApplicationResponse, error) {
-	var output *ReadApplicationResponse
-	err := retry.Call(retry.CallArgs{
-		Func: func() error {
-			var err error
-			output, err = c.ReadApplication(input)
-			if errors.As(err, &ApplicationNotFoundError) {
-				return nil
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(ctx, ApplicationAvailableTimeout)
+	defer cancel()
+	c.Debugf(fmt.Sprintf("waiting for application %q", input.AppName))
+	if err := WaitForAppsAvailable(ctx, conn, c.JujuLogger(), []string{input.AppName}); err != nil {
+		return nil, err
+	}
+	return c.ReadApplication(input)
+}
+
+// WaitForUnits waits for an application to have the number of units,
+// each assigned to a machine: units are assigned to machines, and
+// removed, after the calls adding or removing them return, and reads
+// made meanwhile would not see the change. The units of subordinate
+// applications, and of Kubernetes models, are not waited for, as the
+// reads do not count them.
+func (c applicationsClient) WaitForUnits(ctx context.Context, input *ReadApplicationInput, units int) error {
+	modelType, err := c.ModelType(input.ModelName)
+	if err != nil {
+		return err
+	}
+	if modelType == model.CAAS {
+		return nil
+	}
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	c.Debugf(fmt.Sprintf("waiting for the %d units of application %q", units, input.AppName))
+	return watchModel(ctx, conn, c.JujuLogger(), unitsVisibleTimeout, func(entities *modelEntities) error {
+		app, ok := entities.applications[input.AppName]
+		if !ok {
+			return stillWaiting("application %q is not available", input.AppName)
+		}
+		if app.Subordinate {
+			return nil
+		}
+		count := 0
+		for name, unit := range entities.units {
+			if unit.Application != input.AppName {
+				continue
 			}
-			return err
-		},
-		NotifyFunc: func(err error, attempt int) {
-			if attempt%4 == 0 {
-				message := fmt.Sprintf("waiting for application %q", input.AppName)
-				if attempt != 4 {
-					message = "still " + message
-				}
-				c.Debugf(message)
+			if unit.MachineId == "" {
+				return stillWaiting("unit %q is not assigned to a machine", name)
 			}
-		},
-		BackoffFunc: retry.DoubleDelay,
-		Attempts:    30,
-		Delay:       time.Second,
-		Clock:       clock.WallClock,
-		Stop:        ctx.Done(),
+			count++
+		}
+		if count != units {
+			return stillWaiting("application %q has %d units rather than %d", input.AppName, count, units)
+		}
+		return nil
 	})
-	return output, err
 }
 
 func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadApplicationResponse, error) {
@@ -702,7 +1101,6 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 	defer func() { _ = conn.Close() }()
 
 	applicationAPIClient := apiapplication.NewClient(conn)
-	clientAPIClient := apiclient.NewClient(conn, c.JujuLogger())
 
 	apps, err := applicationAPIClient.ApplicationsInfo([]names.ApplicationTag{names.NewApplicationTag(input.AppName)})
 	if err != nil {
@@ -735,7 +1133,7 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 		appConstraints = queryConstraints[0]
 	}
 
-	status, err := clientAPIClient.Status(nil)
+	status, err := c.ReadModelStatus(input.ModelName, conn, input.CachedStatus)
 	if err != nil {
 		return nil, err
 	}
@@ -803,6 +1201,7 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 				conf[k] = ConfigEntry{
 					Value:     value,
 					IsDefault: aux["source"] == "default",
+					Sensitive: aux["type"] == "secret",
 				}
 			}
 		}
@@ -851,6 +1250,25 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 		exposed["spaces"] = spaces
 		exposed["cidrs"] = cidrs
 	}
+	resourcesAPIClient, err := apiresources.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+	appResources, err := resourcesAPIClient.ListResources([]string{input.AppName})
+	if err != nil {
+		return nil, jujuerrors.Annotate(err, "failed to list application resources")
+	}
+	resources := make(map[string]ResourceEntry)
+	if len(appResources) == 1 {
+		for _, res := range appResources[0].Resources {
+			resources[res.Name] = ResourceEntry{
+				Revision:    res.Revision,
+				Fingerprint: res.Fingerprint.Hex(),
+				Uploaded:    res.Origin == charmresources.OriginUpload,
+			}
+		}
+	}
+
 	// ParseChannel to send back a base without the risk.
 	// Having the risk will cause issues with the provider
 	// saving a different value than the user did.
@@ -872,14 +1290,64 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 		Trust:       trustValue,
 		Expose:      exposed,
 		Config:      conf,
+		Resources:   resources,
 		Constraints: appConstraints,
 		Principal:   appInfo.Principal,
 		Placement:   placement,
+		Machines:    allocatedMachines.SortedValues(),
+
+		EndpointBindings: appInfo.EndpointBindings,
+		UnitDetails:      applicationUnits(input.AppName, appStatus, status.Applications),
+	}
+	if modelType == model.CAAS {
+		response.ServiceProviderID = appStatus.ProviderId
+		response.ServiceAddress = appStatus.PublicAddress
 	}
 
 	return response, nil
 }
 
+// applicationUnits returns the units of an application from the model
+// status. The units of a subordinate application are found under the
+// units of its principal applications.
+func applicationUnits(appName string, appStatus params.ApplicationStatus, applications map[string]params.ApplicationStatus) []ApplicationUnit {
+	unitStatuses := make(map[string]params.UnitStatus)
+	for name, unit := range appStatus.Units {
+		unitStatuses[name] = unit
+	}
+	for _, principal := range appStatus.SubordinateTo {
+		for _, principalUnit := range applications[principal].Units {
+			for name, unit := range principalUnit.Subordinates {
+				if strings.HasPrefix(name, appName+"/") {
+					// Subordinate units run on the machine of
+					// their principal unit.
+					unit.Machine = principalUnit.Machine
+					unitStatuses[name] = unit
+				}
+			}
+		}
+	}
+
+	units := make([]ApplicationUnit, 0, len(unitStatuses))
+	for name, unit := range unitStatuses {
+		units = append(units, ApplicationUnit{
+			Name:            name,
+			Machine:         unit.Machine,
+			Leader:          unit.Leader,
+			PublicAddress:   unit.PublicAddress,
+			PrivateAddress:  unit.Address,
+			WorkloadStatus:  unit.WorkloadStatus.Status,
+			WorkloadMessage: unit.WorkloadStatus.Info,
+			AgentStatus:     unit.AgentStatus.Status,
+			AgentMessage:    unit.AgentStatus.Info,
+		})
+	}
+	sort.Slice(units, func(i, j int) bool {
+		return names.NewUnitTag(units[i].Name).Number() < names.NewUnitTag(units[j].Name).Number()
+	})
+	return units
+}
+
 // removeDefaultCidrs is an auxiliar function to remove
 // the "0.0.0.0/0 and ::/0" strings from an array of
 // cidrs
@@ -894,6 +1362,8 @@ func removeDefaultCidrs(cidrs []string) []string {
 }
 
 func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return err
@@ -953,6 +1423,16 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 		if err != nil {
 			return err
 		}
+	} else if len(input.Resources) != 0 {
+		for name, value := range input.Resources {
+			if err := validateResourceValue(name, value); err != nil {
+				return err
+			}
+		}
+		err := c.updateApplicationResources(input.AppName, input.Resources, applicationAPIClient, charmsAPIClient, resourcesAPIClient)
+		if err != nil {
+			return err
+		}
 	}
 
 	if auxConfig != nil {
@@ -963,6 +1443,17 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 		}
 	}
 
+	// reset the removed configuration keys to the charm defaults
+	if len(input.UnsetConfig) != 0 {
+		unsetKeys := set.NewStrings(input.UnsetConfig...).SortedValues()
+		c.Tracef("Unsetting configuration params", map[string]interface{}{"keys": unsetKeys})
+		err := applicationAPIClient.UnsetApplicationConfig(model.GenerationMaster, input.AppName, unsetKeys)
+		if err != nil {
+			c.Errorf(err, "unsetting configuration params")
+			return err
+		}
+	}
+
 	// unexpose corresponding endpoints
 	if len(input.Unexpose) != 0 {
 		c.Tracef("Unexposing endpoints", map[string]interface{}{"endpoints": input.Unexpose})
@@ -989,60 +1480,135 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 		}
 	}
 
-	if input.Units != nil {
-		// TODO: Refactor this to a separate function
+	if input.Machines != nil || input.Units != nil {
 		modelType, err := c.ModelType(input.ModelName)
 		if err != nil {
 			return err
 		}
-		if modelType == model.CAAS {
-			_, err := applicationAPIClient.ScaleApplication(apiapplication.ScaleApplicationParams{
-				ApplicationName: input.AppName,
-				Scale:           *input.Units,
-				Force:           false,
-			})
-			if err != nil {
-				return err
+		if input.Machines != nil {
+			if modelType == model.CAAS {
+				return jujuerrors.NotSupportedf("placing units on machines in a kubernetes model")
 			}
+			err = c.updateUnitsMachines(applicationAPIClient, input.AppName, appStatus, input.Machines)
+		} else if modelType == model.CAAS {
+			err = c.scaleApplication(applicationAPIClient, input.AppName, *input.Units)
 		} else {
-			unitDiff := *input.Units - len(appStatus.Units)
+			err = c.updateUnits(applicationAPIClient, input.AppName, appStatus, *input.Units)
+		}
+		if err != nil {
+			return err
+		}
+	}
 
-			if unitDiff > 0 {
-				_, err := applicationAPIClient.AddUnits(apiapplication.AddUnitsParams{
-					ApplicationName: input.AppName,
-					NumUnits:        unitDiff,
-				})
-				if err != nil {
-					return err
-				}
-			}
+	return nil
+}
 
-			if unitDiff < 0 {
-				var unitNames []string
-				for unitName := range appStatus.Units {
-					unitNames = append(unitNames, unitName)
-				}
+// scaleApplication sets the scale of an application in a kubernetes
+// model. Sidecar charms are scaled by the controller, units must not
+// be added or removed one by one.
+func (c applicationsClient) scaleApplication(applicationAPIClient *apiapplication.Client, appName string, scale int) error {
+	c.Tracef("Scaling application", map[string]interface{}{"scale": scale})
+	_, err := applicationAPIClient.ScaleApplication(apiapplication.ScaleApplicationParams{
+		ApplicationName: appName,
+		Scale:           scale,
+		Force:           false,
+	})
+	return err
+}
 
-				unitAbs := int(math.Abs(float64(unitDiff)))
-				var unitsToDestroy []string
-				for i := 0; i < unitAbs; i++ {
-					unitsToDestroy = append(unitsToDestroy, unitNames[i])
-				}
-				_, err := applicationAPIClient.DestroyUnits(apiapplication.DestroyUnitsParams{
-					Units:          unitsToDestroy,
-					DestroyStorage: true,
-				})
-				if err != nil {
-					return err
-				}
+// updateUnits adds or removes units of an application in a machine
+// model until the number of units matches the requested one. Units
+// with the highest numbers are removed first.
+func (c applicationsClient) updateUnits(applicationAPIClient *apiapplication.Client, appName string, appStatus params.ApplicationStatus, units int) error {
+	unitDiff := units - len(appStatus.Units)
+
+	if unitDiff > 0 {
+		_, err := applicationAPIClient.AddUnits(apiapplication.AddUnitsParams{
+			ApplicationName: appName,
+			NumUnits:        unitDiff,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if unitDiff < 0 {
+		var unitNames []string
+		for unitName := range appStatus.Units {
+			unitNames = append(unitNames, unitName)
+		}
+		sort.Slice(unitNames, func(i, j int) bool {
+			return names.NewUnitTag(unitNames[i]).Number() > names.NewUnitTag(unitNames[j]).Number()
+		})
+
+		unitAbs := int(math.Abs(float64(unitDiff)))
+		unitsToDestroy := unitNames[:unitAbs]
+		_, err := applicationAPIClient.DestroyUnits(apiapplication.DestroyUnitsParams{
+			Units:          unitsToDestroy,
+			DestroyStorage: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateUnitsMachines ensures the application has exactly one unit on each
+// of the given machines. Units are added to machines without one, and units
+// placed on any other machine are destroyed.
+func (c applicationsClient) updateUnitsMachines(applicationAPIClient *apiapplication.Client, appName string, appStatus params.ApplicationStatus, machines []string) error {
+	wanted := set.NewStrings(machines...)
+	current := set.NewStrings()
+	var unitsToDestroy []string
+	for unitName, unitStatus := range appStatus.Units {
+		// destroy units on unwanted machines, and any extra
+		// unit on a wanted machine.
+		if !wanted.Contains(unitStatus.Machine) || current.Contains(unitStatus.Machine) {
+			unitsToDestroy = append(unitsToDestroy, unitName)
+			continue
+		}
+		current.Add(unitStatus.Machine)
+	}
+
+	toAdd := wanted.Difference(current).SortedValues()
+	if len(toAdd) > 0 {
+		placements := make([]*instance.Placement, len(toAdd))
+		for i, machine := range toAdd {
+			placement, err := instance.ParsePlacement(machine)
+			if err != nil {
+				return err
 			}
+			placements[i] = placement
+		}
+		c.Tracef("Adding units to machines", map[string]interface{}{"machines": toAdd})
+		_, err := applicationAPIClient.AddUnits(apiapplication.AddUnitsParams{
+			ApplicationName: appName,
+			NumUnits:        len(toAdd),
+			Placement:       placements,
+		})
+		if err != nil {
+			return err
 		}
 	}
 
+	if len(unitsToDestroy) > 0 {
+		sort.Strings(unitsToDestroy)
+		c.Tracef("Destroying units", map[string]interface{}{"units": unitsToDestroy})
+		_, err := applicationAPIClient.DestroyUnits(apiapplication.DestroyUnitsParams{
+			Units:          unitsToDestroy,
+			DestroyStorage: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (c applicationsClient) DestroyApplication(input *DestroyApplicationInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return err
@@ -1122,7 +1688,7 @@ func (c applicationsClient) computeSetCharmConfig(
 		msg := fmt.Sprintf("the new charm does not support the current architecture %q", oldOrigin.Architecture)
 		return nil, errors.New(msg)
 	}
-	if !basesContain(oldOrigin.Base, supportedBases) {
+	if !input.ForceBase && !basesContain(oldOrigin.Base, supportedBases) {
 		msg := fmt.Sprintf("the new charm does not support the current operating system %q", oldOrigin.Base.String())
 		return nil, errors.New(msg)
 	}
@@ -1138,7 +1704,7 @@ func (c applicationsClient) computeSetCharmConfig(
 		oldOrigin.Branch = newOrigin.Branch
 	}
 
-	resultOrigin, err := charmsAPIClient.AddCharm(resolvedURL, oldOrigin, false)
+	resultOrigin, err := charmsAPIClient.AddCharm(resolvedURL, oldOrigin, input.ForceBase)
 	if err != nil {
 		return nil, err
 	}
@@ -1148,7 +1714,7 @@ func (c applicationsClient) computeSetCharmConfig(
 		Origin: resultOrigin,
 	}
 
-	resourceIDs, err := c.updateResources(input.AppName, charmsAPIClient, apiCharmID, resourcesAPIClient)
+	resourceIDs, err := c.updateResources(input.AppName, input.Resources, charmsAPIClient, apiCharmID, resourcesAPIClient)
 	if err != nil {
 		return nil, err
 	}
@@ -1156,6 +1722,7 @@ func (c applicationsClient) computeSetCharmConfig(
 	toReturn := apiapplication.SetCharmConfig{
 		ApplicationName: input.AppName,
 		CharmID:         apiCharmID,
+		ForceBase:       input.ForceBase,
 		ResourceIDs:     resourceIDs,
 	}
 
@@ -1181,21 +1748,18 @@ func strPtr(in string) *string {
 	return &in
 }
 
-func (c applicationsClient) updateResources(appName string, charmsAPIClient *apicharms.Client,
+func (c applicationsClient) updateResources(appName string, userResources map[string]string, charmsAPIClient *apicharms.Client,
 	charmID apiapplication.CharmID, resourcesAPIClient *apiresources.Client) (map[string]string, error) {
 	meta, err := utils.GetMetaResources(charmID.URL, charmsAPIClient)
 	if err != nil {
 		return nil, err
 	}
-	// TODO (cderici): Provided resources for GetUpgradeResources are user inputs.
-	// It's a map[string]string that should come from the plan itself. We currently
-	// don't have a resources block in the charm.
 	filtered, err := utils.GetUpgradeResources(
 		charmID,
 		charmsAPIClient,
 		resourcesAPIClient,
 		appName,
-		nil,
+		userResources,
 		meta,
 	)
 	if err != nil {
@@ -1205,10 +1769,67 @@ func (c applicationsClient) updateResources(appName string, charmsAPIClient *api
 		return nil, nil
 	}
 
-	return addPendingResources(appName, filtered, charmID, resourcesAPIClient)
+	return addPendingResources(appName, filtered, userResources, charmID, resourcesAPIClient)
+}
+
+// updateApplicationResources attaches the given resources to an application
+// without changing its charm. Local files are uploaded directly, charmhub
+// revisions are added as pending resources and set by refreshing the
+// application to its current charm.
+func (c applicationsClient) updateApplicationResources(appName string, userResources map[string]string,
+	applicationAPIClient *apiapplication.Client, charmsAPIClient *apicharms.Client, resourcesAPIClient *apiresources.Client) error {
+	storeResources := make(map[string]string)
+	for name, value := range userResources {
+		filename, isFile := ResourceFilename(value)
+		if !isFile {
+			storeResources[name] = value
+			continue
+		}
+		c.Tracef("Uploading resource", map[string]interface{}{"name": name, "filename": filename})
+		if err := uploadResourceFile(resourcesAPIClient, appName, name, filename, ""); err != nil {
+			return err
+		}
+	}
+	if len(storeResources) == 0 {
+		return nil
+	}
+
+	charmURL, origin, err := applicationAPIClient.GetCharmURLOrigin("", appName)
+	if err != nil {
+		return err
+	}
+	charmID := apiapplication.CharmID{
+		URL:    charmURL,
+		Origin: origin,
+	}
+	meta, err := utils.GetMetaResources(charmURL, charmsAPIClient)
+	if err != nil {
+		return err
+	}
+	toAdd := make(map[string]charmresources.Meta)
+	for name := range storeResources {
+		resourceMeta, found := meta[name]
+		if !found {
+			return jujuerrors.NotFoundf("resource %q in charm %q", name, charmURL.Name)
+		}
+		toAdd[name] = resourceMeta
+	}
+	resourceIDs, err := addPendingResources(appName, toAdd, storeResources, charmID, resourcesAPIClient)
+	if err != nil {
+		return err
+	}
+	c.Tracef("Calling SetCharm to update resources", map[string]interface{}{"resources": storeResources})
+	return applicationAPIClient.SetCharm(model.GenerationMaster, apiapplication.SetCharmConfig{
+		ApplicationName: appName,
+		CharmID:         charmID,
+		ResourceIDs:     resourceIDs,
+	})
 }
 
-func addPendingResources(appName string, resourcesToBeAdded map[string]charmresources.Meta,
+// addPendingResources adds the given charm resources as pending resources
+// of the application. By default the latest charmhub revision is used,
+// userResources may specify a revision or a local file to upload instead.
+func addPendingResources(appName string, resourcesToBeAdded map[string]charmresources.Meta, userResources map[string]string,
 	charmID apiapplication.CharmID, resourcesAPIClient *apiresources.Client) (map[string]string, error) {
 	pendingResources := []charmresources.Resource{}
 	for _, v := range resourcesToBeAdded {
@@ -1217,6 +1838,18 @@ func addPendingResources(appName string, resourcesToBeAdded map[string]charmreso
 			Origin:   charmresources.OriginStore,
 			Revision: -1,
 		}
+		if value, found := userResources[v.Name]; found {
+			if _, isFile := ResourceFilename(value); isFile {
+				aux.Origin = charmresources.OriginUpload
+				aux.Revision = 0
+			} else {
+				revision, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, jujuerrors.NotValidf("revision %q for resource %q", value, v.Name)
+				}
+				aux.Revision = revision
+			}
+		}
 		pendingResources = append(pendingResources, aux)
 	}
 
@@ -1234,11 +1867,175 @@ func addPendingResources(appName string, resourcesToBeAdded map[string]charmreso
 		return nil, typedError(err)
 	}
 
-	// now build a map with the resource name and the corresponding UUID
+	// now build a map with the resource name and the corresponding UUID,
+	// uploading the content of local files.
 	toReturn := map[string]string{}
 	for i, argsResource := range pendingResources {
 		toReturn[argsResource.Meta.Name] = toRequest[i]
+		if argsResource.Origin != charmresources.OriginUpload {
+			continue
+		}
+		filename, _ := ResourceFilename(userResources[argsResource.Meta.Name])
+		if err := uploadResourceFile(resourcesAPIClient, appName, argsResource.Meta.Name, filename, toRequest[i]); err != nil {
+			return nil, err
+		}
 	}
 
 	return toReturn, nil
 }
+
+type ReadApplicationResourceInput struct {
+	ModelName string
+	AppName   string
+	Name      string
+}
+
+// ReadApplicationResource returns the resource of an application with
+// the given name. A NotFound error is returned if either the
+// application or its resource does not exist.
+func (c applicationsClient) ReadApplicationResource(input ReadApplicationResourceInput) (*ResourceEntry, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	resourcesAPIClient, err := apiresources.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+	appResources, err := resourcesAPIClient.ListResources([]string{input.AppName})
+	if err != nil {
+		return nil, typedError(err)
+	}
+	if len(appResources) != 1 {
+		return nil, jujuerrors.NotFoundf("application %q", input.AppName)
+	}
+	for _, res := range appResources[0].Resources {
+		if res.Name != input.Name {
+			continue
+		}
+		return &ResourceEntry{
+			Revision:    res.Revision,
+			Fingerprint: res.Fingerprint.Hex(),
+			Uploaded:    res.Origin == charmresources.OriginUpload,
+		}, nil
+	}
+	return nil, jujuerrors.NotFoundf("resource %q of application %q", input.Name, input.AppName)
+}
+
+// ResourceEntry describes a resource of a deployed application.
+type ResourceEntry struct {
+	// Revision is the charmhub revision of the resource, it is
+	// zero for uploaded resources.
+	Revision int
+	// Fingerprint is the hex encoded SHA-384 checksum of the
+	// resource content.
+	Fingerprint string
+	// Uploaded is true if the resource content was uploaded
+	// rather than downloaded from charmhub.
+	Uploaded bool
+}
+
+// resourceFilePrefix indicates a resource value refers to a local file.
+const resourceFilePrefix = "file:"
+
+// ResourceFilename returns the name of the local file referred to by
+// the resource value, and whether the value refers to a local file.
+func ResourceFilename(value string) (string, bool) {
+	if !strings.HasPrefix(value, resourceFilePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(value, resourceFilePrefix), true
+}
+
+// ResourceFileFingerprint returns the hex encoded SHA-384 checksum of
+// the given file, as computed by juju for uploaded resources.
+func ResourceFileFingerprint(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	fingerprint, err := charmresources.GenerateFingerprint(f)
+	if err != nil {
+		return "", err
+	}
+	return fingerprint.Hex(), nil
+}
+
+// validateResourceValue checks the resource value is either a
+// revision number or a local file.
+func validateResourceValue(name, value string) error {
+	if filename, isFile := ResourceFilename(value); isFile {
+		if filename == "" {
+			return jujuerrors.NotValidf("empty filename for resource %q", name)
+		}
+		return nil
+	}
+	if _, err := strconv.Atoi(value); err != nil {
+		return jujuerrors.NotValidf("resource %q value %q, expected a revision number or %q followed by a filename", name, value, resourceFilePrefix)
+	}
+	return nil
+}
+
+// uploadResourceFile uploads the content of a local file as the given
+// application resource. A pendingID is required for resources added
+// before the application is deployed or refreshed.
+func uploadResourceFile(resourcesAPIClient *apiresources.Client, appName, name, filename, pendingID string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return jujuerrors.Annotatef(err, "unable to open resource %q", name)
+	}
+	defer func() { _ = f.Close() }()
+	return resourcesAPIClient.Upload(appName, name, filename, pendingID, f)
+}
+
+// ReadApplicationStatus returns the status of an application and of
+// its units, as `juju status` does.
+func (c applicationsClient) ReadApplicationStatus(input *ReadApplicationStatusInput) (*ReadApplicationStatusResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	clientAPIClient := apiclient.NewClient(conn, c.JujuLogger())
+
+	status, err := clientAPIClient.Status(nil)
+	if err != nil {
+		return nil, err
+	}
+	appStatus, ok := status.Applications[input.AppName]
+	if !ok {
+		return nil, jujuerrors.NotFoundf("application %q", input.AppName)
+	}
+	return &ReadApplicationStatusResponse{
+		Status:  appStatus.Status.Status,
+		Message: appStatus.Status.Info,
+		Units:   applicationUnits(input.AppName, appStatus, status.Applications),
+	}, nil
+}
+
+// ListApplications returns the names of the applications of a model,
+// sorted. The applications consumed from offers are not included.
+func (c applicationsClient) ListApplications(input ListApplicationsInput) ([]string, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	clientAPIClient := apiclient.NewClient(conn, c.JujuLogger())
+
+	status, err := clientAPIClient.Status(nil)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(status.Applications))
+	for name := range status.Applications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
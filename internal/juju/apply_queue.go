@@ -0,0 +1,105 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"sync"
+)
+
+// applyGroupKey is the key of the operations of a group: the operations
+// destroying resources are ordered apart from those applying them.
+type applyGroupKey struct {
+	group   string
+	destroy bool
+}
+
+// applyOperation is an operation waiting its turn or in progress.
+type applyOperation struct {
+	priority int64
+}
+
+// ApplyQueue orders the operations of the resources terraform applies
+// at the same time. Within a group, the resources of a lower priority
+// are applied first, and destroyed last: an operation waits until the
+// operations of the group of a lower priority, or of a higher one when
+// destroying, are done. Operations of the same priority run at the same
+// time.
+//
+// The ordering is best effort: terraform does not tell which operations
+// a run holds, an operation is only ordered with the operations of its
+// group already in progress when it starts. An operation started after
+// the operations it should follow are done, or before they start, is
+// not waited for.
+type ApplyQueue struct {
+	mu sync.Mutex
+	// changed is closed, and replaced, each time an operation is done.
+	changed chan struct{}
+	pending map[applyGroupKey]map[*applyOperation]struct{}
+}
+
+func NewApplyQueue() *ApplyQueue {
+	return &ApplyQueue{
+		changed: make(chan struct{}),
+		pending: make(map[applyGroupKey]map[*applyOperation]struct{}),
+	}
+}
+
+// Enter waits the turn of an operation of the priority in the group,
+// among the operations of the group in progress, and returns the
+// function to call once the operation is done. The operations without a
+// group are not ordered, they do not wait.
+func (q *ApplyQueue) Enter(ctx context.Context, group string, priority int64, destroy bool) (func(), error) {
+	if group == "" {
+		return func() {}, nil
+	}
+	key := applyGroupKey{group: group, destroy: destroy}
+	if destroy {
+		priority = -priority
+	}
+	op := &applyOperation{priority: priority}
+
+	q.mu.Lock()
+	if q.pending[key] == nil {
+		q.pending[key] = make(map[*applyOperation]struct{})
+	}
+	q.pending[key][op] = struct{}{}
+	q.mu.Unlock()
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			delete(q.pending[key], op)
+			if len(q.pending[key]) == 0 {
+				delete(q.pending, key)
+			}
+			close(q.changed)
+			q.changed = make(chan struct{})
+		})
+	}
+
+	for {
+		q.mu.Lock()
+		waiting := false
+		for other := range q.pending[key] {
+			if other.priority < op.priority {
+				waiting = true
+				break
+			}
+		}
+		changed := q.changed
+		q.mu.Unlock()
+		if !waiting {
+			return release, nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/juju/charm/v11"
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apiapplication "github.com/juju/juju/api/client/application"
+	apicharms "github.com/juju/juju/api/client/charms"
+	apimodelconfig "github.com/juju/juju/api/client/modelconfig"
+	"github.com/juju/juju/charmhub"
+	"github.com/juju/juju/cmd/juju/application/utils"
+	"github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+)
+
+type bundlesClient struct {
+	SharedClient
+
+	applications *applicationsClient
+	integrations *integrationsClient
+}
+
+type DeployBundleInput struct {
+	ModelName string
+	// Bundle is the name of a Charmhub bundle, or the path of a
+	// local bundle file, directory or archive.
+	Bundle   string
+	Channel  string
+	Revision int
+	// Overlays are the paths of local overlay files, applied in
+	// order on top of the bundle.
+	Overlays []string
+}
+
+type DeployBundleResponse struct {
+	// Applications are the applications deployed, also when
+	// deploying the bundle failed part way.
+	Applications []string
+}
+
+type ReadBundleInput struct {
+	ModelName    string
+	Applications []string
+}
+
+type ReadBundleResponse struct {
+	// Applications are the applications of the bundle which are
+	// still in the model.
+	Applications []string
+}
+
+type DestroyBundleInput struct {
+	ModelName    string
+	Applications []string
+}
+
+func newBundlesClient(sc SharedClient) *bundlesClient {
+	return &bundlesClient{
+		SharedClient: sc,
+		applications: newApplicationClient(sc),
+		integrations: newIntegrationsClient(sc),
+	}
+}
+
+// DeployBundle deploys the applications of a bundle, with its overlays
+// applied, and integrates them. Placement directives, machines, offers
+// and saas of the bundle are not supported.
+func (c *bundlesClient) DeployBundle(ctx context.Context, input DeployBundleInput) (*DeployBundleResponse, error) {
+	defer c.InvalidateModelStatus(input.ModelName)
+
+	data, basePath, err := c.readBundleData(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBundleSupported(data); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(data.Applications))
+	for name := range data.Applications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	response := DeployBundleResponse{}
+	for _, name := range names {
+		appInput, err := bundleApplicationInput(input.ModelName, name, data, basePath)
+		if err != nil {
+			return &response, err
+		}
+		c.Tracef(fmt.Sprintf("deploying application %q of bundle %q", name, input.Bundle))
+		if _, err := c.applications.CreateApplication(ctx, appInput); err != nil {
+			return &response, errors.Annotatef(err, "deploying application %q", name)
+		}
+		response.Applications = append(response.Applications, name)
+	}
+
+	for _, relation := range data.Relations {
+		apps := make([]string, 0, len(relation))
+		for _, endpoint := range relation {
+			app, _, _ := strings.Cut(endpoint, ":")
+			apps = append(apps, app)
+		}
+		c.Tracef(fmt.Sprintf("integrating %q of bundle %q", relation, input.Bundle))
+		if _, err := c.integrations.CreateIntegration(&IntegrationInput{
+			ModelName: input.ModelName,
+			Apps:      apps,
+			Endpoints: relation,
+		}); err != nil {
+			return &response, errors.Annotatef(err, "integrating %q", relation)
+		}
+	}
+	return &response, nil
+}
+
+// ReadBundle returns the applications of a bundle which are still in
+// the model.
+func (c *bundlesClient) ReadBundle(input ReadBundleInput) (*ReadBundleResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apiapplication.NewClient(conn)
+
+	tags := make([]names.ApplicationTag, 0, len(input.Applications))
+	for _, name := range input.Applications {
+		tags = append(tags, names.NewApplicationTag(name))
+	}
+	results, err := client.ApplicationsInfo(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	response := ReadBundleResponse{}
+	for i, result := range results {
+		if result.Error != nil {
+			if errors.Is(typedError(result.Error), errors.NotFound) {
+				continue
+			}
+			return nil, result.Error
+		}
+		response.Applications = append(response.Applications, input.Applications[i])
+	}
+	return &response, nil
+}
+
+// DestroyBundle removes the applications of a bundle, and their
+// storage, from the model.
+func (c *bundlesClient) DestroyBundle(input DestroyBundleInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
+	for _, name := range input.Applications {
+		err := c.applications.DestroyApplication(&DestroyApplicationInput{
+			ApplicationName: name,
+			ModelName:       input.ModelName,
+		})
+		if err != nil && !errors.Is(typedError(err), errors.NotFound) {
+			return errors.Annotatef(err, "removing application %q", name)
+		}
+	}
+	return nil
+}
+
+// readBundleData returns the bundle data, with the overlays applied,
+// and the directory the relative paths of the bundle are resolved from.
+func (c *bundlesClient) readBundleData(ctx context.Context, input DeployBundleInput) (*charm.BundleData, string, error) {
+	bundlePath := input.Bundle
+	basePath := ""
+	if _, err := os.Stat(input.Bundle); err == nil {
+		basePath = filepath.Dir(input.Bundle)
+	} else {
+		dir, err := os.MkdirTemp("", "juju-bundle")
+		if err != nil {
+			return nil, "", err
+		}
+		defer func() { _ = os.RemoveAll(dir) }()
+
+		bundlePath = filepath.Join(dir, "bundle.zip")
+		if err := c.downloadBundle(ctx, input, bundlePath); err != nil {
+			return nil, "", err
+		}
+	}
+
+	sources := make([]charm.BundleDataSource, 0, len(input.Overlays)+1)
+	source, err := charm.LocalBundleDataSource(bundlePath)
+	if err != nil {
+		return nil, "", errors.Annotatef(err, "reading bundle %q", input.Bundle)
+	}
+	sources = append(sources, source)
+	for _, overlay := range input.Overlays {
+		source, err := charm.LocalBundleDataSource(overlay)
+		if err != nil {
+			return nil, "", errors.Annotatef(err, "reading overlay %q", overlay)
+		}
+		sources = append(sources, source)
+	}
+	data, err := charm.ReadAndMergeBundleData(sources...)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, basePath, nil
+}
+
+// downloadBundle resolves a Charmhub bundle with the controller, and
+// downloads its archive.
+func (c *bundlesClient) downloadBundle(ctx context.Context, input DeployBundleInput, archivePath string) error {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	downloadURL, err := bundleDownloadURL(conn, input)
+	if err != nil {
+		return err
+	}
+	client, err := charmhub.NewClient(charmhub.Config{
+		Logger: loggo.GetLogger(LogJujuClient),
+	})
+	if err != nil {
+		return err
+	}
+	return client.Download(ctx, downloadURL, archivePath)
+}
+
+func bundleDownloadURL(conn api.Connection, input DeployBundleInput) (*url.URL, error) {
+	charmsAPIClient := apicharms.NewClient(conn)
+	modelconfigAPIClient := apimodelconfig.NewClient(conn)
+
+	channel, err := charm.ParseChannel(input.Channel)
+	if err != nil {
+		return nil, err
+	}
+	bundleURL, err := resolveCharmURL(input.Bundle)
+	if err != nil {
+		return nil, err
+	}
+	if input.Revision != UnspecifiedRevision {
+		bundleURL = bundleURL.WithRevision(input.Revision)
+	}
+	modelCons, err := modelconfigAPIClient.GetModelConstraints()
+	if err != nil {
+		return nil, err
+	}
+	platform := utils.MakePlatform(constraints.Value{}, base.Base{}, modelCons)
+	origin, err := utils.DeduceOrigin(bundleURL, channel, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedURL, resolvedOrigin, _, err := resolveCharm(charmsAPIClient, bundleURL, origin)
+	if err != nil {
+		return nil, err
+	}
+	if resolvedOrigin.Type != "bundle" {
+		return nil, errors.NotValidf("bundle %q, it is a charm,", input.Bundle)
+	}
+	info, err := charmsAPIClient.GetDownloadInfo(resolvedURL, resolvedOrigin)
+	if err != nil {
+		return nil, err
+	}
+	return url.Parse(info.URL)
+}
+
+func checkBundleSupported(data *charm.BundleData) error {
+	if len(data.Machines) > 0 {
+		return errors.NotSupportedf("machines of bundles")
+	}
+	if len(data.Saas) > 0 {
+		return errors.NotSupportedf("saas of bundles")
+	}
+	for name, app := range data.Applications {
+		if len(app.To) > 0 {
+			return errors.NotSupportedf("placement of application %q of bundles", name)
+		}
+		if len(app.Offers) > 0 {
+			return errors.NotSupportedf("offers of application %q of bundles", name)
+		}
+	}
+	return nil
+}
+
+// bundleApplicationInput returns the input to deploy an application of
+// a bundle. Local resources of the bundle are relative to basePath.
+func bundleApplicationInput(modelName, name string, data *charm.BundleData, basePath string) (*CreateApplicationInput, error) {
+	app := data.Applications[name]
+	if charm.Local.Matches(app.Charm) || strings.HasPrefix(app.Charm, ".") || filepath.IsAbs(app.Charm) {
+		return nil, errors.NotSupportedf("local charm of application %q of bundles", name)
+	}
+
+	input := CreateApplicationInput{
+		ApplicationName: name,
+		ModelName:       modelName,
+		CharmName:       app.Charm,
+		CharmChannel:    app.Channel,
+		CharmBase:       app.Base,
+		CharmSeries:     app.Series,
+		CharmRevision:   UnspecifiedRevision,
+		Units:           app.NumUnits,
+		Trust:           app.RequiresTrust,
+	}
+	if input.CharmBase == "" && input.CharmSeries == "" {
+		input.CharmBase = data.DefaultBase
+		input.CharmSeries = data.Series
+	}
+	if app.Revision != nil {
+		input.CharmRevision = *app.Revision
+	}
+	if app.Scale_ > 0 {
+		input.Units = app.Scale_
+	}
+	if app.Expose {
+		input.Expose = map[string]interface{}{}
+	}
+	if len(app.Options) > 0 {
+		input.Config = make(map[string]string, len(app.Options))
+		for key, value := range app.Options {
+			input.Config[key] = fmt.Sprintf("%v", value)
+		}
+	}
+	if app.Constraints != "" {
+		cons, err := constraints.Parse(app.Constraints)
+		if err != nil {
+			return nil, errors.Annotatef(err, "parsing constraints of application %q", name)
+		}
+		input.Constraints = cons
+	}
+	if len(app.Resources) > 0 {
+		input.Resources = make(map[string]string, len(app.Resources))
+		for resource, value := range app.Resources {
+			switch value := value.(type) {
+			case string:
+				if basePath == "" {
+					return nil, errors.NotSupportedf("local resource %q of application %q of Charmhub bundles", resource, name)
+				}
+				if !filepath.IsAbs(value) {
+					value = filepath.Join(basePath, value)
+				}
+				input.Resources[resource] = resourceFilePrefix + value
+			default:
+				input.Resources[resource] = fmt.Sprintf("%v", value)
+			}
+		}
+	}
+	return &input, nil
+}
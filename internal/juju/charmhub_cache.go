@@ -0,0 +1,149 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// charmhubCacheTTL is how long a Charmhub query is read from the on-disk
+// cache, the in-memory cache lasts for the run of the provider.
+const charmhubCacheTTL = 10 * time.Minute
+
+// charmhubQuery is a Charmhub query of a run. done is closed once the
+// query is answered.
+type charmhubQuery struct {
+	done     chan struct{}
+	response *ReadCharmResponse
+	err      error
+}
+
+// charmhubCacheEntry is the on-disk form of a Charmhub query.
+type charmhubCacheEntry struct {
+	Key      string             `json:"key"`
+	ReadAt   time.Time          `json:"read-at"`
+	Response *ReadCharmResponse `json:"response"`
+}
+
+// charmhubCache caches the Charmhub queries of the charms resolved by
+// a run, keyed by charm, channel, base and architecture: the resources
+// of a large plan resolving the same charms query Charmhub once, rather
+// than once each, and are not rate limited. When dir is set, the
+// queries are also cached on disk for charmhubCacheTTL, to be shared by
+// the runs of the provider.
+type charmhubCache struct {
+	dir string
+
+	mu      sync.Mutex
+	queries map[string]*charmhubQuery
+}
+
+func newCharmhubCache(dir string) *charmhubCache {
+	return &charmhubCache{
+		dir:     dir,
+		queries: make(map[string]*charmhubQuery),
+	}
+}
+
+// charmhubCacheKey returns the key of the query of a charm.
+func charmhubCacheKey(name, channel, base, architecture string) string {
+	return strings.Join([]string{name, channel, base, architecture}, "|")
+}
+
+// get returns the response cached for the key, or the response of
+// query. Concurrent gets of a key wait for a single query. Failed
+// queries are not cached. The response is shared and must not be
+// modified.
+func (c *charmhubCache) get(key string, query func() (*ReadCharmResponse, error)) (*ReadCharmResponse, error) {
+	c.mu.Lock()
+	if entry, ok := c.queries[key]; ok {
+		c.mu.Unlock()
+		<-entry.done
+		return entry.response, entry.err
+	}
+	entry := &charmhubQuery{done: make(chan struct{})}
+	c.queries[key] = entry
+	c.mu.Unlock()
+
+	entry.response = c.readDisk(key)
+	if entry.response == nil {
+		entry.response, entry.err = query()
+		if entry.err == nil {
+			c.writeDisk(key, entry.response)
+		}
+	}
+	if entry.err != nil {
+		c.mu.Lock()
+		delete(c.queries, key)
+		c.mu.Unlock()
+	}
+	close(entry.done)
+	return entry.response, entry.err
+}
+
+func (c *charmhubCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// readDisk returns the response cached on disk for the key, nil when
+// there is none or it has expired. The on-disk cache is best effort,
+// it is skipped when it cannot be read.
+func (c *charmhubCache) readDisk(key string) *ReadCharmResponse {
+	if c.dir == "" {
+		return nil
+	}
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil
+	}
+	var entry charmhubCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil
+	}
+	if entry.Key != key || time.Since(entry.ReadAt) > charmhubCacheTTL {
+		return nil
+	}
+	return entry.Response
+}
+
+// writeDisk caches the response on disk for the key. The file is
+// renamed into place, as other runs may read it meanwhile.
+func (c *charmhubCache) writeDisk(key string, response *ReadCharmResponse) {
+	if c.dir == "" {
+		return
+	}
+	data, err := json.Marshal(charmhubCacheEntry{
+		Key:      key,
+		ReadAt:   time.Now(),
+		Response: response,
+	})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return
+	}
+	f, err := os.CreateTemp(c.dir, ".charmhub-*")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), c.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+}
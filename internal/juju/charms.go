@@ -0,0 +1,169 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juju/charm/v11"
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/juju/charmhub"
+	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/base"
+	"github.com/juju/loggo"
+)
+
+type charmsClient struct {
+	SharedClient
+
+	cache *charmhubCache
+}
+
+type ReadCharmInput struct {
+	Name    string
+	Channel string
+	// Base selects the revision released for the base, e.g.
+	// "ubuntu@22.04". Any base of the channel is used when empty.
+	Base         string
+	Architecture string
+}
+
+type ReadCharmResponse struct {
+	Name         string
+	Channel      string
+	Architecture string
+	Revision     int
+	// Bases are the bases supported by the revision, e.g.
+	// "ubuntu@22.04".
+	Bases []string
+	// Config holds the config options of the charm, keyed by name.
+	Config map[string]CharmConfigOption
+	// Resources maps the charm resource names to the revision
+	// released with the charm.
+	Resources map[string]int
+}
+
+// CharmConfigOption describes a config option of a charm.
+type CharmConfigOption struct {
+	Type        string
+	Default     string
+	Description string
+}
+
+func newCharmsClient(sc SharedClient, cacheDir string) *charmsClient {
+	return &charmsClient{
+		SharedClient: sc,
+		cache:        newCharmhubCache(cacheDir),
+	}
+}
+
+// ReadCharm resolves a charm in a channel of Charmhub, as `juju info`
+// does. Charmhub is queried directly, a controller connection is not
+// required. The queries are cached by the client, see charmhubCache.
+func (c *charmsClient) ReadCharm(ctx context.Context, input ReadCharmInput) (*ReadCharmResponse, error) {
+	channel, err := charm.ParseChannelNormalize(input.Channel)
+	if err != nil {
+		return nil, err
+	}
+	refreshBase := charmhub.RefreshBase{
+		Architecture: input.Architecture,
+	}
+	if refreshBase.Architecture == "" {
+		refreshBase.Architecture = arch.DefaultArchitecture
+	}
+	if input.Base != "" {
+		parsed, err := base.ParseBaseFromString(input.Base)
+		if err != nil {
+			return nil, err
+		}
+		refreshBase.Name = parsed.OS
+		refreshBase.Channel = parsed.Channel.Track
+	}
+
+	key := charmhubCacheKey(input.Name, channel.String(), input.Base, refreshBase.Architecture)
+	return c.cache.get(key, func() (*ReadCharmResponse, error) {
+		return c.queryCharm(ctx, input.Name, channel, refreshBase)
+	})
+}
+
+// queryCharm queries Charmhub for a charm in a channel, for the base.
+func (c *charmsClient) queryCharm(ctx context.Context, name string, channel charm.Channel, refreshBase charmhub.RefreshBase) (*ReadCharmResponse, error) {
+	client, err := charmhub.NewClient(charmhub.Config{
+		Logger: loggo.GetLogger(LogJujuClient),
+	})
+	if err != nil {
+		return nil, err
+	}
+	config, err := charmhub.InstallOneFromChannel(name, channel.String(), refreshBase)
+	if err != nil {
+		return nil, err
+	}
+	responses, err := client.Refresh(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) != 1 {
+		return nil, fmt.Errorf("expected only one charm, received %d", len(responses))
+	}
+	if responses[0].Error != nil {
+		return nil, typedError(errors.Errorf("charm %q in channel %q: %s", name, channel.String(), responses[0].Error.Message))
+	}
+	entity := responses[0].Entity
+	if entity.Type != "charm" {
+		return nil, errors.NotValidf("%q of type %q, expected a charm", name, entity.Type)
+	}
+
+	bases := set.NewStrings()
+	for _, b := range entity.Bases {
+		if b.Architecture != refreshBase.Architecture && b.Architecture != "all" {
+			continue
+		}
+		parsed, err := base.ParseBase(b.Name, b.Channel)
+		if err != nil {
+			return nil, err
+		}
+		bases.Add(parsed.String())
+	}
+
+	options := make(map[string]CharmConfigOption)
+	if strings.TrimSpace(entity.ConfigYAML) != "" {
+		charmConfig, err := charm.ReadConfig(strings.NewReader(entity.ConfigYAML))
+		if err != nil {
+			return nil, errors.Annotatef(err, "reading config of charm %q", name)
+		}
+		for name, option := range charmConfig.Options {
+			value := ""
+			if option.Default != nil {
+				value = fmt.Sprintf("%v", option.Default)
+			}
+			options[name] = CharmConfigOption{
+				Type:        option.Type,
+				Default:     value,
+				Description: option.Description,
+			}
+		}
+	}
+
+	resources := make(map[string]int, len(entity.Resources))
+	for _, resource := range entity.Resources {
+		resources[resource.Name] = resource.Revision
+	}
+
+	effectiveChannel := responses[0].EffectiveChannel
+	if effectiveChannel == "" {
+		effectiveChannel = channel.String()
+	}
+	return &ReadCharmResponse{
+		Name:         entity.Name,
+		Channel:      effectiveChannel,
+		Architecture: refreshBase.Architecture,
+		Revision:     entity.Revision,
+		Bases:        bases.SortedValues(),
+		Config:       options,
+		Resources:    resources,
+	}, nil
+}
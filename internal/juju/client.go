@@ -7,15 +7,21 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/juju/errors"
 	"github.com/juju/juju/api"
+	apiclient "github.com/juju/juju/api/client/client"
 	"github.com/juju/juju/api/client/modelmanager"
 	"github.com/juju/juju/api/connector"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v4"
+	"github.com/juju/utils/v3"
+	"github.com/juju/version/v2"
 )
 
 const (
@@ -33,26 +39,81 @@ type ControllerConfiguration struct {
 	Username            string
 	Password            string
 	CACert              string
+	// CharmhubCacheDir is the directory where the Charmhub queries
+	// are cached between runs, they are only cached in memory when
+	// empty.
+	CharmhubCacheDir string
 }
 
 type Client struct {
-	Applications applicationsClient
-	Machines     machinesClient
-	Credentials  credentialsClient
-	Integrations integrationsClient
-	Models       modelsClient
-	Offers       offersClient
-	SSHKeys      sshKeysClient
-	Users        usersClient
+	// Offline is set when the controller is unreachable while
+	// planning: the resources keep their prior state rather than
+	// being read from the controller.
+	Offline bool
+	// FailOnMissingResources is set when the reads of resources
+	// removed outside of terraform fail, rather than removing them
+	// from the state.
+	FailOnMissingResources bool
+	// ControllerVersion is the version of Juju the controller
+	// runs, zero when it is unknown.
+	ControllerVersion version.Number
+	// ApplyQueue orders the resources applied at the same time
+	// by their apply group and priority.
+	ApplyQueue *ApplyQueue
+
+	Applications   applicationsClient
+	Bundles        bundlesClient
+	Charms         charmsClient
+	Machines       machinesClient
+	Clouds         cloudsClient
+	Controller     controllerClient
+	Credentials    credentialsClient
+	Exec           execClient
+	FirewallRules  firewallRulesClient
+	Integrations   integrationsClient
+	JAAS           jaasClient
+	Models         modelsClient
+	ModelDefaults  modelDefaultsClient
+	Offers         offersClient
+	Secrets        secretsClient
+	SecretBackends secretBackendsClient
+	SSHKeys        sshKeysClient
+	Spaces         spacesClient
+	Storage        storageClient
+	Users          usersClient
 }
 
 type jujuModel struct {
+	name string
+	// owner is empty when the model is owned by the current user.
+	owner     string
 	uuid      string
 	modelType model.ModelType
 }
 
 func (j jujuModel) String() string {
-	return fmt.Sprintf("uuid(%s) type(%s)", j.uuid, j.modelType.String())
+	return fmt.Sprintf("name(%s) uuid(%s) type(%s)", j.name, j.uuid, j.modelType.String())
+}
+
+// ResolvedModel is a model given by its name, qualified or not, or by
+// its UUID.
+type ResolvedModel struct {
+	// Name is the name of the model, not qualified by its owner.
+	Name string
+	// Owner is the name of the owner of the model, empty when the
+	// model is owned by the current user.
+	Owner string
+	UUID  string
+	Type  model.ModelType
+}
+
+// Reference returns the name of the model, qualified by the name of its
+// owner when it is owned by another user, as models are given by name.
+func (m ResolvedModel) Reference() string {
+	if m.Owner == "" {
+		return m.Name
+	}
+	return QualifiedModelName(m.Owner, m.Name)
 }
 
 type SharedClient interface {
@@ -60,6 +121,9 @@ type SharedClient interface {
 	GetConnection(modelName *string) (api.Connection, error)
 	ModelType(modelName string) (model.ModelType, error)
 	ModelUUID(modelName string) (string, error)
+	ResolveModel(modelName string) (ResolvedModel, error)
+	ReadModelStatus(modelName string, conn api.Connection, cached bool) (*params.FullStatus, error)
+	InvalidateModelStatus(modelName string)
 	RemoveModel(modelUUID string)
 
 	JujuLogger() *jujuLoggerShim
@@ -76,6 +140,12 @@ type sharedClient struct {
 	modelUUIDcache map[string]jujuModel
 	modelUUIDmu    sync.Mutex
 
+	// statusCache holds the statuses of the models shared by
+	// the reads of a refresh, keyed by model UUID: a model may be
+	// given by its name, its qualified name or its UUID.
+	statusCache map[string]*modelStatus
+	statusMu    sync.Mutex
+
 	// subCtx is the context created with the new tflog subsystem for applications.
 	subCtx context.Context
 }
@@ -90,18 +160,32 @@ func NewClient(ctx context.Context, config ControllerConfiguration) (*Client, er
 	sc := &sharedClient{
 		controllerConfig: config,
 		modelUUIDcache:   make(map[string]jujuModel),
+		statusCache:      make(map[string]*modelStatus),
 		subCtx:           tflog.NewSubsystem(ctx, LogJujuClient),
 	}
 
 	return &Client{
-		Applications: *newApplicationClient(sc),
-		Credentials:  *newCredentialsClient(sc),
-		Integrations: *newIntegrationsClient(sc),
-		Machines:     *newMachinesClient(sc),
-		Models:       *newModelsClient(sc),
-		Offers:       *newOffersClient(sc),
-		SSHKeys:      *newSSHKeysClient(sc),
-		Users:        *newUsersClient(sc),
+		ApplyQueue:     NewApplyQueue(),
+		Applications:   *newApplicationClient(sc),
+		Bundles:        *newBundlesClient(sc),
+		Charms:         *newCharmsClient(sc, config.CharmhubCacheDir),
+		Clouds:         *newCloudsClient(sc),
+		Controller:     *newControllerClient(sc),
+		Credentials:    *newCredentialsClient(sc),
+		Exec:           *newExecClient(sc),
+		FirewallRules:  *newFirewallRulesClient(sc),
+		Integrations:   *newIntegrationsClient(sc),
+		JAAS:           *newJAASClient(sc),
+		Machines:       *newMachinesClient(sc),
+		Models:         *newModelsClient(sc),
+		ModelDefaults:  *newModelDefaultsClient(sc),
+		Offers:         *newOffersClient(sc),
+		Secrets:        *newSecretsClient(sc),
+		SecretBackends: *newSecretBackendsClient(sc),
+		SSHKeys:        *newSSHKeysClient(sc),
+		Spaces:         *newSpacesClient(sc),
+		Storage:        *newStorageClient(sc),
+		Users:          *newUsersClient(sc),
 	}, nil
 }
 
@@ -144,32 +228,69 @@ func (sc *sharedClient) GetConnection(modelName *string) (api.Connection, error)
 }
 
 func (sc *sharedClient) ModelUUID(modelName string) (string, error) {
+	resolved, err := sc.ResolveModel(modelName)
+	if err != nil {
+		return "", err
+	}
+	return resolved.UUID, nil
+}
+
+// ResolveModel returns the name, the owner, the UUID and the type of a
+// model given by its name, qualified or not, or by its UUID. The model
+// is read from the model info cache, which is filled from the juju
+// controller when the model is not found in it.
+func (sc *sharedClient) ResolveModel(modelName string) (ResolvedModel, error) {
 	sc.modelUUIDmu.Lock()
 	defer sc.modelUUIDmu.Unlock()
+	found, err := sc.resolveModel(modelName)
+	if err != nil {
+		return ResolvedModel{}, err
+	}
+	return ResolvedModel{
+		Name:  found.name,
+		Owner: found.owner,
+		UUID:  found.uuid,
+		Type:  found.modelType,
+	}, nil
+}
+
+// resolveModel returns the model with the given name or UUID.
+// Callers are expected to hold the modelUUIDmu lock.
+func (sc *sharedClient) resolveModel(modelName string) (jujuModel, error) {
 	dataMap := make(map[string]interface{})
 	// How to tell if logging level is Trace?
 	for k, v := range sc.modelUUIDcache {
 		dataMap[k] = v.String()
 	}
 	sc.Tracef(fmt.Sprintf("ModelUUID cache looking for %q", modelName), dataMap)
+	// A model may be given by its UUID rather than its name.
+	if utils.IsValidUUIDString(modelName) {
+		return sc.modelByUUID(modelName)
+	}
 	if modelWithName, ok := sc.modelUUIDcache[modelName]; ok {
 		sc.Tracef(fmt.Sprintf("Found uuid for %q in cache", modelName))
-		return modelWithName.uuid, nil
+		return modelWithName, nil
 	}
-	if err := sc.fillModelCache(); err != nil {
-		return "", err
+	// A qualified model name, owner/name, may refer to a model
+	// owned by another user, fill the cache with the models of
+	// that user.
+	owner, _ := SplitModelName(modelName)
+	if err := sc.fillModelCache(owner); err != nil {
+		return jujuModel{}, err
 	}
 	if modelWithName, ok := sc.modelUUIDcache[modelName]; ok {
 		sc.Tracef(fmt.Sprintf("Found uuid for %q in cache on 2nd attempt", modelName))
-		return modelWithName.uuid, nil
+		return modelWithName, nil
 	}
-	return "", errors.NotFoundf("model %q", modelName)
+	return jujuModel{}, &modelNotFoundError{name: modelName}
 }
 
 // fillModelCache checks with the juju controller for all
-// models and puts the relevant data in the model info cache.
+// models of the given user, the current user if empty, and puts
+// the relevant data in the model info cache. Models are cached by
+// name and by their qualified name, owner/name.
 // Callers are expected to hold the modelUUIDmu lock.
-func (sc *sharedClient) fillModelCache() error {
+func (sc *sharedClient) fillModelCache(user string) error {
 	conn, err := sc.GetConnection(nil)
 	if err != nil {
 		return err
@@ -178,53 +299,209 @@ func (sc *sharedClient) fillModelCache() error {
 
 	client := modelmanager.NewClient(conn)
 
+	currentUser := getCurrentJujuUser(conn)
+	if user == "" {
+		user = currentUser
+	}
+
 	// Calling ListModelSummaries because other Model endpoints require
 	// the UUID, here we're trying to get the model UUID for other calls.
-	modelSummaries, err := client.ListModelSummaries(conn.AuthTag().Id(), false)
+	modelSummaries, err := client.ListModelSummaries(user, false)
 	if err != nil {
 		return err
 	}
 	for _, modelSummary := range modelSummaries {
 		modelWithName := jujuModel{
+			name:      modelSummary.Name,
 			uuid:      modelSummary.UUID,
 			modelType: modelSummary.Type,
 		}
-		sc.modelUUIDcache[modelSummary.Name] = modelWithName
+		if modelSummary.Owner != currentUser {
+			modelWithName.owner = modelSummary.Owner
+		}
+		if user == currentUser {
+			sc.modelUUIDcache[modelSummary.Name] = modelWithName
+		}
+		if modelSummary.Owner != "" {
+			sc.modelUUIDcache[QualifiedModelName(modelSummary.Owner, modelSummary.Name)] = modelWithName
+		}
 	}
 	return nil
 }
 
+// modelByUUID returns the model with the given UUID from the model
+// info cache. A model not found in the cache is read from the juju
+// controller and cached under its UUID.
+// Callers are expected to hold the modelUUIDmu lock.
+func (sc *sharedClient) modelByUUID(modelUUID string) (jujuModel, error) {
+	for _, v := range sc.modelUUIDcache {
+		if v.uuid == modelUUID {
+			return v, nil
+		}
+	}
+
+	conn, err := sc.GetConnection(nil)
+	if err != nil {
+		return jujuModel{}, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+	results, err := client.ModelInfo([]names.ModelTag{names.NewModelTag(modelUUID)})
+	if err != nil {
+		return jujuModel{}, err
+	}
+	if len(results) != 1 {
+		return jujuModel{}, fmt.Errorf("expected one model, received %d", len(results))
+	}
+	if results[0].Error != nil {
+		if params.IsCodeNotFound(results[0].Error) || params.IsCodeModelNotFound(results[0].Error) {
+			return jujuModel{}, &modelNotFoundError{uuid: modelUUID}
+		}
+		return jujuModel{}, results[0].Error
+	}
+
+	owner, err := names.ParseUserTag(results[0].Result.OwnerTag)
+	if err != nil {
+		return jujuModel{}, err
+	}
+	modelWithUUID := jujuModel{
+		name:      results[0].Result.Name,
+		uuid:      modelUUID,
+		modelType: model.ModelType(results[0].Result.Type),
+	}
+	if owner.Id() != getCurrentJujuUser(conn) {
+		modelWithUUID.owner = owner.Id()
+	}
+	sc.modelUUIDcache[modelUUID] = modelWithUUID
+	return modelWithUUID, nil
+}
+
+// QualifiedModelName returns the name of a model qualified by
+// the name of its owner, e.g. admin/default.
+func QualifiedModelName(owner, name string) string {
+	return owner + "/" + name
+}
+
+// SplitModelName splits a model name, qualified or not, into
+// the name of its owner and the name of the model. The owner
+// is empty when the name is not qualified.
+func SplitModelName(modelName string) (owner, name string) {
+	if i := strings.Index(modelName, "/"); i >= 0 {
+		return modelName[:i], modelName[i+1:]
+	}
+	return "", modelName
+}
+
 func (sc *sharedClient) ModelType(modelName string) (model.ModelType, error) {
-	sc.modelUUIDmu.Lock()
-	defer sc.modelUUIDmu.Unlock()
-	if modelWithName, ok := sc.modelUUIDcache[modelName]; ok {
-		return modelWithName.modelType, nil
+	resolved, err := sc.ResolveModel(modelName)
+	if err != nil {
+		return model.ModelType(""), err
+	}
+	return resolved.Type, nil
+}
+
+// statusCacheTTL is how long the status of a model is shared by the
+// reads of a refresh.
+const statusCacheTTL = 30 * time.Second
+
+// modelStatus is the status of a model, read once for the reads of a
+// refresh. done is closed once the status is read.
+type modelStatus struct {
+	done   chan struct{}
+	status *params.FullStatus
+	err    error
+	readAt time.Time
+}
+
+// ReadModelStatus returns the full status of the model of the
+// connection. When cached is true, the status is shared with the other
+// cached reads of the model for statusCacheTTL, and concurrent reads
+// wait for a single status call: the resources of a model are then
+// refreshed with one call rather than one call each. Only the reads of
+// a refresh are cached, the changes to a model invalidate its status.
+// The status returned must not be modified.
+func (sc *sharedClient) ReadModelStatus(modelName string, conn api.Connection, cached bool) (*params.FullStatus, error) {
+	if !cached {
+		return apiclient.NewClient(conn, sc.JujuLogger()).Status(nil)
+	}
+
+	key := sc.statusCacheKey(modelName)
+	sc.statusMu.Lock()
+	if entry, ok := sc.statusCache[key]; ok {
+		select {
+		case <-entry.done:
+			if entry.err == nil && time.Since(entry.readAt) < statusCacheTTL {
+				sc.statusMu.Unlock()
+				return entry.status, nil
+			}
+		default:
+			// The status is being read by another refresh.
+			sc.statusMu.Unlock()
+			<-entry.done
+			return entry.status, entry.err
+		}
 	}
+	entry := &modelStatus{done: make(chan struct{})}
+	sc.statusCache[key] = entry
+	sc.statusMu.Unlock()
+
+	entry.status, entry.err = apiclient.NewClient(conn, sc.JujuLogger()).Status(nil)
+	entry.readAt = time.Now()
+	close(entry.done)
+	return entry.status, entry.err
+}
 
-	return model.ModelType(""), errors.NotFoundf("type for model %q", modelName)
+// InvalidateModelStatus drops the cached status of a model, once the
+// model is changed: the reads which follow read its status again. Each
+// client method changing a model invalidates its status.
+func (sc *sharedClient) InvalidateModelStatus(modelName string) {
+	key := sc.statusCacheKey(modelName)
+	sc.statusMu.Lock()
+	delete(sc.statusCache, key)
+	sc.statusMu.Unlock()
+}
+
+// statusCacheKey returns the UUID of the model the status is cached
+// by, or the model as given when it cannot be resolved.
+func (sc *sharedClient) statusCacheKey(modelName string) string {
+	if uuid, err := sc.ModelUUID(modelName); err == nil {
+		return uuid
+	}
+	return modelName
 }
 
 func (sc *sharedClient) RemoveModel(modelUUID string) {
 	sc.modelUUIDmu.Lock()
-	var modelName string
+	// A model may be cached under both its name and its
+	// qualified name.
 	for k, v := range sc.modelUUIDcache {
 		if v.uuid == modelUUID {
-			modelName = k
-			break
+			delete(sc.modelUUIDcache, k)
 		}
 	}
-	if modelName != "" {
-		delete(sc.modelUUIDcache, modelName)
-	}
 	sc.modelUUIDmu.Unlock()
 }
 
 func (sc *sharedClient) AddModel(modelName, modelUUID string, modelType model.ModelType) {
 	sc.modelUUIDmu.Lock()
+	owner, name := SplitModelName(modelName)
 	sc.modelUUIDcache[modelName] = jujuModel{
+		name:      name,
+		owner:     owner,
 		uuid:      modelUUID,
 		modelType: modelType,
 	}
+	// Only the models of the current user are cached by a name which
+	// is not qualified.
+	if owner == "" {
+		for k, v := range sc.modelUUIDcache {
+			if v.uuid == modelUUID {
+				v.owner = ""
+				sc.modelUUIDcache[k] = v
+			}
+		}
+	}
 	sc.modelUUIDmu.Unlock()
 }
 
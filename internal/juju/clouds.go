@@ -0,0 +1,435 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	cloudapi "github.com/juju/juju/api/client/cloud"
+	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/names/v4"
+)
+
+type cloudsClient struct {
+	SharedClient
+}
+
+type CloudRegion struct {
+	Name             string
+	Endpoint         string
+	IdentityEndpoint string
+	StorageEndpoint  string
+}
+
+type CreateCloudInput struct {
+	Name             string
+	Type             string
+	AuthTypes        []string
+	Endpoint         string
+	IdentityEndpoint string
+	StorageEndpoint  string
+	// Regions are the regions of the cloud, the first region
+	// is the default region of the cloud.
+	Regions        []CloudRegion
+	CACertificates []string
+	// Force adds the cloud even if the controller does not
+	// support its type.
+	Force bool
+}
+
+type ReadCloudInput struct {
+	Name string
+}
+
+type ReadCloudResponse struct {
+	Name             string
+	Type             string
+	AuthTypes        []string
+	Endpoint         string
+	IdentityEndpoint string
+	StorageEndpoint  string
+	Regions          []CloudRegion
+	CACertificates   []string
+}
+
+type UpdateCloudInput struct {
+	Name             string
+	Type             string
+	AuthTypes        []string
+	Endpoint         string
+	IdentityEndpoint string
+	StorageEndpoint  string
+	Regions          []CloudRegion
+	CACertificates   []string
+}
+
+type DestroyCloudInput struct {
+	Name string
+}
+
+type CreateKubernetesCloudInput struct {
+	Name string
+	// KubeConfig is the content of the kubeconfig file holding
+	// the cluster and the credentials to use.
+	KubeConfig string
+	// Context is the kubeconfig context to use, the current
+	// context of the kubeconfig by default.
+	Context string
+	// StorageClass is the storage class used to provision the
+	// storage of workloads.
+	StorageClass string
+}
+
+type CreateKubernetesCloudResponse struct {
+	// CredentialName is the name of the credential added for
+	// the cloud, from the credentials of the kubeconfig context.
+	CredentialName string
+}
+
+type DestroyKubernetesCloudInput struct {
+	Name           string
+	CredentialName string
+}
+
+type GrantCloudAccessInput struct {
+	Name   string
+	Access string
+	Users  []string
+}
+
+type ReadCloudAccessInput struct {
+	Name string
+}
+
+type ReadCloudAccessResponse struct {
+	// Users holds the access of the users to the cloud,
+	// keyed by user name.
+	Users map[string]string
+}
+
+type RevokeCloudAccessInput struct {
+	Name  string
+	Users []string
+}
+
+func newCloudsClient(sc SharedClient) *cloudsClient {
+	return &cloudsClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateCloud adds a cloud to the controller, as `juju add-cloud
+// --controller` does.
+func (c *cloudsClient) CreateCloud(input CreateCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	return client.AddCloud(newJujuCloud(
+		input.Name, input.Type, input.AuthTypes,
+		input.Endpoint, input.IdentityEndpoint, input.StorageEndpoint,
+		input.Regions, input.CACertificates,
+	), input.Force)
+}
+
+// ReadCloud returns the definition of a cloud of the controller.
+func (c *cloudsClient) ReadCloud(input ReadCloudInput) (*ReadCloudResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	cloud, err := client.Cloud(names.NewCloudTag(input.Name))
+	if err != nil {
+		return nil, typedError(err)
+	}
+
+	return cloudResponse(cloud), nil
+}
+
+// ListClouds returns the clouds of the controller the user can see,
+// sorted by name.
+func (c *cloudsClient) ListClouds() ([]ReadCloudResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	clouds, err := client.Clouds()
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]ReadCloudResponse, 0, len(clouds))
+	for _, cloud := range clouds {
+		responses = append(responses, *cloudResponse(cloud))
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Name < responses[j].Name
+	})
+	return responses, nil
+}
+
+// UpdateCloud replaces the definition of a cloud of the controller.
+func (c *cloudsClient) UpdateCloud(input UpdateCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	return client.UpdateCloud(newJujuCloud(
+		input.Name, input.Type, input.AuthTypes,
+		input.Endpoint, input.IdentityEndpoint, input.StorageEndpoint,
+		input.Regions, input.CACertificates,
+	))
+}
+
+// DestroyCloud removes a cloud from the controller. A cloud used
+// by a model cannot be removed.
+func (c *cloudsClient) DestroyCloud(input DestroyCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	return client.RemoveCloud(input.Name)
+}
+
+// CreateKubernetesCloud adds a kubernetes cloud and its credential
+// to the controller, from a kubeconfig, as `juju add-k8s --controller`
+// does. The credential is named after the cloud.
+func (c *cloudsClient) CreateKubernetesCloud(input CreateKubernetesCloudInput) (*CreateKubernetesCloudResponse, error) {
+	k8sConfig, err := k8scloud.ConfigFromReader(strings.NewReader(input.KubeConfig))
+	if err != nil {
+		return nil, err
+	}
+	contextName := input.Context
+	if contextName == "" {
+		contextName = k8sConfig.CurrentContext
+	}
+
+	cloud, err := k8scloud.CloudFromKubeConfigContext(contextName, k8sConfig, k8scloud.CloudParamaters{
+		Name: input.Name,
+		// The host cloud of the cluster is not detected, as
+		// add-k8s does when it cannot tell.
+		HostCloudRegion: "other",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cloud.SkipTLSVerify && len(cloud.CACertificates) > 0 && cloud.CACertificates[0] != "" {
+		return nil, errors.NotValidf("cloud with both skip-TLS-verify=true and CA certificates")
+	}
+	if input.StorageClass != "" {
+		cloud.Config = map[string]interface{}{
+			k8sconstants.WorkloadStorageKey: input.StorageClass,
+		}
+	}
+
+	credential, err := k8scloud.CredentialFromKubeConfigContext(contextName, k8sConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	if err := client.AddCloud(cloud, false); err != nil {
+		return nil, err
+	}
+
+	cloudCredTag, err := GetCloudCredentialTag(input.Name, getCurrentJujuUser(conn), input.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.AddCredential(cloudCredTag.String(), credential); err != nil {
+		return nil, err
+	}
+
+	return &CreateKubernetesCloudResponse{CredentialName: input.Name}, nil
+}
+
+// DestroyKubernetesCloud removes the credential of a kubernetes
+// cloud, then the cloud, from the controller.
+func (c *cloudsClient) DestroyKubernetesCloud(input DestroyKubernetesCloudInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	if input.CredentialName != "" {
+		cloudCredTag, err := GetCloudCredentialTag(input.Name, getCurrentJujuUser(conn), input.CredentialName)
+		if err != nil {
+			return err
+		}
+		if err := client.RevokeCredential(*cloudCredTag, false); err != nil && !errors.Is(typedError(err), errors.NotFound) {
+			return err
+		}
+	}
+
+	return client.RemoveCloud(input.Name)
+}
+
+// GrantCloudAccess grants the given access to a cloud to the users.
+// Users already granted the access are left as they are, the access
+// of users granted another access is removed before the given access
+// is granted.
+func (c *cloudsClient) GrantCloudAccess(input GrantCloudAccessInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	currentAccess, err := cloudUsersAccess(client, input.Name)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range input.Users {
+		access, granted := currentAccess[user]
+		if access == input.Access {
+			continue
+		}
+		if granted {
+			if err := client.RevokeCloud(user, string(permission.AddModelAccess), input.Name); err != nil {
+				return err
+			}
+		}
+		if err := client.GrantCloud(user, input.Access, input.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadCloudAccess returns the access of the users to a cloud.
+func (c *cloudsClient) ReadCloudAccess(input ReadCloudAccessInput) (*ReadCloudAccessResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	users, err := cloudUsersAccess(cloudapi.NewClient(conn), input.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadCloudAccessResponse{Users: users}, nil
+}
+
+// RevokeCloudAccess removes any access to a cloud from the users.
+// Revoking add-model access removes the user from the cloud, as
+// revoking the admin access only downgrades it.
+func (c *cloudsClient) RevokeCloudAccess(input RevokeCloudAccessInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+	for _, user := range input.Users {
+		if err := client.RevokeCloud(user, string(permission.AddModelAccess), input.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cloudUsersAccess(client *cloudapi.Client, cloudName string) (map[string]string, error) {
+	infos, err := client.CloudInfo([]names.CloudTag{names.NewCloudTag(cloudName)})
+	if err != nil {
+		return nil, typedError(err)
+	}
+	users := make(map[string]string, len(infos[0].Users))
+	for name, user := range infos[0].Users {
+		users[name] = user.Access
+	}
+	return users, nil
+}
+
+func cloudResponse(cloud jujucloud.Cloud) *ReadCloudResponse {
+	authTypes := make([]string, 0, len(cloud.AuthTypes))
+	for _, authType := range cloud.AuthTypes {
+		authTypes = append(authTypes, string(authType))
+	}
+	regions := make([]CloudRegion, 0, len(cloud.Regions))
+	for _, region := range cloud.Regions {
+		regions = append(regions, CloudRegion{
+			Name:             region.Name,
+			Endpoint:         region.Endpoint,
+			IdentityEndpoint: region.IdentityEndpoint,
+			StorageEndpoint:  region.StorageEndpoint,
+		})
+	}
+
+	return &ReadCloudResponse{
+		Name:             cloud.Name,
+		Type:             cloud.Type,
+		AuthTypes:        authTypes,
+		Endpoint:         cloud.Endpoint,
+		IdentityEndpoint: cloud.IdentityEndpoint,
+		StorageEndpoint:  cloud.StorageEndpoint,
+		Regions:          regions,
+		CACertificates:   cloud.CACertificates,
+	}
+}
+
+func newJujuCloud(
+	name, cloudType string, authTypes []string,
+	endpoint, identityEndpoint, storageEndpoint string,
+	regions []CloudRegion, caCertificates []string,
+) jujucloud.Cloud {
+	cloud := jujucloud.Cloud{
+		Name:             name,
+		Type:             cloudType,
+		Endpoint:         endpoint,
+		IdentityEndpoint: identityEndpoint,
+		StorageEndpoint:  storageEndpoint,
+		CACertificates:   caCertificates,
+	}
+	for _, authType := range authTypes {
+		cloud.AuthTypes = append(cloud.AuthTypes, jujucloud.AuthType(authType))
+	}
+	for _, region := range regions {
+		cloud.Regions = append(cloud.Regions, jujucloud.Region{
+			Name:             region.Name,
+			Endpoint:         region.Endpoint,
+			IdentityEndpoint: region.IdentityEndpoint,
+			StorageEndpoint:  region.StorageEndpoint,
+		})
+	}
+	return cloud
+}
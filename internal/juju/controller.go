@@ -0,0 +1,253 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/client/backups"
+	"github.com/juju/juju/api/client/modelmanager"
+	apicontroller "github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/controller"
+)
+
+type controllerClient struct {
+	SharedClient
+}
+
+type ReadControllerConfigResponse struct {
+	// Config holds the controller config, values are typed as
+	// returned by the controller.
+	Config map[string]interface{}
+}
+
+type ReadControllerResponse struct {
+	UUID    string
+	Name    string
+	Version string
+	// APIAddresses are the addresses of the controller API, as
+	// host:port.
+	APIAddresses []string
+	CACert       string
+	// Cloud and CloudRegion are those of the controller model.
+	Cloud       string
+	CloudRegion string
+}
+
+type UpdateControllerConfigInput struct {
+	// Config holds the controller config values to set, they are
+	// coerced to the type of the config key.
+	Config map[string]string
+}
+
+type CreateBackupInput struct {
+	Notes string
+	// DownloadPath is the local path the backup is downloaded to,
+	// the backup is kept on the controller if empty.
+	DownloadPath string
+}
+
+type CreateBackupResponse struct {
+	ID string
+	// Filename is the path of the backup on the controller.
+	Filename string
+	Checksum string
+	Size     int64
+	Started  time.Time
+	Finished time.Time
+}
+
+func newControllerClient(sc SharedClient) *controllerClient {
+	return &controllerClient{
+		SharedClient: sc,
+	}
+}
+
+// UpdatableControllerConfigKeys returns the controller config keys
+// which can be set once the controller is bootstrapped.
+func UpdatableControllerConfigKeys() []string {
+	return controller.AllowedUpdateConfigAttributes.SortedValues()
+}
+
+// ReadControllerConfig returns the config of the controller.
+func (c *controllerClient) ReadControllerConfig() (*ReadControllerConfigResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+
+	config, err := client.ControllerConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &ReadControllerConfigResponse{Config: config}, nil
+}
+
+// ReadController returns the details of the controller, as `juju
+// show-controller` does.
+func (c *controllerClient) ReadController() (*ReadControllerResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+
+	config, err := client.ControllerConfig()
+	if err != nil {
+		return nil, err
+	}
+	version, err := client.ControllerVersion()
+	if err != nil {
+		return nil, err
+	}
+	summary, err := controllerModelSummary(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	for _, hostPorts := range conn.APIHostPorts() {
+		addresses = append(addresses, hostPorts.HostPorts().FilterUnusable().Strings()...)
+	}
+	caCert, _ := config.CACert()
+	return &ReadControllerResponse{
+		UUID:         config.ControllerUUID(),
+		Name:         config.ControllerName(),
+		Version:      version.Version,
+		APIAddresses: addresses,
+		CACert:       caCert,
+		Cloud:        summary.Cloud,
+		CloudRegion:  summary.CloudRegion,
+	}, nil
+}
+
+// UpdateControllerConfig sets config values of the controller, as
+// `juju controller-config key=value` does. Config keys which cannot
+// be updated once the controller is bootstrapped are rejected.
+func (c *controllerClient) UpdateControllerConfig(input UpdateControllerConfigInput) error {
+	fields, _, err := controller.ConfigSchema.ValidationSchema()
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]interface{}, len(input.Config))
+	for key, value := range input.Config {
+		if !controller.AllowedUpdateConfigAttributes.Contains(key) {
+			return errors.NotValidf("read-only controller config %q", key)
+		}
+		field, ok := fields[key]
+		if !ok {
+			values[key] = value
+			continue
+		}
+		coerced, err := field.Coerce(value, []string{key})
+		if err != nil {
+			return err
+		}
+		values[key] = coerced
+	}
+
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+
+	return client.ConfigSet(values)
+}
+
+// CreateBackup creates a backup of the controller, as `juju
+// create-backup` does, and downloads it when a download path is given.
+func (c *controllerClient) CreateBackup(input CreateBackupInput) (*CreateBackupResponse, error) {
+	modelUUID, err := c.controllerModelUUID()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := c.GetConnection(&modelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := backups.NewClient(conn)
+
+	result, err := client.Create(input.Notes, input.DownloadPath == "")
+	if err != nil {
+		return nil, err
+	}
+	response := &CreateBackupResponse{
+		ID:       result.ID,
+		Filename: result.Filename,
+		Checksum: result.Checksum,
+		Size:     result.Size,
+		Started:  result.Started,
+		Finished: result.Finished,
+	}
+	if input.DownloadPath == "" {
+		return response, nil
+	}
+
+	archive, err := client.Download(result.Filename)
+	if err != nil {
+		return nil, errors.Annotatef(err, "downloading backup %q", result.Filename)
+	}
+	defer func() { _ = archive.Close() }()
+
+	file, err := os.Create(input.DownloadPath)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(file, archive); err != nil {
+		_ = file.Close()
+		return nil, errors.Annotatef(err, "downloading backup %q", result.Filename)
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// controllerModelUUID returns the UUID of the controller model, which
+// is not named after the current user.
+func (c *controllerClient) controllerModelUUID() (string, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = conn.Close() }()
+
+	summary, err := controllerModelSummary(conn)
+	if err != nil {
+		return "", err
+	}
+	return summary.UUID, nil
+}
+
+// controllerModelSummary returns the summary of the controller model.
+func controllerModelSummary(conn api.Connection) (*base.UserModelSummary, error) {
+	client := modelmanager.NewClient(conn)
+
+	summaries, err := client.ListModelSummaries(getCurrentJujuUser(conn), true)
+	if err != nil {
+		return nil, err
+	}
+	for _, summary := range summaries {
+		if summary.IsController {
+			return &summary, nil
+		}
+	}
+	return nil, errors.NotFoundf("controller model")
+}
@@ -5,11 +5,14 @@ package juju
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/juju/errors"
 	cloudapi "github.com/juju/juju/api/client/cloud"
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v4"
 )
 
@@ -49,6 +52,9 @@ type UpdateCredentialInput struct {
 	CloudName            string
 	ControllerCredential bool
 	Name                 string
+	// Force updates the controller credential even if it cannot
+	// be validated against the models using it.
+	Force bool
 }
 
 type DestroyCredentialInput struct {
@@ -58,6 +64,21 @@ type DestroyCredentialInput struct {
 	Name                 string
 }
 
+type ListCredentialsInput struct {
+	// CloudName filters the credentials by cloud when set.
+	CloudName string
+}
+
+// CredentialSummary describes a credential of the controller, its
+// attributes are left out.
+type CredentialSummary struct {
+	Name     string
+	Cloud    string
+	AuthType string
+	// Models are the names of the models using the credential.
+	Models []string
+}
+
 func newCredentialsClient(sc SharedClient) *credentialsClient {
 	return &credentialsClient{
 		SharedClient: sc,
@@ -230,6 +251,52 @@ func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCred
 	return nil, fmt.Errorf("credential %s not found for cloud %s", credentialName, cloudName)
 }
 
+// ListCredentials returns the credentials of the user stored on the
+// controller, sorted by cloud and name, as `juju credentials
+// --controller` does. Secret attributes are never read.
+func (c *credentialsClient) ListCredentials(input ListCredentialsInput) ([]CredentialSummary, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	results, err := client.CredentialContents("", "", false)
+	if err != nil {
+		return nil, err
+	}
+	credentials := make([]CredentialSummary, 0, len(results))
+	for _, result := range results {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		content := result.Result.Content
+		if input.CloudName != "" && content.Cloud != input.CloudName {
+			continue
+		}
+		models := make([]string, 0, len(result.Result.Models))
+		for _, model := range result.Result.Models {
+			models = append(models, model.Model)
+		}
+		sort.Strings(models)
+		credentials = append(credentials, CredentialSummary{
+			Name:     content.Name,
+			Cloud:    content.Cloud,
+			AuthType: content.AuthType,
+			Models:   models,
+		})
+	}
+	sort.Slice(credentials, func(i, j int) bool {
+		if credentials[i].Cloud != credentials[j].Cloud {
+			return credentials[i].Cloud < credentials[j].Cloud
+		}
+		return credentials[i].Name < credentials[j].Name
+	})
+	return credentials, nil
+}
+
 func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error {
 	if !input.ControllerCredential && !input.ClientCredential {
 		// Just in case none of them are set
@@ -276,7 +343,13 @@ func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error
 	if input.ControllerCredential {
 		client := cloudapi.NewClient(conn)
 
-		if _, err := client.UpdateCredentialsCheckModels(*cloudCredTag, cloudCredential); err != nil {
+		results, err := client.UpdateCloudsCredentials(map[string]jujucloud.Credential{
+			cloudCredTag.String(): cloudCredential,
+		}, input.Force)
+		if err != nil {
+			return err
+		}
+		if err := credentialModelsError(results[0], input.Force); err != nil {
 			return err
 		}
 	}
@@ -284,6 +357,34 @@ func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error
 	return nil
 }
 
+// credentialModelsError returns the error of a credential update,
+// including the models the new credential is not valid for. Those
+// are ignored when the update is forced.
+func credentialModelsError(result params.UpdateCredentialResult, force bool) error {
+	var modelErrors []string
+	if !force {
+		for _, model := range result.Models {
+			for _, modelErr := range model.Errors {
+				if modelErr.Error == nil {
+					continue
+				}
+				modelErrors = append(modelErrors, fmt.Sprintf("model %q: %s", model.ModelName, modelErr.Error.Error()))
+			}
+		}
+	}
+	if result.Error != nil {
+		if len(modelErrors) == 0 {
+			return result.Error
+		}
+		return errors.Errorf("%s: %s", result.Error, strings.Join(modelErrors, "; "))
+	}
+	if len(modelErrors) > 0 {
+		return errors.Errorf("credential not valid for models using it, use force to update it anyway: %s",
+			strings.Join(modelErrors, "; "))
+	}
+	return nil
+}
+
 func getExistingClientCredential(cloudName string) (*jujucloud.CloudCredential, error) {
 	store := jujuclient.NewFileClientStore()
 	existingCredentials, err := store.CredentialForCloud(cloudName)
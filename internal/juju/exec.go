@@ -0,0 +1,213 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	apiaction "github.com/juju/juju/api/client/action"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v4"
+	"github.com/juju/retry"
+)
+
+type execClient struct {
+	SharedClient
+}
+
+type ExecInput struct {
+	ModelName string
+	Commands  string
+	// Applications, Units and Machines are the targets the commands
+	// run on, at least one of them is required.
+	Applications []string
+	Units        []string
+	Machines     []string
+	// Timeout is how long the commands are waited for.
+	Timeout time.Duration
+}
+
+type ExecResponse struct {
+	OperationID string
+	Results     []ExecResult
+}
+
+// ExecResult is the result of the commands on one unit or machine.
+type ExecResult struct {
+	// Receiver is the name of the unit or the id of the machine.
+	Receiver   string
+	Status     string
+	Message    string
+	ReturnCode int
+	Stdout     string
+	Stderr     string
+}
+
+type ListActionsInput struct {
+	ModelName string
+	AppName   string
+}
+
+// CharmAction is an action declared by the charm of an application.
+type CharmAction struct {
+	Name        string
+	Description string
+	// Params is the JSON schema of the parameters of the action.
+	Params map[string]interface{}
+}
+
+func newExecClient(sc SharedClient) *execClient {
+	return &execClient{
+		SharedClient: sc,
+	}
+}
+
+// Exec runs commands on units or machines of a model, as `juju exec`
+// does, and waits for their results. Commands run in the workload
+// container of the units of a kubernetes model.
+func (c *execClient) Exec(ctx context.Context, input ExecInput) (*ExecResponse, error) {
+	modelType, err := c.ModelType(input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	if modelType == model.CAAS && len(input.Machines) > 0 {
+		return nil, errors.NotSupportedf("targeting machines of a kubernetes model")
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apiaction.NewClient(conn)
+
+	enqueued, err := client.Run(apiaction.RunParams{
+		Commands:        input.Commands,
+		Timeout:         input.Timeout,
+		Applications:    input.Applications,
+		Units:           input.Units,
+		Machines:        input.Machines,
+		WorkloadContext: modelType == model.CAAS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, result := range enqueued.Actions {
+		if result.Error != nil {
+			return nil, errors.Annotate(result.Error, "enqueuing commands")
+		}
+		ids = append(ids, result.Action.ID)
+	}
+
+	// Wait a little longer than the timeout of the commands, for the
+	// results to be reported once they are timed out.
+	var results []apiaction.ActionResult
+	err = retry.Call(retry.CallArgs{
+		Func: func() error {
+			var err error
+			results, err = client.Actions(ids)
+			if err != nil {
+				return err
+			}
+			for _, result := range results {
+				if result.Error != nil {
+					return result.Error
+				}
+				switch result.Status {
+				case params.ActionPending, params.ActionRunning, params.ActionAborting:
+					return errors.NewNotYetAvailable(nil, fmt.Sprintf("task %s on %s is %s", result.Action.ID, result.Action.Receiver, result.Status))
+				}
+			}
+			return nil
+		},
+		IsFatalError: func(err error) bool {
+			return !errors.Is(err, errors.NotYetAvailable)
+		},
+		NotifyFunc: func(err error, attempt int) {
+			if attempt%10 == 0 {
+				c.Debugf(fmt.Sprintf("waiting for operation %s: %s", enqueued.OperationID, err))
+			}
+		},
+		Delay:       2 * time.Second,
+		MaxDuration: input.Timeout + time.Minute,
+		Clock:       clock.WallClock,
+		Stop:        ctx.Done(),
+	})
+	if retry.IsRetryStopped(err) || retry.IsDurationExceeded(err) {
+		err = retry.LastError(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := ExecResponse{OperationID: enqueued.OperationID}
+	for _, result := range results {
+		response.Results = append(response.Results, execResult(result))
+	}
+	return &response, nil
+}
+
+// ListActions returns the actions declared by the charm of an
+// application, sorted by name, as `juju actions` does.
+func (c *execClient) ListActions(input ListActionsInput) ([]CharmAction, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apiaction.NewClient(conn)
+
+	specs, err := client.ApplicationCharmActions(input.AppName)
+	if err != nil {
+		return nil, typedError(err)
+	}
+	actions := make([]CharmAction, 0, len(specs))
+	for name, spec := range specs {
+		actions = append(actions, CharmAction{
+			Name:        name,
+			Description: spec.Description,
+			Params:      spec.Params,
+		})
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Name < actions[j].Name
+	})
+	return actions, nil
+}
+
+func execResult(result apiaction.ActionResult) ExecResult {
+	execResult := ExecResult{
+		Status:     result.Status,
+		Message:    result.Message,
+		ReturnCode: -1,
+	}
+	if receiver, err := names.ParseTag(result.Action.Receiver); err == nil {
+		execResult.Receiver = receiver.Id()
+	} else {
+		execResult.Receiver = result.Action.Receiver
+	}
+	if stdout, ok := result.Output["stdout"].(string); ok {
+		execResult.Stdout = stdout
+	}
+	if stderr, ok := result.Output["stderr"].(string); ok {
+		execResult.Stderr = stderr
+	}
+	// return-code may be a float64 due to serialisation.
+	if code, ok := result.Output["return-code"]; ok && code != nil {
+		if returnCode, err := strconv.Atoi(fmt.Sprintf("%v", code)); err == nil {
+			execResult.ReturnCode = returnCode
+		}
+	}
+	return execResult
+}
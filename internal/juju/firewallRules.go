@@ -0,0 +1,128 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+	apimodelconfig "github.com/juju/juju/api/client/modelconfig"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/environs/config"
+)
+
+type firewallRulesClient struct {
+	SharedClient
+}
+
+type SetFirewallRuleInput struct {
+	ModelName string
+	// Service is the well known service of the rule, either ssh
+	// or juju-application-offer.
+	Service string
+	// Allowlist holds the CIDRs allowed to access the service.
+	Allowlist []string
+}
+
+type ReadFirewallRuleInput struct {
+	ModelName string
+	Service   string
+}
+
+type ReadFirewallRuleResponse struct {
+	Allowlist []string
+}
+
+type DestroyFirewallRuleInput struct {
+	ModelName string
+	Service   string
+}
+
+func newFirewallRulesClient(sc SharedClient) *firewallRulesClient {
+	return &firewallRulesClient{
+		SharedClient: sc,
+	}
+}
+
+// SetFirewallRule sets the CIDRs allowed to access a well known
+// service of the model, as `juju set-firewall-rule` does.
+func (c *firewallRulesClient) SetFirewallRule(input SetFirewallRuleInput) error {
+	key, err := firewallRuleConfigKey(input.Service)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apimodelconfig.NewClient(conn)
+
+	return client.ModelSet(map[string]interface{}{key: strings.Join(input.Allowlist, ",")})
+}
+
+// ReadFirewallRule returns the CIDRs allowed to access a well known
+// service of the model.
+func (c *firewallRulesClient) ReadFirewallRule(input ReadFirewallRuleInput) (*ReadFirewallRuleResponse, error) {
+	if _, err := firewallRuleConfigKey(input.Service); err != nil {
+		return nil, err
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apimodelconfig.NewClient(conn)
+
+	attrs, err := client.ModelGet()
+	if err != nil {
+		return nil, typedError(err)
+	}
+	cfg, err := config.New(config.NoDefaults, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	allowlist := cfg.SSHAllow()
+	if firewall.WellKnownServiceType(input.Service) == firewall.JujuApplicationOfferRule {
+		allowlist = cfg.SAASIngressAllow()
+	}
+	return &ReadFirewallRuleResponse{Allowlist: allowlist}, nil
+}
+
+// DestroyFirewallRule resets the CIDRs allowed to access a well known
+// service of the model to the default, which allows any address.
+func (c *firewallRulesClient) DestroyFirewallRule(input DestroyFirewallRuleInput) error {
+	key, err := firewallRuleConfigKey(input.Service)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apimodelconfig.NewClient(conn)
+
+	return client.ModelUnset(key)
+}
+
+// firewallRuleConfigKey returns the model config key holding the
+// allowlist of a well known service. The controller service cannot
+// be configured per model.
+func firewallRuleConfigKey(service string) (string, error) {
+	switch firewall.WellKnownServiceType(service) {
+	case firewall.SSHRule:
+		return config.SSHAllowKey, nil
+	case firewall.JujuApplicationOfferRule:
+		return config.SAASIngressAllowKey, nil
+	}
+	return "", errors.NotSupportedf("service %q", service)
+}
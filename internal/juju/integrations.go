@@ -8,20 +8,24 @@ package juju
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/juju/charm/v11"
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/juju/api"
 	apiapplication "github.com/juju/juju/api/client/application"
+	apicharms "github.com/juju/juju/api/client/charms"
 	apiclient "github.com/juju/juju/api/client/client"
+	corestatus "github.com/juju/juju/core/status"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/retry"
 )
 
 const (
-	// IntegrationQueryTick defines the time to wait between ticks
-	// when querying the API
-	IntegrationApiTickWait = time.Second * 5
 	// IntegrationAppAvailableTimeout indicates the time to wait
 	// for applications to be available before integrating them
 	IntegrationAppAvailableTimeout = time.Second * 60
@@ -32,14 +36,37 @@ var NoIntegrationFoundError = &noIntegrationFoundError{}
 // NoIntegrationFoundError
 type noIntegrationFoundError struct {
 	ModelUUID string
+	// Key is the key of the integration not found, it is empty
+	// when the model has no integration.
+	Key string
 }
 
 func (ie *noIntegrationFoundError) Error() string {
+	if ie.Key != "" {
+		return fmt.Sprintf("integration %q not found in model %v", ie.Key, ie.ModelUUID)
+	}
 	return fmt.Sprintf("no integrations exist in model %v", ie.ModelUUID)
 }
 
 type integrationsClient struct {
 	SharedClient
+
+	// charmEndpoints caches the endpoints of charms by charm URL,
+	// which holds the revision of the charm.
+	charmEndpoints *charmEndpointsCache
+}
+
+type charmEndpointsCache struct {
+	mu        sync.Mutex
+	endpoints map[string]map[string]CharmEndpoint
+}
+
+// CharmEndpoint is an endpoint of a charm, as declared in the
+// provides, requires and peers of its metadata.
+type CharmEndpoint struct {
+	Name      string
+	Role      string
+	Interface string
 }
 
 type Application struct {
@@ -58,6 +85,9 @@ type IntegrationInput struct {
 	Apps      []string
 	Endpoints []string
 	ViaCIDRs  string
+	// CachedStatus shares the status of the model with the other
+	// reads of a refresh, see ReadModelStatus.
+	CachedStatus bool
 }
 
 type CreateIntegrationResponse struct {
@@ -72,6 +102,32 @@ type UpdateIntegrationResponse struct {
 	Applications []Application
 }
 
+type DestroyIntegrationInput struct {
+	ModelName string
+	// Endpoints holds the endpoints of the integration, as
+	// "<application>:<endpoint>", or "<application>" when the
+	// endpoint is inferred.
+	Endpoints []string
+	// Force removes the integration even if its relation hooks
+	// fail, as `juju remove-relation --force` does.
+	Force bool
+	// Timeout is how long the integration is waited for to be
+	// removed, i.e. for the units of its applications to have left
+	// the relation. The integration is not waited for when zero.
+	Timeout time.Duration
+}
+
+type WaitForIntegrationJoinedInput struct {
+	ModelName string
+	// Endpoints holds the two endpoints of the integration, as
+	// "<application>:<endpoint>".
+	Endpoints []string
+}
+
+type ListIntegrationsInput struct {
+	ModelName string
+}
+
 type UpdateIntegrationInput struct {
 	ModelName    string
 	Endpoints    []string
@@ -79,13 +135,23 @@ type UpdateIntegrationInput struct {
 	ViaCIDRs     string
 }
 
+type ReadApplicationEndpointsInput struct {
+	ModelName string
+	AppNames  []string
+}
+
 func newIntegrationsClient(sc SharedClient) *integrationsClient {
 	return &integrationsClient{
 		SharedClient: sc,
+		charmEndpoints: &charmEndpointsCache{
+			endpoints: make(map[string]map[string]CharmEndpoint),
+		},
 	}
 }
 
 func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateIntegrationResponse, error) {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return nil, err
@@ -98,7 +164,7 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 	ctx, cancel := context.WithTimeout(context.Background(), IntegrationAppAvailableTimeout)
 	defer cancel()
 
-	err = WaitForAppsAvailable(ctx, client, input.Apps, IntegrationApiTickWait)
+	err = WaitForAppsAvailable(ctx, conn, c.JujuLogger(), input.Apps)
 	if err != nil {
 		return nil, errors.New("the applications were not available to be integrated")
 	}
@@ -132,7 +198,7 @@ func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadInteg
 	}
 	defer func() { _ = conn.Close() }()
 
-	status, err := c.getStatus(conn)
+	status, err := c.ReadModelStatus(input.ModelName, conn, input.CachedStatus)
 	if err != nil {
 		return nil, err
 	}
@@ -176,7 +242,8 @@ func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadInteg
 	}
 
 	if integration.Id == 0 && integration.Key == "" {
-		return nil, fmt.Errorf("integration not found in model")
+		modelUUID, _ := conn.ModelTag()
+		return nil, &noIntegrationFoundError{ModelUUID: modelUUID.Id(), Key: key}
 	}
 
 	applications := parseApplications(status.RemoteApplications, integration.Endpoints)
@@ -187,6 +254,8 @@ func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadInteg
 }
 
 func (c integrationsClient) UpdateIntegration(input *UpdateIntegrationInput) (*UpdateIntegrationResponse, error) {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return nil, err
@@ -236,7 +305,12 @@ func (c integrationsClient) UpdateIntegration(input *UpdateIntegrationInput) (*U
 	}, nil
 }
 
-func (c integrationsClient) DestroyIntegration(input *IntegrationInput) error {
+// DestroyIntegration removes the integration and, when a timeout is
+// given, waits until it is fully removed, so the applications of the
+// integration can be destroyed right after.
+func (c integrationsClient) DestroyIntegration(ctx context.Context, input *DestroyIntegrationInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return err
@@ -245,7 +319,22 @@ func (c integrationsClient) DestroyIntegration(input *IntegrationInput) error {
 
 	client := apiapplication.NewClient(conn)
 
-	var force bool = false
+	// The relation is found before it is destroyed, its endpoints
+	// may be given without the endpoint names.
+	var relationID int
+	if input.Timeout > 0 {
+		status, err := c.getStatus(conn)
+		if err != nil {
+			return err
+		}
+		relation := findRelation(status.Relations, input.Endpoints)
+		if relation == nil {
+			return nil
+		}
+		relationID = relation.Id
+	}
+
+	force := input.Force
 	var timeout time.Duration = 30 * time.Second
 
 	err = client.DestroyRelation(
@@ -256,10 +345,270 @@ func (c integrationsClient) DestroyIntegration(input *IntegrationInput) error {
 	if err != nil {
 		return err
 	}
+	if input.Timeout == 0 {
+		return nil
+	}
+
+	err = retry.Call(retry.CallArgs{
+		Func: func() error {
+			status, err := c.getStatus(conn)
+			if err != nil {
+				return err
+			}
+			for _, relation := range status.Relations {
+				if relation.Id == relationID {
+					return errors.Errorf("integration %q is %s", input.Endpoints, relation.Status.Status)
+				}
+			}
+			return nil
+		},
+		NotifyFunc: func(err error, attempt int) {
+			if attempt%4 == 0 {
+				c.Debugf(fmt.Sprintf("waiting for integration %q to be removed: %s", input.Endpoints, err))
+			}
+		},
+		Delay:       5 * time.Second,
+		MaxDuration: input.Timeout,
+		Clock:       clock.WallClock,
+		Stop:        ctx.Done(),
+	})
+	if retry.IsRetryStopped(err) || retry.IsDurationExceeded(err) {
+		err = retry.LastError(err)
+	}
+	return err
+}
+
+// findRelation returns the relation of the endpoints, given as
+// "<application>:<endpoint>" or "<application>", nil when there is
+// none.
+func findRelation(relations []params.RelationStatus, endpoints []string) *params.RelationStatus {
+	for i, relation := range relations {
+		if len(relation.Endpoints) < len(endpoints) {
+			continue
+		}
+		found := true
+		for _, endpoint := range endpoints {
+			matched := false
+			for _, relationEndpoint := range relation.Endpoints {
+				if endpoint == relationEndpoint.ApplicationName ||
+					endpoint == fmt.Sprintf("%s:%s", relationEndpoint.ApplicationName, relationEndpoint.Name) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				found = false
+				break
+			}
+		}
+		if found {
+			return &relations[i]
+		}
+	}
+	return nil
+}
+
+// WaitForIntegrationJoined waits until the integration is joined and
+// the units of its applications are idle, i.e. the relation hooks
+// have completed on both sides, or the timeout is exceeded. The units
+// are watched with the AllWatcher, the status of the integration is
+// only read once they are idle. The units of applications consumed
+// from offers are not waited for. A unit in error is not waited for.
+// When a unit is in error, or blocked once the timeout is exceeded, a
+// *UnitError is returned with the last log lines of the unit.
+func (c integrationsClient) WaitForIntegrationJoined(ctx context.Context, input WaitForIntegrationJoinedInput, timeout time.Duration) error {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	applications := make(map[string]bool, len(input.Endpoints))
+	for _, endpoint := range input.Endpoints {
+		application, _, _ := strings.Cut(endpoint, ":")
+		applications[application] = true
+	}
+	var last *modelEntities
+	err = watchModel(ctx, conn, c.JujuLogger(), timeout, func(entities *modelEntities) error {
+		last = entities
+		for unitName, unit := range entities.units {
+			if !applications[unit.Application] {
+				continue
+			}
+			if unit.WorkloadStatus.Current == corestatus.Error {
+				return &UnitError{
+					Unit:    unitName,
+					Status:  string(corestatus.Error),
+					Message: unit.WorkloadStatus.Message,
+					Hook:    hookFromStatusData(unit.WorkloadStatus.Data, unit.AgentStatus.Data),
+				}
+			}
+			if unit.AgentStatus.Current != corestatus.Idle {
+				c.Debugf(fmt.Sprintf("waiting for integration %q to be joined: unit %q is %s", input.Endpoints, unitName, unit.AgentStatus.Current))
+				return stillWaiting("unit %q is %s", unitName, unit.AgentStatus.Current)
+			}
+		}
+
+		status, err := c.getStatus(conn)
+		if err != nil {
+			return err
+		}
+		if err := integrationJoined(status, input.Endpoints); err != nil {
+			var unitErr *UnitError
+			if errors.As(err, &unitErr) {
+				return err
+			}
+			return waitingError{err}
+		}
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	var unitErr *UnitError
+	if !errors.As(err, &unitErr) {
+		// The timeout is exceeded, a blocked unit tells why.
+		if last == nil {
+			return err
+		}
+		if unitErr = blockedUnit(last, applications); unitErr == nil {
+			return err
+		}
+		err = errors.Annotate(unitErr, err.Error())
+	}
+	unitErr.Logs = readUnitLogs(ctx, conn, unitErr.Unit)
+	return err
+}
 
+// integrationJoined returns an error while the integration of the
+// endpoints is not joined, or the units of its applications are not
+// idle.
+func integrationJoined(status *params.FullStatus, endpoints []string) error {
+	relation := findRelation(status.Relations, endpoints)
+	if relation == nil {
+		return errors.Errorf("integration %q not found", endpoints)
+	}
+	if relation.Status.Status != string(corestatus.Joined) {
+		return errors.Errorf("integration %q is %s", endpoints, relation.Status.Status)
+	}
+	for _, endpoint := range relation.Endpoints {
+		appStatus, ok := status.Applications[endpoint.ApplicationName]
+		if !ok {
+			// The application is consumed from an offer.
+			continue
+		}
+		for unitName, unit := range appStatus.Units {
+			if unit.WorkloadStatus.Status == string(corestatus.Error) {
+				return &UnitError{
+					Unit:    unitName,
+					Status:  unit.WorkloadStatus.Status,
+					Message: unit.WorkloadStatus.Info,
+					Hook:    hookFromStatusData(unit.WorkloadStatus.Data, unit.AgentStatus.Data),
+				}
+			}
+			if unit.AgentStatus.Status != string(corestatus.Idle) {
+				return errors.Errorf("unit %q is %s", unitName, unit.AgentStatus.Status)
+			}
+		}
+	}
 	return nil
 }
 
+// ReadApplicationEndpoints returns the endpoints of the charms of
+// applications of a model, keyed by application name then endpoint
+// name. The applications which are not deployed are not included.
+func (c integrationsClient) ReadApplicationEndpoints(input ReadApplicationEndpointsInput) (map[string]map[string]CharmEndpoint, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.getStatus(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	charmsAPIClient := apicharms.NewClient(conn)
+	endpoints := make(map[string]map[string]CharmEndpoint, len(input.AppNames))
+	for _, appName := range input.AppNames {
+		appStatus, ok := status.Applications[appName]
+		if !ok {
+			continue
+		}
+		charmEndpoints, err := c.readCharmEndpoints(charmsAPIClient, appStatus.Charm)
+		if err != nil {
+			return nil, err
+		}
+		endpoints[appName] = charmEndpoints
+	}
+	return endpoints, nil
+}
+
+func (c integrationsClient) readCharmEndpoints(charmsAPIClient *apicharms.Client, charmURL string) (map[string]CharmEndpoint, error) {
+	c.charmEndpoints.mu.Lock()
+	defer c.charmEndpoints.mu.Unlock()
+	if endpoints, ok := c.charmEndpoints.endpoints[charmURL]; ok {
+		return endpoints, nil
+	}
+
+	charmInfo, err := charmsAPIClient.CharmInfo(charmURL)
+	if err != nil {
+		return nil, err
+	}
+	// Every charm provides the juju-info endpoint implicitly.
+	endpoints := map[string]CharmEndpoint{
+		"juju-info": {Name: "juju-info", Role: string(charm.RoleProvider), Interface: "juju-info"},
+	}
+	if charmInfo.Meta != nil {
+		for name, relation := range charmInfo.Meta.CombinedRelations() {
+			endpoints[name] = CharmEndpoint{
+				Name:      name,
+				Role:      string(relation.Role),
+				Interface: relation.Interface,
+			}
+		}
+	}
+	c.charmEndpoints.endpoints[charmURL] = endpoints
+	return endpoints, nil
+}
+
+// ListIntegrations returns the integrations of a model, sorted by
+// their key as `juju status` shows them. Peer integrations, which
+// are created with the applications, are not included.
+func (c integrationsClient) ListIntegrations(input ListIntegrationsInput) ([]ReadIntegrationResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.getStatus(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	relations := make([]params.RelationStatus, 0, len(status.Relations))
+	for _, relation := range status.Relations {
+		if len(relation.Endpoints) != 2 {
+			continue
+		}
+		relations = append(relations, relation)
+	}
+	sort.Slice(relations, func(i, j int) bool {
+		return relations[i].Key < relations[j].Key
+	})
+
+	integrations := make([]ReadIntegrationResponse, 0, len(relations))
+	for _, relation := range relations {
+		integrations = append(integrations, ReadIntegrationResponse{
+			Applications: parseApplications(status.RemoteApplications, relation.Endpoints),
+		})
+	}
+	return integrations, nil
+}
+
 func (c integrationsClient) getStatus(conn api.Connection) (*params.FullStatus, error) {
 	client := apiclient.NewClient(conn, c.JujuLogger())
 
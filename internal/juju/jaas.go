@@ -0,0 +1,433 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/rpc/params"
+)
+
+// jimmFacade is the facade JAAS serves its own API with, next to the
+// juju API of the controllers it manages.
+const (
+	jimmFacade        = "JIMM"
+	jimmFacadeVersion = 4
+)
+
+// serviceAccountDomain is the domain of the identities of the service
+// accounts of JAAS, the owner of their cloud credentials.
+const serviceAccountDomain = "serviceaccount"
+
+type jaasClient struct {
+	SharedClient
+}
+
+// The parameters of the JIMM facade, as defined by the apiparams of JAAS.
+
+type jaasGroup struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+type jaasAddGroupRequest struct {
+	Name string `json:"name"`
+}
+
+type jaasGetGroupRequest struct {
+	UUID string `json:"uuid,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type jaasRenameGroupRequest struct {
+	Name    string `json:"name"`
+	NewName string `json:"new-name"`
+}
+
+type jaasRemoveGroupRequest struct {
+	Name string `json:"name"`
+}
+
+type jaasRole struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+type jaasAddRoleRequest struct {
+	Name string `json:"name"`
+}
+
+type jaasGetRoleRequest struct {
+	UUID string `json:"uuid,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type jaasRenameRoleRequest struct {
+	Name    string `json:"name"`
+	NewName string `json:"new-name"`
+}
+
+type jaasRemoveRoleRequest struct {
+	Name string `json:"name"`
+}
+
+type jaasRelationshipTuple struct {
+	Object       string `json:"object"`
+	Relation     string `json:"relation"`
+	TargetObject string `json:"target_object"`
+}
+
+type jaasRelationRequest struct {
+	Tuples []jaasRelationshipTuple `json:"tuples"`
+}
+
+type jaasListRelationshipTuplesRequest struct {
+	Tuple             jaasRelationshipTuple `json:"tuple"`
+	PageSize          int32                 `json:"page_size,omitempty"`
+	ContinuationToken string                `json:"continuation_token,omitempty"`
+}
+
+type jaasListRelationshipTuplesResponse struct {
+	Tuples            []jaasRelationshipTuple `json:"tuples"`
+	ContinuationToken string                  `json:"continuation_token"`
+}
+
+type jaasAddServiceAccountRequest struct {
+	ClientID string `json:"client-id"`
+}
+
+type jaasUpdateServiceAccountCredentialsRequest struct {
+	ClientID string `json:"client-id"`
+	params.UpdateCredentialArgs
+}
+
+type jaasListServiceAccountCredentialsRequest struct {
+	ClientID string `json:"client-id"`
+	params.CloudCredentialArgs
+}
+
+type CreateJAASGroupInput struct {
+	Name string
+}
+
+type CreateJAASGroupResponse struct {
+	UUID string
+}
+
+type ReadJAASGroupInput struct {
+	UUID string
+}
+
+type ReadJAASGroupResponse struct {
+	UUID string
+	Name string
+}
+
+type UpdateJAASGroupInput struct {
+	Name    string
+	NewName string
+}
+
+type DestroyJAASGroupInput struct {
+	Name string
+}
+
+type CreateJAASRoleInput struct {
+	Name string
+}
+
+type CreateJAASRoleResponse struct {
+	UUID string
+}
+
+type ReadJAASRoleInput struct {
+	UUID string
+}
+
+type ReadJAASRoleResponse struct {
+	UUID string
+	Name string
+}
+
+type UpdateJAASRoleInput struct {
+	Name    string
+	NewName string
+}
+
+type DestroyJAASRoleInput struct {
+	Name string
+}
+
+type JAASRelationsInput struct {
+	// TargetObject is the object the relation gives access to, e.g.
+	// model-<owner>/<name>, controller-<name> or group-<name>.
+	TargetObject string
+	// Relation is the relation of the objects to the target object,
+	// e.g. member, administrator or reader.
+	Relation string
+	// Objects are the objects the relation is set for, e.g.
+	// user-<name>, group-<name>#member or serviceaccount-<id>.
+	Objects []string
+}
+
+type ReadJAASRelationsInput struct {
+	TargetObject string
+	Relation     string
+}
+
+type ReadJAASRelationsResponse struct {
+	Objects []string
+}
+
+// JAASCloudCredential is a cloud credential of a service account.
+type JAASCloudCredential struct {
+	Cloud      string
+	Name       string
+	AuthType   string
+	Attributes map[string]string
+}
+
+type CreateJAASServiceAccountInput struct {
+	ClientID    string
+	Credentials []JAASCloudCredential
+}
+
+type ReadJAASServiceAccountInput struct {
+	ClientID string
+	// Credentials are the cloud credentials to read, only their cloud
+	// and name are used. All of the credentials of the service account
+	// are read when empty.
+	Credentials []JAASCloudCredential
+}
+
+type ReadJAASServiceAccountResponse struct {
+	// Credentials are the cloud credentials found, without their
+	// attributes.
+	Credentials []JAASCloudCredential
+}
+
+type UpdateJAASServiceAccountCredentialsInput struct {
+	ClientID    string
+	Credentials []JAASCloudCredential
+}
+
+func newJAASClient(sc SharedClient) *jaasClient {
+	return &jaasClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateGroup adds a group to JAAS.
+func (c *jaasClient) CreateGroup(input CreateJAASGroupInput) (*CreateJAASGroupResponse, error) {
+	var group jaasGroup
+	if err := c.call("AddGroup", jaasAddGroupRequest{Name: input.Name}, &group); err != nil {
+		return nil, err
+	}
+	return &CreateJAASGroupResponse{UUID: group.UUID}, nil
+}
+
+// ReadGroup returns a group of JAAS.
+func (c *jaasClient) ReadGroup(input ReadJAASGroupInput) (*ReadJAASGroupResponse, error) {
+	var group jaasGroup
+	if err := c.call("GetGroup", jaasGetGroupRequest{UUID: input.UUID}, &group); err != nil {
+		return nil, err
+	}
+	return &ReadJAASGroupResponse{UUID: group.UUID, Name: group.Name}, nil
+}
+
+// UpdateGroup renames a group of JAAS, its relations are kept.
+func (c *jaasClient) UpdateGroup(input UpdateJAASGroupInput) error {
+	return c.call("RenameGroup", jaasRenameGroupRequest{Name: input.Name, NewName: input.NewName}, nil)
+}
+
+// DestroyGroup removes a group, and its relations, from JAAS.
+func (c *jaasClient) DestroyGroup(input DestroyJAASGroupInput) error {
+	return c.call("RemoveGroup", jaasRemoveGroupRequest{Name: input.Name}, nil)
+}
+
+// CreateRole adds a role to JAAS.
+func (c *jaasClient) CreateRole(input CreateJAASRoleInput) (*CreateJAASRoleResponse, error) {
+	var role jaasRole
+	if err := c.call("AddRole", jaasAddRoleRequest{Name: input.Name}, &role); err != nil {
+		return nil, err
+	}
+	return &CreateJAASRoleResponse{UUID: role.UUID}, nil
+}
+
+// ReadRole returns a role of JAAS.
+func (c *jaasClient) ReadRole(input ReadJAASRoleInput) (*ReadJAASRoleResponse, error) {
+	var role jaasRole
+	if err := c.call("GetRole", jaasGetRoleRequest{UUID: input.UUID}, &role); err != nil {
+		return nil, err
+	}
+	return &ReadJAASRoleResponse{UUID: role.UUID, Name: role.Name}, nil
+}
+
+// UpdateRole renames a role of JAAS, its relations are kept.
+func (c *jaasClient) UpdateRole(input UpdateJAASRoleInput) error {
+	return c.call("RenameRole", jaasRenameRoleRequest{Name: input.Name, NewName: input.NewName}, nil)
+}
+
+// DestroyRole removes a role, and its relations, from JAAS.
+func (c *jaasClient) DestroyRole(input DestroyJAASRoleInput) error {
+	return c.call("RemoveRole", jaasRemoveRoleRequest{Name: input.Name}, nil)
+}
+
+// CreateServiceAccount adds a service account, registered with the
+// identity provider of JAAS, and its cloud credentials to JAAS. The
+// current user becomes the administrator of the service account.
+func (c *jaasClient) CreateServiceAccount(input CreateJAASServiceAccountInput) error {
+	if err := c.call("AddServiceAccount", jaasAddServiceAccountRequest{ClientID: input.ClientID}, nil); err != nil {
+		return err
+	}
+	return c.UpdateServiceAccountCredentials(UpdateJAASServiceAccountCredentialsInput(input))
+}
+
+// ReadServiceAccount returns the cloud credentials of a service account,
+// credentials which are not found are not returned.
+func (c *jaasClient) ReadServiceAccount(input ReadJAASServiceAccountInput) (*ReadJAASServiceAccountResponse, error) {
+	request := jaasListServiceAccountCredentialsRequest{ClientID: input.ClientID}
+	for _, credential := range input.Credentials {
+		request.Credentials = append(request.Credentials, params.CloudCredentialArg{
+			CloudName:      credential.Cloud,
+			CredentialName: credential.Name,
+		})
+	}
+	var results params.CredentialContentResults
+	if err := c.call("ListServiceAccountCredentials", request, &results); err != nil {
+		return nil, err
+	}
+
+	response := ReadJAASServiceAccountResponse{}
+	for _, result := range results.Results {
+		if result.Error != nil {
+			if params.IsCodeNotFound(result.Error) {
+				continue
+			}
+			return nil, typedError(result.Error)
+		}
+		if result.Result == nil {
+			continue
+		}
+		response.Credentials = append(response.Credentials, JAASCloudCredential{
+			Cloud:    result.Result.Content.Cloud,
+			Name:     result.Result.Content.Name,
+			AuthType: result.Result.Content.AuthType,
+		})
+	}
+	return &response, nil
+}
+
+// UpdateServiceAccountCredentials adds or updates cloud credentials of a
+// service account. JAAS cannot remove the cloud credentials of a
+// service account.
+func (c *jaasClient) UpdateServiceAccountCredentials(input UpdateJAASServiceAccountCredentialsInput) error {
+	if len(input.Credentials) == 0 {
+		return nil
+	}
+	request := jaasUpdateServiceAccountCredentialsRequest{ClientID: input.ClientID}
+	owner := fmt.Sprintf("%s@%s", input.ClientID, serviceAccountDomain)
+	for _, credential := range input.Credentials {
+		tag, err := GetCloudCredentialTag(credential.Cloud, owner, credential.Name)
+		if err != nil {
+			return err
+		}
+		request.Credentials = append(request.Credentials, params.TaggedCredential{
+			Tag: tag.String(),
+			Credential: params.CloudCredential{
+				AuthType:   credential.AuthType,
+				Attributes: credential.Attributes,
+			},
+		})
+	}
+	var results params.UpdateCredentialResults
+	if err := c.call("UpdateServiceAccountCredentials", request, &results); err != nil {
+		return err
+	}
+	for _, result := range results.Results {
+		if result.Error != nil {
+			return errors.Annotatef(typedError(result.Error), "updating credential %q", result.CredentialTag)
+		}
+	}
+	return nil
+}
+
+// AddRelations adds the relation of the objects to the target object.
+func (c *jaasClient) AddRelations(input JAASRelationsInput) error {
+	if len(input.Objects) == 0 {
+		return nil
+	}
+	return c.call("AddRelation", jaasRelationRequest{Tuples: relationshipTuples(input)}, nil)
+}
+
+// ReadRelations returns the objects with a direct relation to the
+// target object, relations inherited through groups or roles are not
+// returned.
+func (c *jaasClient) ReadRelations(input ReadJAASRelationsInput) (*ReadJAASRelationsResponse, error) {
+	request := jaasListRelationshipTuplesRequest{
+		Tuple: jaasRelationshipTuple{
+			Relation:     input.Relation,
+			TargetObject: input.TargetObject,
+		},
+	}
+	var objects []string
+	for {
+		var response jaasListRelationshipTuplesResponse
+		if err := c.call("ListRelationshipTuples", request, &response); err != nil {
+			return nil, err
+		}
+		for _, tuple := range response.Tuples {
+			if tuple.Relation == input.Relation && tuple.TargetObject == input.TargetObject {
+				objects = append(objects, tuple.Object)
+			}
+		}
+		if response.ContinuationToken == "" || len(response.Tuples) == 0 {
+			break
+		}
+		request.ContinuationToken = response.ContinuationToken
+	}
+	return &ReadJAASRelationsResponse{Objects: objects}, nil
+}
+
+// RemoveRelations removes the relation of the objects to the target
+// object.
+func (c *jaasClient) RemoveRelations(input JAASRelationsInput) error {
+	if len(input.Objects) == 0 {
+		return nil
+	}
+	return c.call("RemoveRelation", jaasRelationRequest{Tuples: relationshipTuples(input)}, nil)
+}
+
+// call calls a method of the JIMM facade, it fails when the provider
+// is not connected to JAAS.
+func (c *jaasClient) call(method string, args, response interface{}) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return jaasCall(conn, method, args, response)
+}
+
+func jaasCall(conn api.Connection, method string, args, response interface{}) error {
+	if conn.BestFacadeVersion(jimmFacade) == 0 {
+		return errors.NotSupportedf("JAAS %s on this controller", method)
+	}
+	return typedError(conn.APICall(jimmFacade, jimmFacadeVersion, "", method, args, response))
+}
+
+func relationshipTuples(input JAASRelationsInput) []jaasRelationshipTuple {
+	tuples := make([]jaasRelationshipTuple, 0, len(input.Objects))
+	for _, object := range input.Objects {
+		tuples = append(tuples, jaasRelationshipTuple{
+			Object:       object,
+			Relation:     input.Relation,
+			TargetObject: input.TargetObject,
+		})
+	}
+	return tuples
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,12 +21,15 @@ import (
 	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/model"
+	corestatus "github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/manual"
 	"github.com/juju/juju/environs/manual/sshprovisioner"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/storage"
+	"github.com/juju/names/v4"
 	"github.com/juju/retry"
 )
 
@@ -40,6 +45,14 @@ type CreateMachineInput struct {
 	Series      string
 	InstanceId  string
 
+	// ContainerType is the type of container to create the machine
+	// in, e.g. lxd. The container is created on a new machine unless
+	// ParentID is set.
+	ContainerType string
+	// ParentID is the id of the existing machine to create the
+	// container on.
+	ParentID string
+
 	// SSHAddress is the host address of a machine for manual provisioning
 	// Note that it has the user too, e.g. user@host
 	SSHAddress string
@@ -49,6 +62,13 @@ type CreateMachineInput struct {
 
 	// PrivateKey is the file path to read the private key from
 	PrivateKeyFile string
+
+	// ProvisionTimeout is how long manual provisioning is retried
+	// while it fails, e.g. while the machine is not reachable over
+	// ssh yet. It is attempted once if zero.
+	ProvisionTimeout time.Duration
+
+	Annotations map[string]string
 }
 
 type CreateMachineResponse struct {
@@ -60,6 +80,13 @@ type CreateMachineResponse struct {
 type ReadMachineInput struct {
 	ModelName string
 	ID        string
+	// CachedStatus shares the status of the model with the other
+	// reads of a refresh, see ReadModelStatus.
+	CachedStatus bool
+}
+
+type ListMachinesInput struct {
+	ModelName string
 }
 
 type ReadMachineResponse struct {
@@ -67,6 +94,31 @@ type ReadMachineResponse struct {
 	Base        string
 	Constraints string
 	Series      string
+	// Status is the status of the machine agent, e.g. started.
+	Status string
+	// InstanceStatus and InstanceMessage are the status of the
+	// instance of the machine, e.g. provisioning error, and its
+	// message.
+	InstanceStatus  string
+	InstanceMessage string
+	InstanceId      string
+	Hostname        string
+	IPAddresses     []string
+	// Hardware holds the hardware characteristics of the machine,
+	// e.g. arch, cores and mem.
+	Hardware    map[string]string
+	Annotations map[string]string
+}
+
+type UpdateMachineInput struct {
+	ModelName string
+	ID        string
+	// Base is the base to upgrade the machine to, e.g. ubuntu@22.04.
+	// The machine is not upgraded if empty.
+	Base string
+	// Annotations holds the annotations to be set, an annotation
+	// with an empty value is removed.
+	Annotations map[string]string
 }
 
 type DestroyMachineInput struct {
@@ -81,6 +133,8 @@ func newMachinesClient(sc SharedClient) *machinesClient {
 }
 
 func (c machinesClient) CreateMachine(ctx context.Context, input *CreateMachineInput) (*CreateMachineResponse, error) {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return nil, err
@@ -100,8 +154,15 @@ func (c machinesClient) CreateMachine(ctx context.Context, input *CreateMachineI
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
-		return manualProvision(machineAPIClient, cfg,
-			input.SSHAddress, input.PublicKeyFile, input.PrivateKeyFile)
+		response, err := c.manualProvisionWithRetry(ctx, machineAPIClient, cfg,
+			input.SSHAddress, input.PublicKeyFile, input.PrivateKeyFile, input.ProvisionTimeout)
+		if err != nil {
+			return nil, err
+		}
+		if len(input.Annotations) > 0 {
+			err = setAnnotations(conn, names.NewMachineTag(response.ID), input.Annotations)
+		}
+		return response, err
 	}
 
 	var machineParams params.AddMachineParams
@@ -134,6 +195,15 @@ func (c machinesClient) CreateMachine(ctx context.Context, input *CreateMachineI
 	jobs := []model.MachineJob{model.JobHostUnits}
 	machineParams.Jobs = jobs
 
+	if input.ContainerType != "" {
+		containerType, err := instance.ParseContainerType(input.ContainerType)
+		if err != nil {
+			return nil, err
+		}
+		machineParams.ContainerType = containerType
+		machineParams.ParentId = input.ParentID
+	}
+
 	opSys := input.Base
 	if opSys == "" {
 		opSys = input.Series
@@ -154,6 +224,12 @@ func (c machinesClient) CreateMachine(ctx context.Context, input *CreateMachineI
 	}
 	machineID := machines[0].Machine
 
+	if len(input.Annotations) > 0 {
+		if err := setAnnotations(conn, names.NewMachineTag(machineID), input.Annotations); err != nil {
+			return nil, err
+		}
+	}
+
 	// Read the machine to ensure we have a base and series. It's
 	// not a required field in a minimal machine config.
 	readResponse, err := c.readMachineWithRetryOnNotFound(ctx,
@@ -216,6 +292,39 @@ func fromLegacyCentosChannel(series string) string {
 	return series
 }
 
+// manualProvisionWithRetry calls manualProvision until successful, or
+// the timeout is exceeded. A machine already provisioned is not retried.
+// The machine recorded by a failed attempt is removed by the provisioner.
+func (c machinesClient) manualProvisionWithRetry(ctx context.Context, client manual.ProvisioningClientAPI,
+	config *config.Config, sshAddress string, publicKey string,
+	privateKey string, timeout time.Duration) (*CreateMachineResponse, error) {
+	if timeout <= 0 {
+		return manualProvision(client, config, sshAddress, publicKey, privateKey)
+	}
+	var response *CreateMachineResponse
+	err := retry.Call(retry.CallArgs{
+		Func: func() error {
+			var err error
+			response, err = manualProvision(client, config, sshAddress, publicKey, privateKey)
+			return err
+		},
+		IsFatalError: func(err error) bool {
+			return errors.Is(err, manual.ErrProvisioned)
+		},
+		NotifyFunc: func(err error, attempt int) {
+			c.Debugf(fmt.Sprintf("provisioning %q failed, retrying: %s", sshAddress, err))
+		},
+		Delay:       10 * time.Second,
+		MaxDuration: timeout,
+		Clock:       clock.WallClock,
+		Stop:        ctx.Done(),
+	})
+	if retry.IsRetryStopped(err) || retry.IsDurationExceeded(err) {
+		err = retry.LastError(err)
+	}
+	return response, err
+}
+
 // manualProvision calls the sshprovisioner.ProvisionMachine on the Juju side
 // to provision an existing machine using ssh_address, public_key and
 // private_key in the CreateMachineInput.
@@ -293,27 +402,126 @@ func (c machinesClient) ReadMachine(input ReadMachineInput) (ReadMachineResponse
 	}
 	defer func() { _ = conn.Close() }()
 
-	clientAPIClient := apiclient.NewClient(conn, c.JujuLogger())
-
-	status, err := clientAPIClient.Status(nil)
+	status, err := c.ReadModelStatus(input.ModelName, conn, input.CachedStatus)
 	if err != nil {
 		return response, err
 	}
 
-	machineStatus, exists := status.Machines[input.ID]
+	machineStatus, exists := findMachineStatus(status.Machines, input.ID)
 	if !exists {
 		return response, fmt.Errorf("no status returned for machine: %s", input.ID)
 	}
 	c.Tracef("ReadMachine:Machine status result", map[string]interface{}{"machineStatus": machineStatus})
+	response, err = machineResponseFromStatus(machineStatus)
+	if err != nil {
+		return response, err
+	}
+	response.Annotations, err = getAnnotations(conn, names.NewMachineTag(input.ID))
+	if err != nil {
+		return response, err
+	}
+	return response, nil
+}
+
+// ListMachines returns all of the machines of a model, containers
+// included, sorted by ID. The annotations of the machines are not
+// read.
+func (c machinesClient) ListMachines(input ListMachinesInput) ([]ReadMachineResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	clientAPIClient := apiclient.NewClient(conn, c.JujuLogger())
+
+	status, err := clientAPIClient.Status(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var machines []ReadMachineResponse
+	var addMachines func(map[string]params.MachineStatus) error
+	addMachines = func(statuses map[string]params.MachineStatus) error {
+		for _, machineStatus := range statuses {
+			response, err := machineResponseFromStatus(machineStatus)
+			if err != nil {
+				return err
+			}
+			machines = append(machines, response)
+			if err := addMachines(machineStatus.Containers); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := addMachines(status.Machines); err != nil {
+		return nil, err
+	}
+	sort.Slice(machines, func(i, j int) bool {
+		return lessMachineID(machines[i].ID, machines[j].ID)
+	})
+	return machines, nil
+}
+
+// lessMachineID orders machine IDs numerically, containers following
+// the machine hosting them, e.g. 1, 1/lxd/0, 2, 10.
+func lessMachineID(a, b string) bool {
+	aParts, bParts := strings.Split(a, "/"), strings.Split(b, "/")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if aParts[i] == bParts[i] {
+			continue
+		}
+		aNum, aErr := strconv.Atoi(aParts[i])
+		bNum, bErr := strconv.Atoi(bParts[i])
+		if aErr == nil && bErr == nil {
+			return aNum < bNum
+		}
+		return aParts[i] < bParts[i]
+	}
+	return len(aParts) < len(bParts)
+}
+
+// machineResponseFromStatus returns the details of a machine found in
+// the status of its model.
+func machineResponseFromStatus(machineStatus params.MachineStatus) (ReadMachineResponse, error) {
+	var response ReadMachineResponse
+	var err error
 	response.ID = machineStatus.Id
 	response.Base, response.Series, err = baseAndSeriesFromParams(&machineStatus.Base)
 	if err != nil {
 		return response, err
 	}
 	response.Constraints = machineStatus.Constraints
+	response.Status = machineStatus.AgentStatus.Status
+	response.InstanceId = string(machineStatus.InstanceId)
+	response.Hostname = machineStatus.Hostname
+	response.IPAddresses = machineStatus.IPAddresses
+	response.Hardware = map[string]string{}
+	for _, characteristic := range strings.Fields(machineStatus.Hardware) {
+		if key, value, ok := strings.Cut(characteristic, "="); ok {
+			response.Hardware[key] = value
+		}
+	}
+	response.InstanceStatus = machineStatus.InstanceStatus.Status
+	response.InstanceMessage = machineStatus.InstanceStatus.Info
 	return response, nil
 }
 
+// findMachineStatus returns the status of a machine, containers are
+// only found in the status of the machine hosting them.
+func findMachineStatus(machines map[string]params.MachineStatus, id string) (params.MachineStatus, bool) {
+	if machineStatus, ok := machines[id]; ok {
+		return machineStatus, true
+	}
+	for _, machineStatus := range machines {
+		if found, ok := findMachineStatus(machineStatus.Containers, id); ok {
+			return found, true
+		}
+	}
+	return params.MachineStatus{}, false
+}
+
 // readMachineWithRetryOnNotFound calls ReadMachine until
 // successful, or the count is exceeded when the error is of type
 // not found. Delay indicates how long to wait between attempts.
@@ -347,7 +555,114 @@ func (c machinesClient) readMachineWithRetryOnNotFound(ctx context.Context, inpu
 	return output, err
 }
 
+// WaitForMachineStarted waits until the machine agent is started, as
+// the AllWatcher reports it, or the timeout is exceeded, then reads
+// the machine. A machine which failed to provision is not waited for.
+func (c machinesClient) WaitForMachineStarted(ctx context.Context, input ReadMachineInput, timeout time.Duration) (ReadMachineResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return ReadMachineResponse{}, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	err = watchModel(ctx, conn, c.JujuLogger(), timeout, func(entities *modelEntities) error {
+		machine, ok := entities.machines[input.ID]
+		if !ok {
+			return stillWaiting("machine %q not found", input.ID)
+		}
+		if machine.InstanceStatus.Current == corestatus.ProvisioningError {
+			return errors.Errorf("machine %q failed to provision: %s", input.ID, machine.InstanceStatus.Message)
+		}
+		if machine.AgentStatus.Current != corestatus.Started {
+			c.Debugf(fmt.Sprintf("waiting for machine %q to start: agent is %s", input.ID, machine.AgentStatus.Current))
+			return stillWaiting("machine %q is %s", input.ID, machine.AgentStatus.Current)
+		}
+		return nil
+	})
+	// The machine is read when the wait fails too, for its status to
+	// be saved.
+	output, readErr := c.ReadMachine(input)
+	if err != nil {
+		return output, err
+	}
+	return output, readErr
+}
+
+// UpdateMachine upgrades the base of a machine, as `juju
+// upgrade-machine` prepare and complete do, and sets its annotations.
+// Juju does not upgrade the operating system of the machine itself,
+// it is expected to be upgraded beforehand, e.g. with do-release-upgrade.
+func (c machinesClient) UpdateMachine(ctx context.Context, input UpdateMachineInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if input.Base != "" {
+		machineBase, err := base.ParseBaseFromString(input.Base)
+		if err != nil {
+			return err
+		}
+		machineAPIClient := apimachinemanager.NewClient(conn)
+		if err := machineAPIClient.UpgradeSeriesPrepare(input.ID, machineBase.Channel.String(), false); err != nil {
+			return err
+		}
+		if err := c.waitForUpgradeSeries(ctx, machineAPIClient, input.ID); err != nil {
+			return err
+		}
+		if err := machineAPIClient.UpgradeSeriesComplete(input.ID); err != nil {
+			return err
+		}
+		if err := c.waitForUpgradeSeries(ctx, machineAPIClient, input.ID); err != nil {
+			return err
+		}
+	}
+
+	if len(input.Annotations) > 0 {
+		return setAnnotations(conn, names.NewMachineTag(input.ID), input.Annotations)
+	}
+	return nil
+}
+
+// waitForUpgradeSeries waits for the current step of the upgrade of
+// the base of a machine to be done, the controller stops the watcher
+// of the upgrade notifications once it is.
+func (c machinesClient) waitForUpgradeSeries(ctx context.Context, client *apimachinemanager.Client, id string) error {
+	watcher, watcherID, err := client.WatchUpgradeSeriesNotifications(id)
+	if err != nil {
+		return err
+	}
+	defer watcher.Kill()
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- watcher.Wait() }()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-stopped:
+			if err != nil && !params.IsCodeStopped(err) {
+				return err
+			}
+			return nil
+		case <-watcher.Changes():
+			messages, err := client.GetUpgradeSeriesMessages(id, watcherID)
+			if err != nil {
+				return err
+			}
+			for _, message := range messages {
+				c.Debugf(fmt.Sprintf("upgrading machine %q: %s", id, message))
+			}
+		}
+	}
+}
+
 func (c machinesClient) DestroyMachine(input *DestroyMachineInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return err
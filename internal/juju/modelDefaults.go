@@ -0,0 +1,125 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"github.com/juju/juju/api/client/modelmanager"
+)
+
+type modelDefaultsClient struct {
+	SharedClient
+}
+
+type SetModelDefaultsInput struct {
+	CloudName   string
+	CloudRegion string
+	Config      map[string]string
+	// Unset holds the keys to be reset to their juju default.
+	Unset []string
+}
+
+type ReadModelDefaultsInput struct {
+	CloudName   string
+	CloudRegion string
+}
+
+type ReadModelDefaultsResponse struct {
+	// Config holds the model defaults set for the cloud, or
+	// for the region of the cloud when a region is given.
+	Config map[string]interface{}
+}
+
+type UnsetModelDefaultsInput struct {
+	CloudName   string
+	CloudRegion string
+	Keys        []string
+}
+
+func newModelDefaultsClient(sc SharedClient) *modelDefaultsClient {
+	return &modelDefaultsClient{
+		SharedClient: sc,
+	}
+}
+
+// SetModelDefaults sets and unsets the model defaults of a cloud,
+// or of a region of a cloud when a region is given.
+func (c *modelDefaultsClient) SetModelDefaults(input SetModelDefaultsInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+
+	if len(input.Config) > 0 {
+		config := make(map[string]interface{}, len(input.Config))
+		for key, value := range input.Config {
+			config[key] = value
+		}
+		if err := client.SetModelDefaults(input.CloudName, input.CloudRegion, config); err != nil {
+			return err
+		}
+	}
+
+	if len(input.Unset) > 0 {
+		if err := client.UnsetModelDefaults(input.CloudName, input.CloudRegion, input.Unset...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadModelDefaults returns the model defaults set at the scope of
+// the given cloud or region. Defaults inherited from another scope
+// are not returned.
+func (c *modelDefaultsClient) ReadModelDefaults(input ReadModelDefaultsInput) (*ReadModelDefaultsResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+
+	defaults, err := client.ModelDefaults(input.CloudName)
+	if err != nil {
+		return nil, err
+	}
+
+	config := make(map[string]interface{})
+	for key, values := range defaults {
+		if input.CloudRegion == "" {
+			if values.Controller != nil {
+				config[key] = values.Controller
+			}
+			continue
+		}
+		for _, region := range values.Regions {
+			if region.Name == input.CloudRegion && region.Value != nil {
+				config[key] = region.Value
+			}
+		}
+	}
+
+	return &ReadModelDefaultsResponse{Config: config}, nil
+}
+
+// UnsetModelDefaults resets the given model defaults of a cloud, or
+// of a region of a cloud, to their juju default.
+func (c *modelDefaultsClient) UnsetModelDefaults(input UnsetModelDefaultsInput) error {
+	if len(input.Keys) == 0 {
+		return nil
+	}
+
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+	return client.UnsetModelDefaults(input.CloudName, input.CloudRegion, input.Keys...)
+}
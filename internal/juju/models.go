@@ -4,15 +4,71 @@
 package juju
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apiblock "github.com/juju/juju/api/client/block"
+	apiclient "github.com/juju/juju/api/client/client"
+	cloudapi "github.com/juju/juju/api/client/cloud"
 	"github.com/juju/juju/api/client/modelconfig"
 	"github.com/juju/juju/api/client/modelmanager"
+	"github.com/juju/juju/api/client/modelupgrader"
+	apisecretbackends "github.com/juju/juju/api/client/secretbackends"
+	"github.com/juju/juju/api/client/usermanager"
+	apicontroller "github.com/juju/juju/api/controller/controller"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v4"
+	"github.com/juju/retry"
+	"github.com/juju/version/v2"
+)
+
+const (
+	// SecretBackendConfigKey is the model config key holding the name
+	// of the secret backend used by the model.
+	SecretBackendConfigKey = "secret-backend"
+
+	// ModelUpgradeTimeout is the time to wait for the agents of
+	// a model to be upgraded.
+	ModelUpgradeTimeout = 30 * time.Minute
+
+	// ModelMigrationTimeout is the time to wait for a model to be
+	// migrated to another controller.
+	ModelMigrationTimeout = 30 * time.Minute
+)
+
+// disabledCommandBlockTypes maps the commands which can be disabled
+// on a model, as `juju disable-command` does, to their block type.
+var disabledCommandBlockTypes = map[string]string{
+	"destroy-model": "BlockDestroy",
+	"remove-object": "BlockRemove",
+	"all":           "BlockChange",
+}
+
+// DisabledCommands returns the commands which can be disabled
+// on a model.
+func DisabledCommands() []string {
+	commands := make([]string, 0, len(disabledCommandBlockTypes))
+	for command := range disabledCommandBlockTypes {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+const (
+	// errModelUpgradeInProgress is returned while waiting for the
+	// agents of a model to run the target version.
+	errModelUpgradeInProgress = errors.ConstError("model upgrade in progress")
+
+	// errModelMigrationInProgress is returned while waiting for a
+	// model to leave the controller.
+	errModelMigrationInProgress = errors.ConstError("model migration in progress")
 )
 
 var ModelNotFoundError = &modelNotFoundError{}
@@ -30,6 +86,12 @@ func (me *modelNotFoundError) Error() string {
 	return fmt.Sprintf(toReturn, me.uuid)
 }
 
+// Is makes a model not found error a NotFound error too, for the
+// callers checking errors.Is(err, errors.NotFound).
+func (me *modelNotFoundError) Is(target error) bool {
+	return target == errors.NotFound
+}
+
 type modelsClient struct {
 	SharedClient
 }
@@ -41,26 +103,48 @@ type GrantModelInput struct {
 }
 
 type CreateModelInput struct {
-	Name        string
+	Name string
+	// Owner is the name of the user owning the model, the
+	// current user if empty.
+	Owner       string
 	CloudName   string
 	CloudRegion string
 	Config      map[string]string
 	Credential  string
 	Constraints constraints.Value
+	Annotations map[string]string
+	// SecretBackend is the name of the secret backend used by
+	// the model, it must exist on the controller.
+	SecretBackend string
+	// DisabledCommands maps the commands to disable on the
+	// model to the message given when they are attempted.
+	DisabledCommands map[string]string
+	// SLALevel is the support level of the model, e.g. essential.
+	SLALevel string
 }
 
 type CreateModelResponse struct {
 	Cloud               string
 	CloudRegion         string
 	CloudCredentialName string
+	Owner               string
+	SecretBackend       string
+	SLALevel            string
 	Type                string
 	UUID                string
+	Life                string
+	AgentVersion        string
+	Status              string
 }
 
 type ReadModelResponse struct {
 	ModelInfo        params.ModelInfo
 	ModelConfig      map[string]interface{}
 	ModelConstraints constraints.Value
+	ModelAnnotations map[string]string
+	// DisabledCommands maps the commands disabled on the
+	// model to the message given when they are attempted.
+	DisabledCommands map[string]string
 }
 
 type UpdateModelInput struct {
@@ -70,18 +154,66 @@ type UpdateModelInput struct {
 	Unset       []string
 	Constraints *constraints.Value
 	Credential  string
+	// Annotations holds the annotations to be set, an annotation
+	// with an empty value is removed.
+	Annotations map[string]string
+	// SecretBackend is the name of the secret backend to be used
+	// by the model, it must exist on the controller.
+	SecretBackend string
+	// DisableCommands maps the commands to disable on the model
+	// to the message given when they are attempted. Commands
+	// are disabled once all other changes are made.
+	DisableCommands map[string]string
+	// EnableCommands holds the commands to enable again on the
+	// model. Commands are enabled before any other change.
+	EnableCommands []string
+	// SLALevel is the support level of the model, e.g. essential.
+	SLALevel string
 }
 
 type UpdateAccessModelInput struct {
 	ModelName string
-	OldAccess string
-	Grant     []string
-	Revoke    []string
-	Access    string
+	// Grant holds the users to be granted the access, users
+	// already granted the access are left as they are.
+	Grant  []string
+	Revoke []string
+	Access string
+}
+
+type UpgradeModelInput struct {
+	// Name is the name of the model, qualified by its owner
+	// for a model owned by another user, or its UUID.
+	Name         string
+	AgentVersion string
+}
+
+type MigrateModelInput struct {
+	// Name is the name of the model, qualified by its owner
+	// for a model owned by another user, or its UUID.
+	Name                  string
+	TargetControllerUUID  string
+	TargetControllerAlias string
+	TargetAddresses       []string
+	TargetCACert          string
+	TargetUser            string
+	TargetPassword        string
+}
+
+type MigrateModelResponse struct {
+	MigrationID string
+	UUID        string
 }
 
 type DestroyModelInput struct {
 	UUID string
+	// DestroyStorage destroys the storage of the model, otherwise
+	// the storage is released from the model.
+	DestroyStorage bool
+	// Force destroys the model ignoring any errors.
+	Force bool
+	// Timeout is the time to wait for each step of a forced
+	// destroy. The default is used if nil.
+	Timeout *time.Duration
 }
 
 type DestroyAccessModelInput struct {
@@ -164,7 +296,19 @@ func (c *modelsClient) CreateModel(input CreateModelInput) (CreateModelResponse,
 		configValues[key] = configVal
 	}
 
-	modelInfo, err := client.CreateModel(modelName, currentUser, cloudName, cloudRegion, *cloudCredTag, configValues)
+	if input.SecretBackend != "" {
+		if err := c.validateSecretBackend(input.SecretBackend); err != nil {
+			return resp, err
+		}
+		configValues[SecretBackendConfigKey] = input.SecretBackend
+	}
+
+	owner := input.Owner
+	if owner == "" {
+		owner = currentUser
+	}
+
+	modelInfo, err := client.CreateModel(modelName, owner, cloudName, cloudRegion, *cloudCredTag, configValues)
 	if err != nil {
 		return resp, err
 	}
@@ -172,34 +316,196 @@ func (c *modelsClient) CreateModel(input CreateModelInput) (CreateModelResponse,
 	resp.Cloud = modelInfo.Cloud
 	resp.CloudRegion = modelInfo.CloudRegion
 	resp.CloudCredentialName = names.NewCloudCredentialTag(modelInfo.CloudCredential).Name()
+	resp.Owner = modelInfo.Owner
 	resp.Type = modelInfo.Type.String()
 	resp.UUID = modelInfo.UUID
+	resp.Life = string(modelInfo.Life)
+	resp.Status = string(modelInfo.Status.Status)
+	if modelInfo.AgentVersion != nil {
+		resp.AgentVersion = modelInfo.AgentVersion.String()
+	}
 
-	// Add the model to the client cache of jujuModel
-	c.AddModel(modelInfo.Name, modelInfo.UUID, modelInfo.Type)
-
-	// set constraints when required
-	if input.Constraints.String() == "" {
-		return resp, nil
+	// Add the model to the client cache of jujuModel, models owned
+	// by another user are only known by their qualified name.
+	qualifiedName := QualifiedModelName(modelInfo.Owner, modelInfo.Name)
+	c.AddModel(qualifiedName, modelInfo.UUID, modelInfo.Type)
+	if modelInfo.Owner == currentUser {
+		c.AddModel(modelInfo.Name, modelInfo.UUID, modelInfo.Type)
 	}
 
-	// we have to set constraints ...
-	// establish a new connection with the created model through the modelconfig api to set constraints
-	connModel, err := c.GetConnection(&modelName)
+	// establish a new connection with the created model to read
+	// the secret backend, and set constraints and annotations
+	connModel, err := c.GetConnection(&qualifiedName)
 	if err != nil {
 		return resp, err
 	}
-	defer func() { _ = conn.Close() }()
+	defer func() { _ = connModel.Close() }()
 
 	modelClient := modelconfig.NewClient(connModel)
-	err = modelClient.SetModelConstraints(input.Constraints)
+	resp.SecretBackend = input.SecretBackend
+	if resp.SecretBackend == "" {
+		attrs, err := modelClient.ModelGet()
+		if err != nil {
+			return resp, err
+		}
+		resp.SecretBackend, _ = attrs[SecretBackendConfigKey].(string)
+	}
+
+	if input.Constraints.String() != "" {
+		err = modelClient.SetModelConstraints(input.Constraints)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	if input.SLALevel != "" {
+		err = modelClient.SetSLALevel(input.SLALevel, currentUser, nil)
+		if err != nil {
+			return resp, err
+		}
+	}
+	resp.SLALevel, err = modelClient.SLALevel()
 	if err != nil {
 		return resp, err
 	}
 
+	if len(input.Annotations) > 0 {
+		err = setAnnotations(connModel, names.NewModelTag(modelInfo.UUID), input.Annotations)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	if err := disableModelCommands(connModel, input.DisabledCommands); err != nil {
+		return resp, err
+	}
+
 	return resp, nil
 }
 
+// disableModelCommands disables the given commands on the model
+// of the connection, with the message given when they are attempted.
+func disableModelCommands(conn api.Connection, commands map[string]string) error {
+	if len(commands) == 0 {
+		return nil
+	}
+	client := apiblock.NewClient(conn)
+	for command, message := range commands {
+		blockType, ok := disabledCommandBlockTypes[command]
+		if !ok {
+			return errors.NotValidf("command %q", command)
+		}
+		// A command is disabled once, switch off any
+		// block with a different message first.
+		if err := client.SwitchBlockOff(blockType); err != nil && !errors.Is(typedError(err), errors.NotFound) {
+			return err
+		}
+		if err := client.SwitchBlockOn(blockType, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enableModelCommands enables the given commands on the model
+// of the connection.
+func enableModelCommands(conn api.Connection, commands []string) error {
+	client := apiblock.NewClient(conn)
+	for _, command := range commands {
+		blockType, ok := disabledCommandBlockTypes[command]
+		if !ok {
+			return errors.NotValidf("command %q", command)
+		}
+		if err := client.SwitchBlockOff(blockType); err != nil && !errors.Is(typedError(err), errors.NotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateCloudRegion returns an error if the cloud is not known to
+// the controller, or if the region is not a region of the cloud.
+// An empty region is not validated.
+func (c *modelsClient) ValidateCloudRegion(cloudName, region string) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	clouds, err := client.Clouds()
+	if err != nil {
+		return err
+	}
+	cloud, ok := clouds[names.NewCloudTag(cloudName)]
+	if !ok {
+		known := make([]string, 0, len(clouds))
+		for tag := range clouds {
+			known = append(known, tag.Id())
+		}
+		sort.Strings(known)
+		return errors.NewNotFound(nil, fmt.Sprintf("cloud %q not found, known clouds are %q", cloudName, known))
+	}
+
+	if region == "" || len(cloud.Regions) == 0 {
+		return nil
+	}
+	regions := make([]string, 0, len(cloud.Regions))
+	for _, r := range cloud.Regions {
+		if r.Name == region {
+			return nil
+		}
+		regions = append(regions, r.Name)
+	}
+	return errors.NewNotFound(nil, fmt.Sprintf("region %q not found in cloud %q, known regions are %q", region, cloudName, regions))
+}
+
+// getDisabledModelCommands returns the commands disabled on the
+// model of the connection, with their message.
+func getDisabledModelCommands(conn api.Connection) (map[string]string, error) {
+	blocks, err := apiblock.NewClient(conn).List()
+	if err != nil {
+		return nil, err
+	}
+	commands := make(map[string]string, len(blocks))
+	for _, block := range blocks {
+		for command, blockType := range disabledCommandBlockTypes {
+			if block.Type == blockType {
+				commands[command] = block.Message
+			}
+		}
+	}
+	return commands, nil
+}
+
+// validateSecretBackend returns an error if the named secret backend
+// does not exist on the controller.
+func (c *modelsClient) validateSecretBackend(name string) error {
+	// auto and internal are always available.
+	if name == "auto" || name == "internal" {
+		return nil
+	}
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecretbackends.NewClient(conn)
+	backends, err := client.ListSecretBackends([]string{name}, false)
+	if err != nil {
+		return err
+	}
+	for _, backend := range backends {
+		if backend.Name == name {
+			return backend.Error
+		}
+	}
+	return errors.NotFoundf("secret backend %q", name)
+}
+
 func (c *modelsClient) ReadModel(name string) (*ReadModelResponse, error) {
 	modelmanagerConn, err := c.GetConnection(nil)
 	if err != nil {
@@ -208,8 +514,10 @@ func (c *modelsClient) ReadModel(name string) (*ReadModelResponse, error) {
 	defer func() { _ = modelmanagerConn.Close() }()
 
 	modelconfigConn, err := c.GetConnection(&name)
-	if err != nil {
+	if errors.Is(err, errors.NotFound) || params.IsCodeModelNotFound(err) {
 		return nil, errors.Wrap(err, &modelNotFoundError{uuid: name})
+	} else if err != nil {
+		return nil, err
 	}
 	defer func() { _ = modelconfigConn.Close() }()
 
@@ -248,10 +556,22 @@ func (c *modelsClient) ReadModel(name string) (*ReadModelResponse, error) {
 		return nil, err
 	}
 
+	modelAnnotations, err := getAnnotations(modelconfigConn, names.NewModelTag(modelInfo.UUID))
+	if err != nil {
+		return nil, err
+	}
+
+	disabledCommands, err := getDisabledModelCommands(modelconfigConn)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ReadModelResponse{
 		ModelInfo:        modelInfo,
 		ModelConfig:      modelConfig,
 		ModelConstraints: modelConstraints,
+		ModelAnnotations: modelAnnotations,
+		DisabledCommands: disabledCommands,
 	}, nil
 }
 
@@ -262,13 +582,23 @@ func (c *modelsClient) UpdateModel(input UpdateModelInput) error {
 	}
 	defer func() { _ = conn.Close() }()
 
+	if err := enableModelCommands(conn, input.EnableCommands); err != nil {
+		return err
+	}
+
 	client := modelconfig.NewClient(conn)
 
 	configMap := make(map[string]interface{})
 	for key, value := range input.Config {
 		configMap[key] = value
 	}
-	if input.Config != nil {
+	if input.SecretBackend != "" {
+		if err := c.validateSecretBackend(input.SecretBackend); err != nil {
+			return err
+		}
+		configMap[SecretBackendConfigKey] = input.SecretBackend
+	}
+	if len(configMap) > 0 {
 		err = client.ModelSet(configMap)
 		if err != nil {
 			return err
@@ -289,32 +619,238 @@ func (c *modelsClient) UpdateModel(input UpdateModelInput) error {
 		}
 	}
 
-	if input.Credential != "" {
-		cloudName := input.CloudName
-		currentUser := getCurrentJujuUser(conn)
-		cloudCredTag, err := GetCloudCredentialTag(cloudName, currentUser, input.Credential)
+	if input.SLALevel != "" {
+		err = client.SetSLALevel(input.SLALevel, getCurrentJujuUser(conn), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(input.Annotations) > 0 {
+		modelUUIDTag, modelOk := conn.ModelTag()
+		if !modelOk {
+			return errors.Errorf("Not connected to model %q", input.Name)
+		}
+		err = setAnnotations(conn, modelUUIDTag, input.Annotations)
 		if err != nil {
 			return err
 		}
+	}
+
+	if input.Credential != "" {
 		// open new connection to get facade versions correctly
 		connModelManager, err := c.GetConnection(nil)
 		if err != nil {
 			return err
 		}
-		defer func() { _ = conn.Close() }()
+		defer func() { _ = connModelManager.Close() }()
 		modelUUIDTag, modelOk := conn.ModelTag()
 		if !modelOk {
 			return errors.Errorf("Not connected to model %q", input.Name)
 		}
 		clientModelManager := modelmanager.NewClient(connModelManager)
+
+		// The cloud of the model is used when not given, the
+		// credential must be of the cloud of the model.
+		cloudName := input.CloudName
+		if cloudName == "" {
+			models, err := clientModelManager.ModelInfo([]names.ModelTag{modelUUIDTag})
+			if err != nil {
+				return err
+			}
+			if len(models) != 1 || models[0].Error != nil || models[0].Result == nil {
+				return &modelNotFoundError{name: input.Name}
+			}
+			cloudTag, err := names.ParseCloudTag(models[0].Result.CloudTag)
+			if err != nil {
+				return err
+			}
+			cloudName = cloudTag.Id()
+		}
+
+		currentUser := getCurrentJujuUser(conn)
+		cloudCredTag, err := GetCloudCredentialTag(cloudName, currentUser, input.Credential)
+		if err != nil {
+			return err
+		}
 		if err := clientModelManager.ChangeModelCredential(modelUUIDTag, *cloudCredTag); err != nil {
 			return err
 		}
 	}
 
+	return disableModelCommands(conn, input.DisableCommands)
+}
+
+// UpgradeModel upgrades the agents of a model to the given version,
+// as `juju upgrade-model --agent-version` does, and waits until all
+// machine and unit agents of the model run that version.
+func (c *modelsClient) UpgradeModel(ctx context.Context, input UpgradeModelInput) error {
+	targetVersion, err := version.Parse(input.AgentVersion)
+	if err != nil {
+		return errors.NotValidf("agent version %q", input.AgentVersion)
+	}
+
+	modelUUID, err := c.ModelUUID(input.Name)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelupgrader.NewClient(conn)
+	if _, err := client.UpgradeModel(modelUUID, targetVersion, "", false, false); err != nil {
+		return err
+	}
+
+	return retry.Call(retry.CallArgs{
+		Func: func() error {
+			return c.modelAgentsRunVersion(input.Name, targetVersion)
+		},
+		IsFatalError: func(err error) bool {
+			return !errors.Is(err, errModelUpgradeInProgress)
+		},
+		NotifyFunc: func(err error, attempt int) {
+			if attempt%4 == 0 {
+				message := fmt.Sprintf("waiting for model %q to be upgraded to %s", input.Name, targetVersion)
+				if attempt != 4 {
+					message = "still " + message
+				}
+				c.Debugf(message)
+			}
+		},
+		Delay:       10 * time.Second,
+		MaxDuration: ModelUpgradeTimeout,
+		Clock:       clock.WallClock,
+		Stop:        ctx.Done(),
+	})
+}
+
+// modelAgentsRunVersion returns errModelUpgradeInProgress until the
+// model and all of its machine and unit agents run the given version.
+func (c *modelsClient) modelAgentsRunVersion(modelName string, targetVersion version.Number) error {
+	conn, err := c.GetConnection(&modelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := apiclient.NewClient(conn, c.JujuLogger()).Status(nil)
+	if err != nil {
+		return err
+	}
+
+	versions := []string{status.Model.Version}
+	var addMachines func(map[string]params.MachineStatus)
+	addMachines = func(machines map[string]params.MachineStatus) {
+		for _, machine := range machines {
+			versions = append(versions, machine.AgentStatus.Version)
+			addMachines(machine.Containers)
+		}
+	}
+	addMachines(status.Machines)
+	var addUnits func(map[string]params.UnitStatus)
+	addUnits = func(units map[string]params.UnitStatus) {
+		for _, unit := range units {
+			versions = append(versions, unit.AgentStatus.Version)
+			addUnits(unit.Subordinates)
+		}
+	}
+	for _, application := range status.Applications {
+		addUnits(application.Units)
+	}
+
+	for _, v := range versions {
+		if v != "" && v != targetVersion.String() {
+			return errModelUpgradeInProgress
+		}
+	}
 	return nil
 }
 
+// MigrateModel migrates a model to the target controller, as `juju
+// migrate` does, and waits until the model has left this controller.
+// The model is then unknown to the client.
+func (c *modelsClient) MigrateModel(ctx context.Context, input MigrateModelInput) (*MigrateModelResponse, error) {
+	modelUUID, err := c.ModelUUID(input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	migrationID, err := apicontroller.NewClient(conn).InitiateMigration(apicontroller.MigrationSpec{
+		ModelUUID:             modelUUID,
+		TargetControllerUUID:  input.TargetControllerUUID,
+		TargetControllerAlias: input.TargetControllerAlias,
+		TargetAddrs:           input.TargetAddresses,
+		TargetCACert:          input.TargetCACert,
+		TargetUser:            input.TargetUser,
+		TargetPassword:        input.TargetPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client := modelmanager.NewClient(conn)
+	modelTag := names.NewModelTag(modelUUID)
+	err = retry.Call(retry.CallArgs{
+		Func: func() error {
+			results, err := client.ModelInfo([]names.ModelTag{modelTag})
+			if err != nil {
+				return err
+			}
+			if len(results) != 1 {
+				return fmt.Errorf("expected one model, received %d", len(results))
+			}
+			if err := results[0].Error; err != nil {
+				// The model is no longer on this controller.
+				if params.IsCodeNotFound(err) || params.IsCodeModelNotFound(err) || params.IsRedirect(err) {
+					return nil
+				}
+				return err
+			}
+			migration := results[0].Result.Migration
+			if migration != nil && migration.End != nil {
+				return fmt.Errorf("migration of model %q failed: %s", input.Name, migration.Status)
+			}
+			return errModelMigrationInProgress
+		},
+		IsFatalError: func(err error) bool {
+			return !errors.Is(err, errModelMigrationInProgress)
+		},
+		NotifyFunc: func(err error, attempt int) {
+			if attempt%4 == 0 {
+				message := fmt.Sprintf("waiting for model %q to be migrated", input.Name)
+				if attempt != 4 {
+					message = "still " + message
+				}
+				c.Debugf(message)
+			}
+		},
+		Delay:       10 * time.Second,
+		MaxDuration: ModelMigrationTimeout,
+		Clock:       clock.WallClock,
+		Stop:        ctx.Done(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.RemoveModel(modelUUID)
+	return &MigrateModelResponse{
+		MigrationID: migrationID,
+		UUID:        modelUUID,
+	}, nil
+}
+
 func (c *modelsClient) DestroyModel(input DestroyModelInput) error {
 	conn, err := c.GetConnection(nil)
 	if err != nil {
@@ -329,10 +865,11 @@ func (c *modelsClient) DestroyModel(input DestroyModelInput) error {
 
 	tag := names.NewModelTag(input.UUID)
 
-	destroyStorage := true
-	forceDestroy := false
+	if input.Timeout != nil {
+		timeout = *input.Timeout
+	}
 
-	err = client.DestroyModel(tag, &destroyStorage, &forceDestroy, &maxWait, &timeout)
+	err = client.DestroyModel(tag, &input.DestroyStorage, &input.Force, &maxWait, &timeout)
 	if err != nil {
 		return err
 	}
@@ -366,11 +903,10 @@ func (c *modelsClient) GrantModel(input GrantModelInput) error {
 // Note we do a revoke against `read` to remove the user from the model access
 // If a user has had `write`, then removing that access would decrease their
 // access to `read` and the user will remain part of the model access.
+// A user granted another access than the given one, e.g. out of band, has
+// its access removed before the given access is granted.
 func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
-	model := input.ModelName
-	access := input.OldAccess
-
-	uuid, err := c.ModelUUID(model)
+	uuid, err := c.ModelUUID(input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -382,6 +918,16 @@ func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
 	defer func() { _ = conn.Close() }()
 
 	client := modelmanager.NewClient(conn)
+	usermanagerClient := usermanager.NewClient(conn)
+
+	modelUsers, err := usermanagerClient.ModelUserInfo(uuid)
+	if err != nil {
+		return err
+	}
+	currentAccess := make(map[string]string, len(modelUsers))
+	for _, modelUser := range modelUsers {
+		currentAccess[modelUser.UserName] = string(modelUser.Access)
+	}
 
 	for _, user := range input.Revoke {
 		err := client.RevokeModel(user, "read", uuid)
@@ -391,8 +937,16 @@ func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
 	}
 
 	for _, user := range input.Grant {
-		err := client.GrantModel(user, access, uuid)
-		if err != nil {
+		access, granted := currentAccess[user]
+		if access == input.Access {
+			continue
+		}
+		if granted {
+			if err := client.RevokeModel(user, "read", uuid); err != nil {
+				return err
+			}
+		}
+		if err := client.GrantModel(user, input.Access, uuid); err != nil {
 			return err
 		}
 	}
@@ -5,16 +5,19 @@ package juju
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
-	"github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/api"
 	apiapplication "github.com/juju/juju/api/client/application"
 	"github.com/juju/juju/api/client/applicationoffers"
 	apiclient "github.com/juju/juju/api/client/client"
 	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v4"
 )
@@ -23,9 +26,6 @@ const (
 	// OfferAppAvailableTimeout is the time to wait for an app to be available
 	// before creating an offer.
 	OfferAppAvailableTimeout = time.Second * 60
-	// OfferApiTickWait is the time to wait between consecutive requests
-	// to the API
-	OfferApiTickWait = time.Second * 5
 )
 
 type offersClient struct {
@@ -34,7 +34,7 @@ type offersClient struct {
 
 type CreateOfferInput struct {
 	ApplicationName string
-	Endpoint        string
+	Endpoints       []string
 	ModelName       string
 	ModelOwner      string
 	Name            string
@@ -51,19 +51,63 @@ type ReadOfferInput struct {
 
 type ReadOfferResponse struct {
 	ApplicationName string
-	Endpoint        string
+	Connections     []OfferConnection
+	Endpoints       []string
 	ModelName       string
 	Name            string
 	OfferURL        string
+	// Users holds the access of the users to the offer,
+	// keyed by user name.
+	Users map[string]string
+}
+
+// OfferConnection describes a relation made by a consumer
+// of an offer.
+type OfferConnection struct {
+	SourceModelUUID string
+	Username        string
+	Endpoint        string
+	RelationID      int
+	Status          string
+}
+
+type ListOffersInput struct {
+	ModelName string
 }
 
 type DestroyOfferInput struct {
 	OfferURL string
+	// Force destroys the offer at once, removing the connections
+	// of its consumers.
+	Force bool
+}
+
+type GrantOfferAccessInput struct {
+	OfferURL string
+	Access   string
+	Users    []string
+}
+
+type RevokeOfferAccessInput struct {
+	OfferURL string
+	Users    []string
 }
 
 type ConsumeRemoteOfferInput struct {
 	ModelName string
 	OfferURL  string
+	// SAASName is the name of the consumed offer in the model, as
+	// `juju consume <offer> <alias>` does. Defaults to the offer name.
+	SAASName string
+	// ConsumeDetails holds the details of the offer read with
+	// ReadOfferConsumeDetails from the controller of the offer. They
+	// are read from the controller of the model when empty, which
+	// requires the offer to be on the same controller.
+	ConsumeDetails string
+}
+
+type ReadOfferConsumeDetailsInput struct {
+	OfferURL string
 }
 
 type ConsumeRemoteOfferResponse struct {
@@ -73,6 +117,14 @@ type ConsumeRemoteOfferResponse struct {
 type RemoveRemoteOfferInput struct {
 	ModelName string
 	OfferURL  string
+	// SAASName is the name of the consumed offer in the model. The
+	// consumed offer is found by its URL when empty.
+	SAASName string
+	// RemovedApplication is the application of an integration being
+	// removed with the consumed offer. When set, the consumed offer is
+	// only removed when it has no integration with another application,
+	// and is not an error when it is already removed.
+	RemovedApplication string
 }
 
 func newOffersClient(sc SharedClient) *offersClient {
@@ -82,6 +134,8 @@ func newOffersClient(sc SharedClient) *offersClient {
 }
 
 func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse, []error) {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	var errs []error
 
 	conn, err := c.GetConnection(nil)
@@ -103,22 +157,21 @@ func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse
 		return nil, append(errs, err)
 	}
 	defer func() { _ = modelConn.Close() }()
-	applicationClient := application.NewClient(modelConn)
 
 	// wait for the app to be available
 	ctx, cancel := context.WithTimeout(context.Background(), OfferAppAvailableTimeout)
 	defer cancel()
 
-	err = WaitForAppsAvailable(ctx, applicationClient, []string{input.ApplicationName}, OfferApiTickWait)
+	err = WaitForAppsAvailable(ctx, modelConn, c.JujuLogger(), []string{input.ApplicationName})
 	if err != nil {
 		return nil, append(errs, errors.New("the application was not available to be offered"))
 	}
 
-	modelUUID, err := c.ModelUUID(input.ModelName)
+	model, err := c.ResolveModel(input.ModelName)
 	if err != nil {
 		return nil, append(errs, err)
 	}
-	result, err := client.Offer(modelUUID, input.ApplicationName, []string{input.Endpoint}, "admin", offerName, "")
+	result, err := client.Offer(model.UUID, input.ApplicationName, input.Endpoints, "admin", offerName, "")
 	if err != nil {
 		return nil, append(errs, err)
 	}
@@ -135,9 +188,11 @@ func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse
 		return nil, errs
 	}
 
+	// The offer is found by the name of the model, which is
+	// resolved when the model is given by its UUID.
 	filter := crossmodel.ApplicationOfferFilter{
 		OfferName: offerName,
-		ModelName: input.ModelName,
+		ModelName: model.Name,
 		OwnerName: input.ModelOwner,
 	}
 
@@ -166,24 +221,93 @@ func (c offersClient) ReadOffer(input *ReadOfferInput) (*ReadOfferResponse, erro
 		return nil, err
 	}
 
+	return offerResponse(conn, result)
+}
+
+// ListOffers returns the offers of a model, sorted by name.
+func (c offersClient) ListOffers(input *ListOffersInput) ([]ReadOfferResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	owner, modelName := SplitModelName(input.ModelName)
+	if owner == "" {
+		owner = getCurrentJujuUser(conn)
+	}
+	client := applicationoffers.NewClient(conn)
+	results, err := client.ListOffers(crossmodel.ApplicationOfferFilter{
+		OwnerName: owner,
+		ModelName: modelName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]ReadOfferResponse, 0, len(results))
+	for _, result := range results {
+		response, err := offerResponse(conn, result)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, *response)
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Name < responses[j].Name
+	})
+	return responses, nil
+}
+
+func offerResponse(conn api.Connection, result *crossmodel.ApplicationOfferDetails) (*ReadOfferResponse, error) {
 	var response ReadOfferResponse
 	response.Name = result.OfferName
 	response.ApplicationName = result.ApplicationName
 	response.OfferURL = result.OfferURL
-	response.Endpoint = result.Endpoints[0].Name
+	for _, endpoint := range result.Endpoints {
+		response.Endpoints = append(response.Endpoints, endpoint.Name)
+	}
+	sort.Strings(response.Endpoints)
+	for _, conn := range result.Connections {
+		response.Connections = append(response.Connections, OfferConnection{
+			SourceModelUUID: conn.SourceModelUUID,
+			Username:        conn.Username,
+			Endpoint:        conn.Endpoint,
+			RelationID:      conn.RelationId,
+			Status:          string(conn.Status),
+		})
+	}
+	response.Users = make(map[string]string, len(result.Users))
+	for _, user := range result.Users {
+		response.Users[user.UserName] = string(user.Access)
+	}
 
 	//no model name is returned but it can be parsed from the resulting offer URL to ensure parity
 	//TODO: verify if we can fetch information another way
-	modelName, ok := parseModelFromURL(result.OfferURL)
-	if !ok {
-		return nil, fmt.Errorf("unable to parse model name from offer URL")
+	offerURL, err := crossmodel.ParseOfferURL(result.OfferURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse model name from offer URL: %w", err)
+	}
+	// Models owned by another user are known by their qualified name.
+	response.ModelName = offerURL.ModelName
+	if offerURL.User != getCurrentJujuUser(conn) {
+		response.ModelName = QualifiedModelName(offerURL.User, offerURL.ModelName)
 	}
-	response.ModelName = modelName
 
 	return &response, nil
 }
 
 func (c offersClient) DestroyOffer(input *DestroyOfferInput) error {
+	// The status of the model offering the application lists its
+	// offers.
+	if offerURL, err := crossmodel.ParseOfferURL(input.OfferURL); err == nil {
+		modelName := offerURL.ModelName
+		if offerURL.User != "" {
+			modelName = QualifiedModelName(offerURL.User, offerURL.ModelName)
+		}
+		defer c.InvalidateModelStatus(modelName)
+	}
+
 	conn, err := c.GetConnection(nil)
 	if err != nil {
 		return err
@@ -196,9 +320,9 @@ func (c offersClient) DestroyOffer(input *DestroyOfferInput) error {
 		return err
 	}
 
-	forceDestroy := false
+	forceDestroy := input.Force
 	//This code loops until it detects 0 connections in the offer or 3 minutes elapses
-	if len(offer.Connections) > 0 {
+	if len(offer.Connections) > 0 && !forceDestroy {
 		end := time.Now().Add(5 * time.Minute)
 		for ok := true; ok; ok = len(offer.Connections) > 0 {
 			//if we have been failing to destroy offer for 5 minutes then force destroy
@@ -223,6 +347,63 @@ func (c offersClient) DestroyOffer(input *DestroyOfferInput) error {
 	return nil
 }
 
+// GrantOfferAccess grants the given access to an offer to the users.
+// Users already granted the access are left as they are, the access of
+// users granted another access is removed before the given access is
+// granted.
+func (c offersClient) GrantOfferAccess(input *GrantOfferAccessInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := applicationoffers.NewClient(conn)
+	offer, err := client.ApplicationOffer(input.OfferURL)
+	if err != nil {
+		return err
+	}
+	currentAccess := make(map[string]string, len(offer.Users))
+	for _, user := range offer.Users {
+		currentAccess[user.UserName] = string(user.Access)
+	}
+
+	for _, user := range input.Users {
+		access, granted := currentAccess[user]
+		if access == input.Access {
+			continue
+		}
+		if granted {
+			if err := client.RevokeOffer(user, string(permission.ReadAccess), input.OfferURL); err != nil {
+				return err
+			}
+		}
+		if err := client.GrantOffer(user, input.Access, input.OfferURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeOfferAccess removes any access to an offer from the users.
+// Revoking read access removes the user from the offer, as revoking
+// the consume or admin access only downgrades it.
+func (c offersClient) RevokeOfferAccess(input *RevokeOfferAccessInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := applicationoffers.NewClient(conn)
+	for _, user := range input.Users {
+		if err := client.RevokeOffer(user, string(permission.ReadAccess), input.OfferURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func findApplicationOffers(client *applicationoffers.Client, filter crossmodel.ApplicationOfferFilter) (*crossmodel.ApplicationOfferDetails, error) {
 	offers, err := client.FindApplicationOffers(filter)
 	if err != nil {
@@ -236,22 +417,10 @@ func findApplicationOffers(client *applicationoffers.Client, filter crossmodel.A
 	return offers[0], nil
 }
 
-func parseModelFromURL(url string) (result string, success bool) {
-	start := strings.Index(url, "/")
-	if start == -1 {
-		return result, false
-	}
-	newURL := url[start+1:]
-	end := strings.Index(newURL, ".")
-	if end == -1 {
-		return result, false
-	}
-	result = newURL[:end]
-	return result, true
-}
-
 // This function allows the integration resource to consume the offers managed by the offer resource
 func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*ConsumeRemoteOfferResponse, error) {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	modelConn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
 		return nil, err
@@ -275,9 +444,19 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 		return nil, fmt.Errorf("saas offer %q shouldn't include endpoint", input.OfferURL)
 	}
 
-	consumeDetails, err := offersClient.GetConsumeDetails(url.AsLocal().String())
-	if err != nil {
-		return nil, err
+	var consumeDetails params.ConsumeOfferDetails
+	if input.ConsumeDetails != "" {
+		if err := json.Unmarshal([]byte(input.ConsumeDetails), &consumeDetails); err != nil {
+			return nil, fmt.Errorf("parsing the consume details of offer %q: %w", input.OfferURL, err)
+		}
+		if consumeDetails.Offer == nil {
+			return nil, fmt.Errorf("the consume details of offer %q hold no offer", input.OfferURL)
+		}
+	} else {
+		consumeDetails, err = offersClient.GetConsumeDetails(url.AsLocal().String())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	offerURL, err := crossmodel.ParseOfferURL(consumeDetails.Offer.OfferURL)
@@ -287,9 +466,34 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 	offerURL.Source = url.Source
 	consumeDetails.Offer.OfferURL = offerURL.String()
 
+	// The offer may be consumed already, or its name may be the
+	// name of an application of the model.
+	status, err := apiclient.NewClient(modelConn, c.JujuLogger()).Status(nil)
+	if err != nil {
+		return nil, err
+	}
+	saasName := input.SAASName
+	if saasName == "" {
+		for name, remote := range status.RemoteApplications {
+			if remote.OfferURL == consumeDetails.Offer.OfferURL {
+				return &ConsumeRemoteOfferResponse{SAASName: name}, nil
+			}
+		}
+		saasName = consumeDetails.Offer.OfferName
+	}
+	if _, exists := status.Applications[saasName]; exists {
+		return nil, fmt.Errorf("an application named %q exists in the model, set a SAAS name to consume offer %q under another name", saasName, input.OfferURL)
+	}
+	if remote, exists := status.RemoteApplications[saasName]; exists {
+		if remote.OfferURL != consumeDetails.Offer.OfferURL {
+			return nil, fmt.Errorf("SAAS %q of the model consumes offer %q, set another SAAS name to consume offer %q", saasName, remote.OfferURL, input.OfferURL)
+		}
+		return &ConsumeRemoteOfferResponse{SAASName: saasName}, nil
+	}
+
 	consumeArgs := crossmodel.ConsumeApplicationArgs{
 		Offer:            *consumeDetails.Offer,
-		ApplicationAlias: consumeDetails.Offer.OfferName,
+		ApplicationAlias: saasName,
 		Macaroon:         consumeDetails.Macaroon,
 	}
 	if consumeDetails.ControllerInfo != nil {
@@ -331,8 +535,41 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 	return &response, nil
 }
 
+// ReadOfferConsumeDetails returns the details needed to consume an
+// offer of the controller, its macaroon included, as an opaque JSON
+// string. The details are given to ConsumeRemoteOffer to consume the
+// offer from the model of another controller.
+func (c offersClient) ReadOfferConsumeDetails(input *ReadOfferConsumeDetailsInput) (string, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = conn.Close() }()
+
+	url, err := crossmodel.ParseOfferURL(input.OfferURL)
+	if err != nil {
+		return "", err
+	}
+	if url.HasEndpoint() {
+		return "", fmt.Errorf("offer URL %q shouldn't include endpoint", input.OfferURL)
+	}
+
+	client := applicationoffers.NewClient(conn)
+	consumeDetails, err := client.GetConsumeDetails(url.AsLocal().String())
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(consumeDetails)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // This function allows the integration resource to destroy the offers managed by the offer resource
 func (c offersClient) RemoveRemoteOffer(input *RemoveRemoteOfferInput) []error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
 	var errors []error
 	conn, err := c.GetConnection(&input.ModelName)
 	if err != nil {
@@ -353,25 +590,47 @@ func (c offersClient) RemoveRemoteOffer(input *RemoveRemoteOfferInput) []error {
 	remoteApplications := status.RemoteApplications
 
 	if len(remoteApplications) == 0 {
+		if input.RemovedApplication != "" {
+			return nil
+		}
 		errors = append(errors, fmt.Errorf("no offers found in model"))
 		return errors
 	}
 
-	var offerName string
-	for _, v := range remoteApplications {
-		if v.Err != nil {
-			errors = append(errors, v.Err)
-			return errors
+	// The consumed offers are keyed by their SAAS name, which is the
+	// offer name unless an alias was given.
+	saasName := input.SAASName
+	if saasName == "" {
+		for name, v := range remoteApplications {
+			if v.Err != nil {
+				errors = append(errors, v.Err)
+				return errors
+			}
+			if v.OfferURL != input.OfferURL {
+				continue
+			}
+			saasName = name
 		}
-		if v.OfferURL != input.OfferURL {
-			continue
+	}
+
+	if input.RemovedApplication != "" {
+		remote, ok := remoteApplications[saasName]
+		if !ok {
+			return nil
+		}
+		for _, apps := range remote.Relations {
+			for _, app := range apps {
+				if app != input.RemovedApplication {
+					c.Tracef(fmt.Sprintf("consumed offer %q kept, it is integrated with %q", saasName, app))
+					return nil
+				}
+			}
 		}
-		offerName = v.OfferName
 	}
 
 	returnErrors, err := client.DestroyConsumedApplication(apiapplication.DestroyConsumedApplicationParams{
 		SaasNames: []string{
-			offerName,
+			saasName,
 		},
 	})
 	if err != nil {
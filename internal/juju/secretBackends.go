@@ -0,0 +1,156 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/errors"
+	apisecretbackends "github.com/juju/juju/api/client/secretbackends"
+)
+
+type secretBackendsClient struct {
+	SharedClient
+}
+
+type CreateSecretBackendInput struct {
+	Name        string
+	BackendType string
+	// TokenRotateInterval is how often the token of the backend
+	// is rotated, it is not rotated if nil.
+	TokenRotateInterval *time.Duration
+	Config              map[string]string
+}
+
+type ReadSecretBackendInput struct {
+	Name string
+}
+
+type ReadSecretBackendResponse struct {
+	Name                string
+	BackendType         string
+	TokenRotateInterval *time.Duration
+	// Config holds the configuration of the backend, with the
+	// values of sensitive keys revealed.
+	Config map[string]string
+	Status string
+}
+
+type UpdateSecretBackendInput struct {
+	Name                string
+	TokenRotateInterval *time.Duration
+	// Config holds the configuration keys to set.
+	Config map[string]string
+	// Reset holds the configuration keys to reset to their default.
+	Reset []string
+}
+
+type DestroySecretBackendInput struct {
+	Name string
+	// Force removes the backend even if it holds secrets.
+	Force bool
+}
+
+func newSecretBackendsClient(sc SharedClient) *secretBackendsClient {
+	return &secretBackendsClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateSecretBackend adds a secret backend to the controller, as
+// `juju add-secret-backend` does.
+func (c *secretBackendsClient) CreateSecretBackend(input CreateSecretBackendInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecretbackends.NewClient(conn)
+
+	return client.AddSecretBackend(apisecretbackends.CreateSecretBackend{
+		Name:                input.Name,
+		BackendType:         input.BackendType,
+		TokenRotateInterval: input.TokenRotateInterval,
+		Config:              secretBackendConfig(input.Config),
+	})
+}
+
+// ReadSecretBackend returns a secret backend of the controller.
+func (c *secretBackendsClient) ReadSecretBackend(input ReadSecretBackendInput) (*ReadSecretBackendResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecretbackends.NewClient(conn)
+
+	backends, err := client.ListSecretBackends([]string{input.Name}, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, backend := range backends {
+		if backend.Name != input.Name {
+			continue
+		}
+		if backend.Error != nil {
+			return nil, typedError(backend.Error)
+		}
+		config := make(map[string]string, len(backend.Config))
+		for key, value := range backend.Config {
+			config[key] = fmt.Sprint(value)
+		}
+		return &ReadSecretBackendResponse{
+			Name:                backend.Name,
+			BackendType:         backend.BackendType,
+			TokenRotateInterval: backend.TokenRotateInterval,
+			Config:              config,
+			Status:              string(backend.Status),
+		}, nil
+	}
+	return nil, errors.NotFoundf("secret backend %q", input.Name)
+}
+
+// UpdateSecretBackend updates the configuration of a secret backend.
+func (c *secretBackendsClient) UpdateSecretBackend(input UpdateSecretBackendInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecretbackends.NewClient(conn)
+
+	return client.UpdateSecretBackend(apisecretbackends.UpdateSecretBackend{
+		Name:                input.Name,
+		TokenRotateInterval: input.TokenRotateInterval,
+		Config:              secretBackendConfig(input.Config),
+		Reset:               input.Reset,
+	}, false)
+}
+
+// DestroySecretBackend removes a secret backend from the controller.
+// A backend holding secrets or used by a model is only removed if
+// forced.
+func (c *secretBackendsClient) DestroySecretBackend(input DestroySecretBackendInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecretbackends.NewClient(conn)
+
+	return client.RemoveSecretBackend(input.Name, input.Force)
+}
+
+func secretBackendConfig(config map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(config))
+	for key, value := range config {
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	coresecrets "github.com/juju/juju/core/secrets"
+)
+
+type secretsClient struct {
+	SharedClient
+}
+
+type ReadSecretInput struct {
+	ModelName string
+	// SecretId is the URI of the secret, or its ID.
+	SecretId string
+	// Label finds the secret by its label when SecretId is empty.
+	Label string
+}
+
+type ReadSecretResponse struct {
+	SecretId    string
+	Label       string
+	Description string
+	Owner       string
+	Revision    int
+	// RotatePolicy is how often the secret is rotated, e.g. daily.
+	RotatePolicy string
+	// NextRotateTime and ExpireTime are nil when the secret is not
+	// rotated, or its latest revision does not expire.
+	NextRotateTime *time.Time
+	ExpireTime     *time.Time
+	CreateTime     time.Time
+	UpdateTime     time.Time
+}
+
+type ListSecretsInput struct {
+	ModelName string
+	// Owner only lists the secrets of the owner, given by its tag,
+	// e.g. application-postgresql.
+	Owner string
+}
+
+type GrantSecretAccessInput struct {
+	ModelName    string
+	SecretId     string
+	Applications []string
+}
+
+type RevokeSecretAccessInput struct {
+	ModelName    string
+	SecretId     string
+	Applications []string
+}
+
+func newSecretsClient(sc SharedClient) *secretsClient {
+	return &secretsClient{
+		SharedClient: sc,
+	}
+}
+
+// ReadSecret returns the metadata of a secret of the model, the value
+// of the secret is not revealed.
+func (c *secretsClient) ReadSecret(input ReadSecretInput) (*ReadSecretResponse, error) {
+	var filter coresecrets.Filter
+	if input.SecretId != "" {
+		uri, err := coresecrets.ParseURI(input.SecretId)
+		if err != nil {
+			return nil, err
+		}
+		filter.URI = uri
+	} else {
+		filter.Label = &input.Label
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecrets.NewClient(conn)
+
+	results, err := client.ListSecrets(false, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		if input.SecretId == "" {
+			return nil, errors.NotFoundf("secret with label %q", input.Label)
+		}
+		return nil, errors.NotFoundf("secret %q", input.SecretId)
+	}
+	if len(results) > 1 {
+		return nil, errors.Errorf("more than one secret with label %q", input.Label)
+	}
+	if results[0].Error != "" {
+		return nil, errors.New(results[0].Error)
+	}
+
+	response := secretResponseFromMetadata(results[0].Metadata)
+	return &response, nil
+}
+
+// ListSecrets returns the metadata of the secrets of the model, the
+// values of the secrets are not revealed.
+func (c *secretsClient) ListSecrets(input ListSecretsInput) ([]ReadSecretResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecrets.NewClient(conn)
+
+	var filter coresecrets.Filter
+	if input.Owner != "" {
+		filter.OwnerTag = &input.Owner
+	}
+	results, err := client.ListSecrets(false, filter)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]ReadSecretResponse, 0, len(results))
+	for _, result := range results {
+		if result.Error != "" {
+			return nil, errors.New(result.Error)
+		}
+		secrets = append(secrets, secretResponseFromMetadata(result.Metadata))
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].SecretId < secrets[j].SecretId
+	})
+	return secrets, nil
+}
+
+func secretResponseFromMetadata(metadata coresecrets.SecretMetadata) ReadSecretResponse {
+	return ReadSecretResponse{
+		SecretId:       metadata.URI.String(),
+		Label:          metadata.Label,
+		Description:    metadata.Description,
+		Owner:          metadata.OwnerTag,
+		Revision:       metadata.LatestRevision,
+		RotatePolicy:   string(metadata.RotatePolicy),
+		NextRotateTime: metadata.NextRotateTime,
+		ExpireTime:     metadata.LatestExpireTime,
+		CreateTime:     metadata.CreateTime,
+		UpdateTime:     metadata.UpdateTime,
+	}
+}
+
+// GrantSecretAccess grants the applications access to a user secret,
+// as `juju grant-secret` does.
+func (c *secretsClient) GrantSecretAccess(input GrantSecretAccessInput) error {
+	uri, err := coresecrets.ParseURI(input.SecretId)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecrets.NewClient(conn)
+
+	results, err := client.GrantSecret(uri, "", input.Applications)
+	if err != nil {
+		return err
+	}
+	return secretAccessError(input.Applications, results)
+}
+
+// RevokeSecretAccess revokes the access of the applications to a user
+// secret, as `juju revoke-secret` does.
+func (c *secretsClient) RevokeSecretAccess(input RevokeSecretAccessInput) error {
+	uri, err := coresecrets.ParseURI(input.SecretId)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apisecrets.NewClient(conn)
+
+	results, err := client.RevokeSecret(uri, "", input.Applications)
+	if err != nil {
+		return err
+	}
+	return secretAccessError(input.Applications, results)
+}
+
+// secretAccessError aggregates the errors of granting or revoking the
+// access of each application.
+func secretAccessError(applications []string, results []error) error {
+	messages := []string{}
+	for i, err := range results {
+		if err != nil {
+			messages = append(messages, applications[i]+": "+err.Error())
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
@@ -0,0 +1,277 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api/client/spaces"
+	"github.com/juju/juju/api/client/subnets"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v4"
+)
+
+type spacesClient struct {
+	SharedClient
+}
+
+type CreateSpaceInput struct {
+	ModelName string
+	Name      string
+}
+
+type ReadSpaceInput struct {
+	ModelName string
+	Name      string
+}
+
+type ReadSpaceResponse struct {
+	Name string
+	// Subnets are the CIDRs of the subnets in the space.
+	Subnets []string
+}
+
+type ListSpacesInput struct {
+	ModelName string
+}
+
+type DestroySpaceInput struct {
+	ModelName string
+	Name      string
+}
+
+type MoveSubnetInput struct {
+	ModelName string
+	CIDR      string
+	// Space is the space the subnet is moved to.
+	Space string
+}
+
+type ReadSubnetInput struct {
+	ModelName string
+	CIDR      string
+}
+
+type ReadSubnetResponse struct {
+	CIDR       string
+	Space      string
+	ProviderId string
+	VLANTag    int
+	Zones      []string
+}
+
+type ListSubnetsInput struct {
+	ModelName string
+	// Space and Zone filter the subnets when set.
+	Space string
+	Zone  string
+}
+
+func newSpacesClient(sc SharedClient) *spacesClient {
+	return &spacesClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateSpace adds an empty space to the model, as `juju add-space`
+// does, subnets are moved to it with MoveSubnet.
+func (c *spacesClient) CreateSpace(input CreateSpaceInput) error {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := spaces.NewAPI(conn)
+
+	return client.CreateSpace(input.Name, nil, false)
+}
+
+// ReadSpace returns a space of the model with the subnets it holds.
+func (c *spacesClient) ReadSpace(input ReadSpaceInput) (*ReadSpaceResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := spaces.NewAPI(conn)
+
+	result, err := client.ShowSpace(input.Name)
+	if err != nil {
+		return nil, typedError(err)
+	}
+
+	cidrs := make([]string, 0, len(result.Space.Subnets))
+	for _, subnet := range result.Space.Subnets {
+		cidrs = append(cidrs, subnet.CIDR)
+	}
+	return &ReadSpaceResponse{
+		Name:    result.Space.Name,
+		Subnets: cidrs,
+	}, nil
+}
+
+// ListSpaces returns the spaces of the model with the subnets they
+// hold, sorted by name.
+func (c *spacesClient) ListSpaces(input ListSpacesInput) ([]ReadSpaceResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := spaces.NewAPI(conn)
+
+	results, err := client.ListSpaces()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]ReadSpaceResponse, 0, len(results))
+	for _, space := range results {
+		cidrs := make([]string, 0, len(space.Subnets))
+		for _, subnet := range space.Subnets {
+			cidrs = append(cidrs, subnet.CIDR)
+		}
+		sort.Strings(cidrs)
+		responses = append(responses, ReadSpaceResponse{
+			Name:    space.Name,
+			Subnets: cidrs,
+		})
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Name < responses[j].Name
+	})
+	return responses, nil
+}
+
+// DestroySpace removes a space from the model, its subnets are moved
+// back to the alpha space.
+func (c *spacesClient) DestroySpace(input DestroySpaceInput) error {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := spaces.NewAPI(conn)
+
+	result, err := client.RemoveSpace(input.Name, false, false)
+	if err != nil {
+		return err
+	}
+	if len(result.Bindings) > 0 || len(result.Constraints) > 0 || len(result.ControllerSettings) > 0 {
+		return errors.Errorf("space %q is in use by bindings, constraints or controller settings", input.Name)
+	}
+	return nil
+}
+
+// MoveSubnet moves a subnet known to the model to a space, as
+// `juju move-to-space` does.
+func (c *spacesClient) MoveSubnet(input MoveSubnetInput) error {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	results, err := subnets.NewAPI(conn).SubnetsByCIDR([]string{input.CIDR})
+	if err != nil {
+		return err
+	}
+	subnetTags := []names.SubnetTag{}
+	for _, result := range results {
+		for _, subnet := range result.Subnets {
+			subnetTags = append(subnetTags, names.NewSubnetTag(subnet.ID))
+		}
+	}
+	if len(subnetTags) == 0 {
+		return errors.NotFoundf("subnet %q", input.CIDR)
+	}
+
+	space := input.Space
+	if space == "" {
+		space = network.AlphaSpaceName
+	}
+	_, err = spaces.NewAPI(conn).MoveSubnets(names.NewSpaceTag(space), subnetTags, false)
+	return err
+}
+
+// ReadSubnet returns a subnet known to the model, as discovered from
+// the provider.
+func (c *spacesClient) ReadSubnet(input ReadSubnetInput) (*ReadSubnetResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := subnets.NewAPI(conn)
+
+	known, err := client.ListSubnets(nil, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, subnet := range known {
+		if subnet.CIDR == input.CIDR {
+			return subnetResponse(subnet)
+		}
+	}
+	return nil, errors.NotFoundf("subnet %q", input.CIDR)
+}
+
+// ListSubnets returns the subnets known to the model, sorted by CIDR,
+// as `juju subnets` does.
+func (c *spacesClient) ListSubnets(input ListSubnetsInput) ([]ReadSubnetResponse, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := subnets.NewAPI(conn)
+
+	var spaceTag *names.SpaceTag
+	if input.Space != "" {
+		tag := names.NewSpaceTag(input.Space)
+		spaceTag = &tag
+	}
+	known, err := client.ListSubnets(spaceTag, input.Zone)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]ReadSubnetResponse, 0, len(known))
+	for _, subnet := range known {
+		response, err := subnetResponse(subnet)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, *response)
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].CIDR < responses[j].CIDR
+	})
+	return responses, nil
+}
+
+func subnetResponse(subnet params.Subnet) (*ReadSubnetResponse, error) {
+	space := network.AlphaSpaceName
+	if subnet.SpaceTag != "" {
+		spaceTag, err := names.ParseSpaceTag(subnet.SpaceTag)
+		if err != nil {
+			return nil, err
+		}
+		space = spaceTag.Id()
+	}
+	return &ReadSubnetResponse{
+		CIDR:       subnet.CIDR,
+		Space:      space,
+		ProviderId: subnet.ProviderId,
+		VLANTag:    subnet.VLANTag,
+		Zones:      subnet.Zones,
+	}, nil
+}
@@ -6,6 +6,7 @@ package juju
 import (
 	"fmt"
 
+	"github.com/juju/errors"
 	"github.com/juju/juju/api/client/keymanager"
 	"github.com/juju/utils/v3/ssh"
 
@@ -22,17 +23,22 @@ type CreateSSHKeyInput struct {
 }
 
 type ReadSSHKeyInput struct {
-	ModelName     string
+	ModelName string
+	// KeyIdentifier is the fingerprint of the key, or its comment
+	// for keys identified before fingerprints were used.
 	KeyIdentifier string
 }
 
 type ReadSSHKeyOutput struct {
-	ModelName string
-	Payload   string
+	ModelName   string
+	Payload     string
+	Fingerprint string
 }
 
 type DeleteSSHKeyInput struct {
-	ModelName     string
+	ModelName string
+	// KeyIdentifier is the fingerprint of the key, or its comment
+	// for keys identified before fingerprints were used.
 	KeyIdentifier string
 }
 
@@ -92,16 +98,17 @@ func (c *sshKeysClient) ReadSSHKey(input *ReadSSHKeyInput) (*ReadSSHKeyOutput, e
 
 	for _, res := range returnedKeys {
 		for _, k := range res.Result {
-			if input.KeyIdentifier == utils.GetKeyIdentifierFromSSHKey(k) {
+			if fingerprint, ok := matchSSHKey(k, input.KeyIdentifier); ok {
 				return &ReadSSHKeyOutput{
-					ModelName: input.ModelName,
-					Payload:   k,
+					ModelName:   input.ModelName,
+					Payload:     k,
+					Fingerprint: fingerprint,
 				}, nil
 			}
 		}
 	}
 
-	return nil, fmt.Errorf("no ssh key found for %s", input.KeyIdentifier)
+	return nil, errors.NotFoundf("ssh key %q", input.KeyIdentifier)
 }
 
 func (c *sshKeysClient) DeleteSSHKey(input *DeleteSSHKeyInput) error {
@@ -123,9 +130,9 @@ func (c *sshKeysClient) DeleteSSHKey(input *DeleteSSHKeyInput) error {
 		return err
 	}
 	// only check if there is one key
-	if len(returnedKeys) == 1 {
+	if len(returnedKeys) == 1 && len(returnedKeys[0].Result) == 1 {
 		k := returnedKeys[0].Result[0]
-		if input.KeyIdentifier == utils.GetKeyIdentifierFromSSHKey(k) {
+		if _, ok := matchSSHKey(k, input.KeyIdentifier); ok {
 			// This is the latest key, do not remove it
 			c.Warnf(fmt.Sprintf("ssh key from user %s is the last one and will not be removed", input.KeyIdentifier))
 			return nil
@@ -150,3 +157,13 @@ func (c *sshKeysClient) DeleteSSHKey(input *DeleteSSHKeyInput) error {
 
 	return err
 }
+
+// matchSSHKey reports whether the key is identified by the given
+// fingerprint or comment, and returns the fingerprint of the key.
+func matchSSHKey(key, identifier string) (string, bool) {
+	fingerprint, err := utils.GetKeyFingerprintFromSSHKey(key)
+	if err != nil {
+		return "", false
+	}
+	return fingerprint, identifier == fingerprint || identifier == utils.GetKeyIdentifierFromSSHKey(key)
+}
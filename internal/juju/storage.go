@@ -0,0 +1,348 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	apistorage "github.com/juju/juju/api/client/storage"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v4"
+	"github.com/juju/retry"
+)
+
+type storageClient struct {
+	SharedClient
+}
+
+type CreateStorageInput struct {
+	ModelName string
+	// Unit is the unit the storage is added to.
+	Unit string
+	// Name is the name of the storage of the charm.
+	Name string
+	Pool string
+	// Size is the size of the storage, in MiB. The size of the
+	// storage constraints of the application is used when zero.
+	Size uint64
+}
+
+type CreateStorageResponse struct {
+	StorageID string
+}
+
+type ReadStorageInput struct {
+	ModelName string
+	StorageID string
+}
+
+type ReadStorageResponse struct {
+	StorageID string
+	Name      string
+	// Unit is the unit the storage is attached to, empty when
+	// the storage is detached.
+	Unit       string
+	Kind       string
+	Status     string
+	Persistent bool
+	// Location is where the storage is mounted, or the device
+	// path of block storage.
+	Location     string
+	Pool         string
+	Size         uint64
+	VolumeID     string
+	FilesystemID string
+}
+
+type AttachStorageInput struct {
+	ModelName string
+	StorageID string
+	Unit      string
+}
+
+type DetachStorageInput struct {
+	ModelName string
+	StorageID string
+}
+
+type DestroyStorageInput struct {
+	ModelName string
+	StorageID string
+}
+
+type ListStoragePoolsInput struct {
+	ModelName string
+	// Providers filters the pools by the type of their storage
+	// provider when set.
+	Providers []string
+}
+
+type StoragePool struct {
+	Name     string
+	Provider string
+	// Attributes holds the config of the pool, values are
+	// formatted as strings.
+	Attributes map[string]string
+}
+
+func newStorageClient(sc SharedClient) *storageClient {
+	return &storageClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateStorage adds a storage instance to a unit, as `juju
+// add-storage` does.
+func (c *storageClient) CreateStorage(input CreateStorageInput) (*CreateStorageResponse, error) {
+	defer c.InvalidateModelStatus(input.ModelName)
+
+	if !names.IsValidUnit(input.Unit) {
+		return nil, errors.NotValidf("unit %q", input.Unit)
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+
+	count := uint64(1)
+	cons := params.StorageConstraints{Pool: input.Pool, Count: &count}
+	if input.Size > 0 {
+		cons.Size = &input.Size
+	}
+	results, err := client.AddToUnit([]params.StorageAddParams{{
+		UnitTag:     names.NewUnitTag(input.Unit).String(),
+		StorageName: input.Name,
+		Constraints: cons,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return nil, typedError(results[0].Error)
+	}
+	if results[0].Result == nil || len(results[0].Result.StorageTags) != 1 {
+		return nil, errors.Errorf("expected 1 storage added to unit %q", input.Unit)
+	}
+	tag, err := names.ParseStorageTag(results[0].Result.StorageTags[0])
+	if err != nil {
+		return nil, err
+	}
+	return &CreateStorageResponse{StorageID: tag.Id()}, nil
+}
+
+// ReadStorage returns a storage instance, with the details of its
+// volume or filesystem.
+func (c *storageClient) ReadStorage(input ReadStorageInput) (*ReadStorageResponse, error) {
+	if !names.IsValidStorage(input.StorageID) {
+		return nil, errors.NotValidf("storage ID %q", input.StorageID)
+	}
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+
+	tag := names.NewStorageTag(input.StorageID)
+	results, err := client.StorageDetails([]names.StorageTag{tag})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return nil, typedError(results[0].Error)
+	}
+	details := results[0].Result
+
+	name, err := names.StorageName(input.StorageID)
+	if err != nil {
+		return nil, err
+	}
+	response := ReadStorageResponse{
+		StorageID:  input.StorageID,
+		Name:       name,
+		Kind:       details.Kind.String(),
+		Status:     string(details.Status.Status),
+		Persistent: details.Persistent,
+	}
+	for _, attachment := range details.Attachments {
+		unitTag, err := names.ParseUnitTag(attachment.UnitTag)
+		if err != nil {
+			return nil, err
+		}
+		response.Unit = unitTag.Id()
+		response.Location = attachment.Location
+	}
+
+	filesystems, err := client.ListFilesystems(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range filesystems {
+		if result.Error != nil {
+			return nil, typedError(result.Error)
+		}
+		for _, filesystem := range result.Result {
+			if filesystem.Storage != nil && filesystem.Storage.StorageTag == tag.String() {
+				response.FilesystemID = filesystem.Info.FilesystemId
+				response.Pool = filesystem.Info.Pool
+				response.Size = filesystem.Info.Size
+			}
+		}
+	}
+	volumes, err := client.ListVolumes(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range volumes {
+		if result.Error != nil {
+			return nil, typedError(result.Error)
+		}
+		for _, volume := range result.Result {
+			if volume.Storage != nil && volume.Storage.StorageTag == tag.String() {
+				response.VolumeID = volume.Info.VolumeId
+				response.Pool = volume.Info.Pool
+				response.Size = volume.Info.Size
+			}
+		}
+	}
+	return &response, nil
+}
+
+// AttachStorage attaches a detached storage instance to a unit, as
+// `juju attach-storage` does.
+func (c *storageClient) AttachStorage(input AttachStorageInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+
+	results, err := client.Attach(input.Unit, []string{input.StorageID})
+	if err != nil {
+		return err
+	}
+	return typedError(params.ErrorResults{Results: results}.Combine())
+}
+
+// DetachStorage detaches a storage instance from its unit, as `juju
+// detach-storage` does, and waits for the storage to be detached.
+func (c *storageClient) DetachStorage(ctx context.Context, input DetachStorageInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+
+	results, err := client.Detach([]string{input.StorageID}, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := (params.ErrorResults{Results: results}).Combine(); err != nil {
+		return typedError(err)
+	}
+
+	err = retry.Call(retry.CallArgs{
+		Func: func() error {
+			output, err := c.ReadStorage(ReadStorageInput(input))
+			if err != nil {
+				return err
+			}
+			if output.Unit != "" {
+				return errors.Errorf("storage %q is attached to unit %q", input.StorageID, output.Unit)
+			}
+			return nil
+		},
+		NotifyFunc: func(err error, attempt int) {
+			if attempt%4 == 0 {
+				c.Debugf(fmt.Sprintf("waiting for storage %q to detach: %s", input.StorageID, err))
+			}
+		},
+		Delay:       5 * time.Second,
+		MaxDuration: 10 * time.Minute,
+		Clock:       clock.WallClock,
+		Stop:        ctx.Done(),
+	})
+	if retry.IsRetryStopped(err) || retry.IsDurationExceeded(err) {
+		err = retry.LastError(err)
+	}
+	return err
+}
+
+// DestroyStorage detaches a storage instance, and destroys it, as
+// `juju remove-storage` does.
+func (c *storageClient) DestroyStorage(input DestroyStorageInput) error {
+	defer c.InvalidateModelStatus(input.ModelName)
+
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+
+	results, err := client.Remove([]string{input.StorageID}, true, true, nil, nil)
+	if err != nil {
+		return err
+	}
+	return typedError(params.ErrorResults{Results: results}.Combine())
+}
+
+// ListStoragePools returns the storage pools of the model, sorted by
+// name, as `juju storage-pools` does.
+func (c *storageClient) ListStoragePools(input ListStoragePoolsInput) ([]StoragePool, error) {
+	conn, err := c.GetConnection(&input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+
+	results, err := client.ListPools(input.Providers, nil)
+	if err != nil {
+		return nil, err
+	}
+	pools := make([]StoragePool, 0, len(results))
+	for _, result := range results {
+		attributes := make(map[string]string, len(result.Attrs))
+		for key, value := range result.Attrs {
+			attributes[key] = fmt.Sprint(value)
+		}
+		pools = append(pools, StoragePool{
+			Name:       result.Name,
+			Provider:   result.Provider,
+			Attributes: attributes,
+		})
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].Name < pools[j].Name
+	})
+	return pools, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/common"
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/names/v4"
+)
+
+const (
+	// unitLogLines is the number of log lines of a unit reported
+	// with its error.
+	unitLogLines = 10
+	// unitLogsTimeout is how long the log lines of a unit are read.
+	unitLogsTimeout = 10 * time.Second
+)
+
+// UnitError is the error of a unit in error or blocked while it is
+// waited for. It holds what juju status and debug-log would tell
+// about the unit, to understand the failure from the error alone.
+type UnitError struct {
+	Unit string
+	// Status is the workload status of the unit, error or blocked.
+	Status  string
+	Message string
+	// Hook is the hook which failed, empty when none did.
+	Hook string
+	// Logs are the last lines logged by the unit.
+	Logs []string
+}
+
+func (e *UnitError) Error() string {
+	var b strings.Builder
+	if e.Status == string(corestatus.Error) {
+		fmt.Fprintf(&b, "unit %q is in error: %s", e.Unit, e.Message)
+	} else {
+		fmt.Fprintf(&b, "unit %q is %s: %s", e.Unit, e.Status, e.Message)
+	}
+	if e.Hook != "" {
+		fmt.Fprintf(&b, "\nfailed hook: %s", e.Hook)
+	}
+	if len(e.Logs) > 0 {
+		fmt.Fprintf(&b, "\nlast log lines of unit %q:", e.Unit)
+		for _, line := range e.Logs {
+			b.WriteString("\n  " + line)
+		}
+	}
+	return b.String()
+}
+
+// blockedUnit returns the error of the first blocked unit of the
+// applications, nil when none is blocked.
+func blockedUnit(entities *modelEntities, applications map[string]bool) *UnitError {
+	unitNames := make([]string, 0, len(entities.units))
+	for unitName, unit := range entities.units {
+		if applications[unit.Application] && unit.WorkloadStatus.Current == corestatus.Blocked {
+			unitNames = append(unitNames, unitName)
+		}
+	}
+	if len(unitNames) == 0 {
+		return nil
+	}
+	sort.Strings(unitNames)
+	unit := entities.units[unitNames[0]]
+	return &UnitError{
+		Unit:    unitNames[0],
+		Status:  string(corestatus.Blocked),
+		Message: unit.WorkloadStatus.Message,
+	}
+}
+
+// hookFromStatusData returns the hook recorded in the data of a status,
+// as the uniter sets it when a hook fails.
+func hookFromStatusData(data ...map[string]interface{}) string {
+	for _, d := range data {
+		if hook, ok := d["hook"].(string); ok && hook != "" {
+			return hook
+		}
+	}
+	return ""
+}
+
+// readUnitLogs returns the last log lines of a unit, as `juju debug-log`
+// shows them. The logs are best effort, none are returned when they
+// cannot be read.
+func readUnitLogs(ctx context.Context, conn api.Connection, unitName string) []string {
+	if !names.IsValidUnit(unitName) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, unitLogsTimeout)
+	defer cancel()
+
+	messages, err := common.StreamDebugLog(ctx, conn, common.DebugLogParams{
+		IncludeEntity: []string{names.NewUnitTag(unitName).String()},
+		Replay:        true,
+		NoTail:        true,
+	})
+	if err != nil {
+		return nil
+	}
+	// The stream is drained once done, for it to be closed.
+	defer func() {
+		go func() {
+			for range messages {
+			}
+		}()
+	}()
+
+	var lines []string
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				return lines
+			}
+			lines = append(lines, fmt.Sprintf("%s %s %s %s",
+				msg.Timestamp.Format(time.TimeOnly), msg.Severity, msg.Module, msg.Message))
+			if len(lines) > unitLogLines {
+				lines = lines[1:]
+			}
+		case <-ctx.Done():
+			return lines
+		}
+	}
+}
@@ -5,8 +5,12 @@ package juju
 
 import (
 	"fmt"
+	"sort"
 
+	"github.com/juju/errors"
 	"github.com/juju/juju/api/client/usermanager"
+	apicontroller "github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v4"
 )
@@ -20,6 +24,8 @@ type CreateUserInput struct {
 	DisplayName string
 	Model       string
 	Password    string
+	// Disabled disables the user once created.
+	Disabled bool
 }
 
 type CreateUserResponse struct {
@@ -35,6 +41,16 @@ type ReadUserResponse struct {
 	UserInfo params.UserInfo
 }
 
+type ListUsersInput struct {
+	// IncludeDisabled lists the disabled users too.
+	IncludeDisabled bool
+}
+
+type ListUsersResponse struct {
+	// Users holds the users of the controller, sorted by name.
+	Users []params.UserInfo
+}
+
 type ReadModelUserResponse struct {
 	ModelUserInfo []params.ModelUserInfo
 }
@@ -44,12 +60,35 @@ type UpdateUserInput struct {
 	DisplayName string
 	User        string
 	Password    string
+	// Disabled disables or enables the user, it is left
+	// unchanged when nil.
+	Disabled *bool
 }
 
 type DestroyUserInput struct {
 	Name string
 }
 
+type GrantControllerAccessInput struct {
+	Access string
+	Users  []string
+}
+
+type ReadControllerAccessInput struct {
+	Users []string
+}
+
+type ReadControllerAccessResponse struct {
+	// Users holds the access of the users to the controller,
+	// keyed by user name. Users without access are left out.
+	Users map[string]string
+}
+
+type RevokeControllerAccessInput struct {
+	Access string
+	Users  []string
+}
+
 func newUsersClient(sc SharedClient) *usersClient {
 	return &usersClient{
 		SharedClient: sc,
@@ -70,6 +109,12 @@ func (c *usersClient) CreateUser(input CreateUserInput) (*CreateUserResponse, er
 		return nil, err
 	}
 
+	if input.Disabled {
+		if err := client.DisableUser(input.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	return &CreateUserResponse{UserTag: userTag, Secret: userSecret}, nil
 }
 
@@ -82,9 +127,9 @@ func (c *usersClient) ReadUser(name string) (*ReadUserResponse, error) {
 
 	usermanagerClient := usermanager.NewClient(usermanagerConn)
 
-	users, err := usermanagerClient.UserInfo([]string{name}, false) //don't list disabled users
+	users, err := usermanagerClient.UserInfo([]string{name}, true) // list disabled users too
 	if err != nil {
-		return nil, err
+		return nil, typedError(err)
 	}
 
 	if len(users) > 1 {
@@ -101,6 +146,26 @@ func (c *usersClient) ReadUser(name string) (*ReadUserResponse, error) {
 	}, nil
 }
 
+// ListUsers returns the users of the controller, as `juju users` does.
+func (c *usersClient) ListUsers(input ListUsersInput) (*ListUsersResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := usermanager.NewClient(conn)
+
+	users, err := client.UserInfo(nil, usermanager.IncludeDisabled(input.IncludeDisabled))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+	return &ListUsersResponse{Users: users}, nil
+}
+
 func (c *usersClient) ModelUserInfo(modelName string) (*ReadModelUserResponse, error) {
 	usermanagerConn, err := c.GetConnection(nil)
 	if err != nil {
@@ -144,6 +209,17 @@ func (c *usersClient) UpdateUser(input UpdateUserInput) error {
 		}
 	}
 
+	if input.Disabled != nil {
+		if *input.Disabled {
+			err = client.DisableUser(input.Name)
+		} else {
+			err = client.EnableUser(input.Name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -163,3 +239,87 @@ func (c *usersClient) DestroyUser(input DestroyUserInput) error {
 
 	return nil
 }
+
+// GrantControllerAccess grants the given access to the controller to
+// the users. A superuser is downgraded to login access when login
+// access is granted.
+func (c *usersClient) GrantControllerAccess(input GrantControllerAccessInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+	for _, user := range input.Users {
+		access, err := controllerAccess(client, user)
+		if err != nil {
+			return err
+		}
+		switch {
+		case access == input.Access:
+			continue
+		case access == string(permission.SuperuserAccess) && input.Access == string(permission.LoginAccess):
+			err = client.RevokeController(user, string(permission.SuperuserAccess))
+		default:
+			err = client.GrantController(user, input.Access)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadControllerAccess returns the access of the users to the controller.
+func (c *usersClient) ReadControllerAccess(input ReadControllerAccessInput) (*ReadControllerAccessResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+	users := make(map[string]string, len(input.Users))
+	for _, user := range input.Users {
+		access, err := controllerAccess(client, user)
+		if err != nil {
+			return nil, err
+		}
+		if access != "" {
+			users[user] = access
+		}
+	}
+	return &ReadControllerAccessResponse{Users: users}, nil
+}
+
+// RevokeControllerAccess revokes the given access to the controller
+// from the users. Revoking superuser access downgrades the users to
+// login access, revoking login access removes their access.
+func (c *usersClient) RevokeControllerAccess(input RevokeControllerAccessInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+	for _, user := range input.Users {
+		if err := client.RevokeController(user, input.Access); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// controllerAccess returns the access of a user to the controller,
+// empty if the user has none.
+func controllerAccess(client *apicontroller.Client, user string) (string, error) {
+	access, err := client.GetControllerAccess(user)
+	if errors.Is(typedError(err), errors.NotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return string(access), nil
+}
@@ -11,11 +11,10 @@ import (
 	"os/exec"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	apiapplication "github.com/juju/juju/api/client/application"
-	"github.com/juju/names/v4"
+	"github.com/juju/juju/api"
+	apiclient "github.com/juju/juju/api/client/client"
 )
 
 // controllerConfig is a representation of the output
@@ -116,43 +115,19 @@ func populateControllerConfig() {
 	tflog.Debug(context.TODO(), "local provider controllerConfig was set", map[string]interface{}{"localProviderConfig": fmt.Sprintf("%#v", localProviderConfig)})
 }
 
-// WaitForAppAvailable blocks the execution flow and waits until all the
-// application names can be queried before the context is done. The
-// tickTime param indicates the frequency used to query the API.
-func WaitForAppsAvailable(ctx context.Context, client *apiapplication.Client, appsName []string, tickTime time.Duration) error {
+// WaitForAppsAvailable blocks the execution flow and waits until all
+// the applications are in the model of the connection, as the
+// AllWatcher reports them, before the context is done.
+func WaitForAppsAvailable(ctx context.Context, conn api.Connection, logger apiclient.Logger, appsName []string) error {
 	if len(appsName) == 0 {
 		return nil
 	}
-	// build app tags for these apps
-	tags := make([]names.ApplicationTag, len(appsName))
-	for i, n := range appsName {
-		tags[i] = names.NewApplicationTag(n)
-	}
-
-	tick := time.NewTicker(tickTime)
-	for {
-		select {
-		case <-tick.C:
-			returned, err := client.ApplicationsInfo(tags)
-			// if there is no error and we get as many app infos as
-			// requested apps, we can assume the apps are available
-			if err != nil {
-				return err
-			}
-			totalAvailable := 0
-			for _, entry := range returned {
-				// there's no info available yet
-				if entry.Result == nil {
-					continue
-				}
-				totalAvailable++
+	return watchModel(ctx, conn, logger, 0, func(entities *modelEntities) error {
+		for _, name := range appsName {
+			if _, ok := entities.applications[name]; !ok {
+				return stillWaiting("application %q is not available", name)
 			}
-			// All the entries were available
-			if totalAvailable == len(appsName) {
-				return nil
-			}
-		case <-ctx.Done():
-			return errors.New("the context was done waiting for apps")
 		}
-	}
+		return nil
+	})
 }
@@ -0,0 +1,157 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apiclient "github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/rpc/params"
+)
+
+// watchRecheckInterval is how often the entities of a model are checked
+// when no change is reported, for the checks which also depend on the
+// status of the model, e.g. of its relations.
+const watchRecheckInterval = 30 * time.Second
+
+// modelEntities holds the entities of a model the AllWatcher reports,
+// keyed by machine id, application name, unit name and relation key.
+type modelEntities struct {
+	machines     map[string]*params.MachineInfo
+	applications map[string]*params.ApplicationInfo
+	units        map[string]*params.UnitInfo
+	relations    map[string]*params.RelationInfo
+}
+
+func newModelEntities() *modelEntities {
+	return &modelEntities{
+		machines:     make(map[string]*params.MachineInfo),
+		applications: make(map[string]*params.ApplicationInfo),
+		units:        make(map[string]*params.UnitInfo),
+		relations:    make(map[string]*params.RelationInfo),
+	}
+}
+
+// apply updates the entities with the deltas of the AllWatcher.
+func (e *modelEntities) apply(deltas []params.Delta) {
+	for _, delta := range deltas {
+		switch entity := delta.Entity.(type) {
+		case *params.MachineInfo:
+			if delta.Removed {
+				delete(e.machines, entity.Id)
+			} else {
+				e.machines[entity.Id] = entity
+			}
+		case *params.ApplicationInfo:
+			if delta.Removed {
+				delete(e.applications, entity.Name)
+			} else {
+				e.applications[entity.Name] = entity
+			}
+		case *params.UnitInfo:
+			if delta.Removed {
+				delete(e.units, entity.Name)
+			} else {
+				e.units[entity.Name] = entity
+			}
+		case *params.RelationInfo:
+			if delta.Removed {
+				delete(e.relations, entity.Key)
+			} else {
+				e.relations[entity.Key] = entity
+			}
+		}
+	}
+}
+
+// waitingError tells why a wait on the entities of a model is not over
+// yet, as opposed to the errors which end the wait.
+type waitingError struct {
+	error
+}
+
+// stillWaiting returns a waitingError with the given reason.
+func stillWaiting(format string, args ...interface{}) error {
+	return waitingError{errors.Errorf(format, args...)}
+}
+
+// watchModel watches the entities of the model of the connection with
+// the AllWatcher, rather than polling the model, until check returns
+// nil. check is called with the entities each time they change; a
+// waitingError keeps the wait going, any other error ends it. When the
+// timeout is exceeded or the context is done, the last reason to wait
+// is returned. A zero timeout waits until the context is done.
+func watchModel(ctx context.Context, conn api.Connection, logger apiclient.Logger, timeout time.Duration, check func(*modelEntities) error) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	watcher, err := apiclient.NewClient(conn, logger).WatchAll()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	// Next blocks until the model changes, the deltas are received in
+	// their own goroutine to honour the context meanwhile.
+	done := make(chan struct{})
+	defer close(done)
+	deltasCh := make(chan []params.Delta)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			deltas, err := watcher.Next()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case deltasCh <- deltas:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	entities := newModelEntities()
+	recheck := time.NewTicker(watchRecheckInterval)
+	defer recheck.Stop()
+	// The first deltas hold all of the entities of the model, the
+	// entities are not checked before.
+	received := false
+	var lastErr error
+	for {
+		select {
+		case deltas := <-deltasCh:
+			entities.apply(deltas)
+			received = true
+		case <-recheck.C:
+			if !received {
+				continue
+			}
+		case err := <-errCh:
+			return errors.Annotate(err, "watching model")
+		case <-ctx.Done():
+			if lastErr != nil {
+				return lastErr
+			}
+			return ctx.Err()
+		}
+
+		err := check(entities)
+		if err == nil {
+			return nil
+		}
+		var waiting waitingError
+		if !errors.As(err, &waiting) {
+			return err
+		}
+		lastErr = waiting.error
+	}
+}
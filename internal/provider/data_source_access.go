@@ -0,0 +1,225 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &accessDataSource{}
+
+func NewAccessDataSource() datasource.DataSource {
+	return &accessDataSource{}
+}
+
+type accessDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// accessDataSourceModel is the juju data stored by terraform.
+// tfsdk must match access data source schema attribute names.
+type accessDataSourceModel struct {
+	User     types.String `tfsdk:"user"`
+	Model    types.String `tfsdk:"model"`
+	OfferURL types.String `tfsdk:"offer_url"`
+	Cloud    types.String `tfsdk:"cloud"`
+	Access   types.String `tfsdk:"access"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *accessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access"
+}
+
+func (d *accessDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the effective access of a user to a model, an offer or a cloud.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Description: "The name of the user.",
+				Required:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "The name of the model to read the access to. Exactly one of model, offer_url " +
+					"and cloud is required.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("offer_url"),
+						path.MatchRoot("cloud"),
+					}...),
+				},
+			},
+			"offer_url": schema.StringAttribute{
+				Description: "The URL of the offer to read the access to.",
+				Optional:    true,
+			},
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud to read the access to.",
+				Optional:    true,
+			},
+			"access": schema.StringAttribute{
+				Description: "The effective access of the user, e.g. `read`, `consume` or `add-model`, empty if " +
+					"the user has no access: the greatest of the access granted to the user, the access granted to " +
+					"`everyone@external` when the user is external, and `admin` when the user is a controller superuser.",
+				Computed: true,
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *accessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceAccess)
+}
+
+func (d *accessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "access")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "access")
+		return
+	}
+
+	var data accessDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := data.User.ValueString()
+	var target string
+	// grants holds the access granted to each user, equalOrGreater
+	// compares the accesses to the target.
+	var grants map[string]string
+	var equalOrGreater func(permission.Access, permission.Access) bool
+	switch {
+	case data.Model.ValueString() != "":
+		target = data.Model.ValueString()
+		response, err := d.client.Users.ModelUserInfo(target)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model access, got error: %s", err))
+			return
+		}
+		grants = make(map[string]string, len(response.ModelUserInfo))
+		for _, modelUser := range response.ModelUserInfo {
+			grants[modelUser.UserName] = string(modelUser.Access)
+		}
+		equalOrGreater = permission.Access.EqualOrGreaterModelAccessThan
+	case data.OfferURL.ValueString() != "":
+		target = data.OfferURL.ValueString()
+		response, err := d.client.Offers.ReadOffer(&juju.ReadOfferInput{
+			OfferURL: target,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read offer access, got error: %s", err))
+			return
+		}
+		grants = response.Users
+		equalOrGreater = permission.Access.EqualOrGreaterOfferAccessThan
+	default:
+		target = data.Cloud.ValueString()
+		response, err := d.client.Clouds.ReadCloudAccess(juju.ReadCloudAccessInput{
+			Name: target,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud access, got error: %s", err))
+			return
+		}
+		grants = response.Users
+		equalOrGreater = permission.Access.EqualOrGreaterCloudAccessThan
+	}
+
+	// The access of the user is the greatest of the access granted
+	// to the user, to everyone@external when the user is external,
+	// and admin when the user is a superuser of the controller.
+	access := grants[user]
+	if names.IsValidUser(user) && !names.NewUserTag(user).IsLocal() {
+		access = greaterAccess(equalOrGreater, access, grants[everyoneExternal])
+	}
+	controllerAccess, err := d.client.Users.ReadControllerAccess(juju.ReadControllerAccessInput{
+		Users: []string{user},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controller access, got error: %s", err))
+		return
+	}
+	if controllerAccess.Users[user] == string(permission.SuperuserAccess) {
+		access = string(permission.AdminAccess)
+	}
+	d.trace(fmt.Sprintf("read juju access of user %q to %q data source", user, target))
+
+	// Save data into Terraform state
+	data.Access = types.StringValue(access)
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", target, user))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// everyoneExternal is the user the access granted to every external
+// user is granted to.
+const everyoneExternal = "everyone@external"
+
+// greaterAccess returns the greater of two accesses, compared by
+// equalOrGreater. An empty access is no access.
+func greaterAccess(equalOrGreater func(permission.Access, permission.Access) bool, a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "" || equalOrGreater(permission.Access(a), permission.Access(b)):
+		return a
+	}
+	return b
+}
+
+func (d *accessDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-access", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-access","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceAccess, msg, additionalFields...)
+}
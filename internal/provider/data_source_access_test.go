@@ -0,0 +1,94 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/juju/juju/core/permission"
+)
+
+func TestAcc_DataSourceAccess(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	modelName := acctest.RandomWithPrefix("tf-datasource-access-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAccess(userName, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_access.model", "access", "write"),
+					resource.TestCheckResourceAttr("data.juju_access.admin", "access", "admin"),
+					resource.TestCheckResourceAttr("data.juju_access.cloud", "access", ""),
+					// The superuser of the controller is admin of the cloud.
+					resource.TestCheckResourceAttr("data.juju_access.admin_cloud", "access", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func TestGreaterAccess(t *testing.T) {
+	tests := []struct {
+		equalOrGreater func(permission.Access, permission.Access) bool
+		a, b, expected string
+	}{
+		{permission.Access.EqualOrGreaterModelAccessThan, "", "", ""},
+		{permission.Access.EqualOrGreaterModelAccessThan, "read", "", "read"},
+		{permission.Access.EqualOrGreaterModelAccessThan, "", "write", "write"},
+		{permission.Access.EqualOrGreaterModelAccessThan, "write", "admin", "admin"},
+		{permission.Access.EqualOrGreaterOfferAccessThan, "consume", "read", "consume"},
+		{permission.Access.EqualOrGreaterCloudAccessThan, "add-model", "admin", "admin"},
+	}
+	for _, test := range tests {
+		if access := greaterAccess(test.equalOrGreater, test.a, test.b); access != test.expected {
+			t.Errorf("greater access of %q and %q: expected %q, got %q", test.a, test.b, test.expected, access)
+		}
+	}
+}
+
+func testAccDataSourceAccess(userName, modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "test" {
+  name     = %q
+  password = "password"
+}
+
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_access_model" "test" {
+  access = "write"
+  model  = juju_model.test.name
+  users  = [juju_user.test.name]
+}
+
+data "juju_access" "model" {
+  user  = juju_user.test.name
+  model = juju_model.test.name
+
+  depends_on = [juju_access_model.test]
+}
+
+data "juju_access" "admin" {
+  user  = "admin"
+  model = juju_model.test.name
+}
+
+data "juju_access" "cloud" {
+  user  = juju_user.test.name
+  cloud = %q
+}
+
+data "juju_access" "admin_cloud" {
+  user  = "admin"
+  cloud = %q
+}`, userName, modelName, testingCloud.CloudName(), testingCloud.CloudName())
+}
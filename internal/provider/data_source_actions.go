@@ -0,0 +1,174 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &actionsDataSource{}
+
+func NewActionsDataSource() datasource.DataSource {
+	return &actionsDataSource{}
+}
+
+type actionsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// actionsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match actions data source schema attribute names.
+type actionsDataSourceModel struct {
+	ModelName       types.String             `tfsdk:"model"`
+	ApplicationName types.String             `tfsdk:"application_name"`
+	Actions         []nestedDataSourceAction `tfsdk:"actions"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceAction represents an element of the actions list of
+// the actions data source.
+type nestedDataSourceAction struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Params      types.String `tfsdk:"params"`
+}
+
+func (d *actionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_actions"
+}
+
+func (d *actionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the actions declared by the charm of a Juju Application, as " +
+			"`juju actions --schema` does.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the application.",
+				Required:    true,
+			},
+			"application_name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Required:    true,
+			},
+			"actions": schema.ListNestedAttribute{
+				Description: "The actions of the charm, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the action.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the action.",
+							Computed:    true,
+						},
+						"params": schema.StringAttribute{
+							Description: "The JSON schema of the parameters of the action, JSON encoded. " +
+								"It is decoded with `jsondecode`.",
+							Computed: true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *actionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceActions)
+}
+
+func (d *actionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "actions")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "actions")
+		return
+	}
+
+	var data actionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	appName := data.ApplicationName.ValueString()
+	actions, err := d.client.Exec.ListActions(juju.ListActionsInput{
+		ModelName: modelName,
+		AppName:   appName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list actions, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju actions of application %q data source", appName))
+
+	// Save data into Terraform state
+	data.Actions = make([]nestedDataSourceAction, 0, len(actions))
+	for _, action := range actions {
+		params, err := json.Marshal(action.Params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode the params of action %q, got error: %s", action.Name, err))
+			return
+		}
+		data.Actions = append(data.Actions, nestedDataSourceAction{
+			Name:        types.StringValue(action.Name),
+			Description: types.StringValue(action.Description),
+			Params:      types.StringValue(string(params)),
+		})
+	}
+	data.ID = types.StringValue(newAppID(modelName, appName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *actionsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-actions", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-actions","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceActions, msg, additionalFields...)
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceActions(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-actions-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceActions(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_actions.this", "actions.*", map[string]string{
+						"name": "fortune",
+					}),
+					resource.TestCheckResourceAttr("data.juju_actions.this", "id", fmt.Sprintf("%s:this", modelName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceActions(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "this"
+
+  charm {
+    name = "juju-qa-test"
+  }
+}
+
+data "juju_actions" "this" {
+  model            = juju_model.this.name
+  application_name = juju_application.this.name
+}`, modelName)
+}
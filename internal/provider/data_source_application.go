@@ -0,0 +1,343 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &applicationDataSource{}
+
+func NewApplicationDataSource() datasource.DataSource {
+	return &applicationDataSource{}
+}
+
+type applicationDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// applicationDataSourceModel is the juju data stored by terraform.
+// tfsdk must match application data source schema attribute names.
+type applicationDataSourceModel struct {
+	ModelName        types.String `tfsdk:"model"`
+	ApplicationName  types.String `tfsdk:"name"`
+	Charm            types.Object `tfsdk:"charm"`
+	Config           types.Map    `tfsdk:"config"`
+	SensitiveConfig  types.Map    `tfsdk:"sensitive_config"`
+	Constraints      types.String `tfsdk:"constraints"`
+	Trust            types.Bool   `tfsdk:"trust"`
+	Expose           types.Object `tfsdk:"expose"`
+	EndpointBindings types.Map    `tfsdk:"endpoint_bindings"`
+	Units            types.List   `tfsdk:"units"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+var applicationDataSourceCharmAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"channel":  types.StringType,
+	"revision": types.Int64Type,
+	"base":     types.StringType,
+}
+
+var applicationDataSourceConfigType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"value":      types.StringType,
+		"is_default": types.BoolType,
+	},
+}
+
+var applicationDataSourceExposeAttrTypes = map[string]attr.Type{
+	"endpoints": types.StringType,
+	"spaces":    types.StringType,
+	"cidrs":     types.StringType,
+}
+
+var applicationDataSourceUnitType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":            types.StringType,
+		"machine":         types.StringType,
+		"leader":          types.BoolType,
+		"public_address":  types.StringType,
+		"private_address": types.StringType,
+		"status":          types.StringType,
+	},
+}
+
+func (d *applicationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+func (d *applicationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing a Juju Application, to use an application managed " +
+			"elsewhere, e.g. to integrate with it.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the application.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Required:    true,
+			},
+			"charm": schema.SingleNestedAttribute{
+				Description: "The charm of the application.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the charm.",
+						Computed:    true,
+					},
+					"channel": schema.StringAttribute{
+						Description: "The channel the charm is deployed from.",
+						Computed:    true,
+					},
+					"revision": schema.Int64Attribute{
+						Description: "The revision of the charm.",
+						Computed:    true,
+					},
+					"base": schema.StringAttribute{
+						Description: "The base of the application, e.g. `ubuntu@22.04`.",
+						Computed:    true,
+					},
+				},
+			},
+			"config": schema.MapNestedAttribute{
+				Description: "The config of the application, keyed by name. Config options declared as " +
+					"secrets by the charm are found in sensitive_config instead.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "The value of the option.",
+							Computed:    true,
+						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether the value is the default of the charm.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"sensitive_config": schema.MapAttribute{
+				Description: "The values of the config options declared as secrets by the charm, keyed by name.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"constraints": schema.StringAttribute{
+				Description: "The constraints of the application.",
+				Computed:    true,
+			},
+			"trust": schema.BoolAttribute{
+				Description: "Whether the application is trusted.",
+				Computed:    true,
+			},
+			"expose": schema.SingleNestedAttribute{
+				Description: "The exposure of the application, null when it is not exposed.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"endpoints": schema.StringAttribute{
+						Description: "The comma-delimited list of exposed endpoints.",
+						Computed:    true,
+					},
+					"spaces": schema.StringAttribute{
+						Description: "The comma-delimited list of spaces the endpoints are exposed to.",
+						Computed:    true,
+					},
+					"cidrs": schema.StringAttribute{
+						Description: "The comma-delimited list of CIDRs the endpoints are exposed to.",
+						Computed:    true,
+					},
+				},
+			},
+			"endpoint_bindings": schema.MapAttribute{
+				Description: "The space each endpoint of the application is bound to, keyed by endpoint. " +
+					"The default space of the application is keyed by an empty string.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"units": schema.ListNestedAttribute{
+				Description: "The units of the application.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the unit, e.g. `postgresql/0`.",
+							Computed:    true,
+						},
+						"machine": schema.StringAttribute{
+							Description: "The ID of the machine the unit runs on.",
+							Computed:    true,
+						},
+						"leader": schema.BoolAttribute{
+							Description: "Whether the unit is the leader of the application.",
+							Computed:    true,
+						},
+						"public_address": schema.StringAttribute{
+							Description: "The public address of the unit.",
+							Computed:    true,
+						},
+						"private_address": schema.StringAttribute{
+							Description: "The private address of the unit.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The workload status of the unit.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *applicationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceApplication)
+}
+
+func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "application")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "application")
+		return
+	}
+
+	var data applicationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	appName := data.ApplicationName.ValueString()
+	app, err := d.client.Applications.ReadApplication(&juju.ReadApplicationInput{
+		ModelName: modelName,
+		AppName:   appName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju application %q data source", appName))
+
+	// Save data into Terraform state
+	var dErr diag.Diagnostics
+	data.Charm, dErr = types.ObjectValue(applicationDataSourceCharmAttrTypes, map[string]attr.Value{
+		"name":     types.StringValue(app.Name),
+		"channel":  types.StringValue(app.Channel),
+		"revision": types.Int64Value(int64(app.Revision)),
+		"base":     types.StringValue(app.Base),
+	})
+	resp.Diagnostics.Append(dErr...)
+	data.Config, data.SensitiveConfig, dErr = applicationDataSourceConfigValues(ctx, app.Config)
+	resp.Diagnostics.Append(dErr...)
+	data.Constraints = types.StringValue(app.Constraints.String())
+	data.Trust = types.BoolValue(app.Trust)
+	data.Expose = types.ObjectNull(applicationDataSourceExposeAttrTypes)
+	if app.Expose != nil {
+		data.Expose, dErr = types.ObjectValueFrom(ctx, applicationDataSourceExposeAttrTypes, parseNestedExpose(app.Expose))
+		resp.Diagnostics.Append(dErr...)
+	}
+	data.EndpointBindings, dErr = types.MapValueFrom(ctx, types.StringType, app.EndpointBindings)
+	resp.Diagnostics.Append(dErr...)
+	units := make([]attr.Value, 0, len(app.UnitDetails))
+	for _, unit := range app.UnitDetails {
+		value, dErr := types.ObjectValue(applicationDataSourceUnitType.AttrTypes, map[string]attr.Value{
+			"name":            types.StringValue(unit.Name),
+			"machine":         types.StringValue(unit.Machine),
+			"leader":          types.BoolValue(unit.Leader),
+			"public_address":  types.StringValue(unit.PublicAddress),
+			"private_address": types.StringValue(unit.PrivateAddress),
+			"status":          types.StringValue(unit.WorkloadStatus),
+		})
+		resp.Diagnostics.Append(dErr...)
+		units = append(units, value)
+	}
+	data.Units, dErr = types.ListValue(applicationDataSourceUnitType, units)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(newAppID(modelName, appName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// applicationDataSourceConfigValues splits the config of an application
+// between the config and sensitive_config attributes.
+func applicationDataSourceConfigValues(ctx context.Context, entries map[string]juju.ConfigEntry) (types.Map, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := make(map[string]attr.Value)
+	sensitiveConfig := make(map[string]string)
+	for name, entry := range entries {
+		value := juju.ConfigEntryToString(entry.Value)
+		if entry.Sensitive {
+			sensitiveConfig[name] = value
+			continue
+		}
+		option, dErr := types.ObjectValue(applicationDataSourceConfigType.AttrTypes, map[string]attr.Value{
+			"value":      types.StringValue(value),
+			"is_default": types.BoolValue(entry.IsDefault),
+		})
+		diags.Append(dErr...)
+		config[name] = option
+	}
+	configValue, dErr := types.MapValue(applicationDataSourceConfigType, config)
+	diags.Append(dErr...)
+	sensitiveValue, dErr := types.MapValueFrom(ctx, types.StringType, sensitiveConfig)
+	diags.Append(dErr...)
+	return configValue, sensitiveValue, diags
+}
+
+func (d *applicationDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-application", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-application","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceApplication, msg, additionalFields...)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceApplication(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-application-test-model")
+
+	dataSourceName := "data.juju_application.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApplication(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "test-app"),
+					resource.TestCheckResourceAttr(dataSourceName, "charm.name", "juju-qa-test"),
+					resource.TestCheckResourceAttr(dataSourceName, "charm.channel", "latest/stable"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "charm.revision", "juju_application.this", "charm.0.revision"),
+					resource.TestCheckResourceAttr(dataSourceName, "config.foo-file.value", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "config.foo-file.is_default", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "trust", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "units.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "units.0.name", "test-app/0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "units.0.machine"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApplication(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+
+  charm {
+    name = "juju-qa-test"
+  }
+
+  config = {
+    foo-file = true
+  }
+}
+
+data "juju_application" "this" {
+  model = juju_model.this.name
+  name  = juju_application.this.name
+}`, modelName)
+}
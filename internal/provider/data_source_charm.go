@@ -0,0 +1,223 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &charmDataSource{}
+
+// defaultCharmChannel is the channel charms are resolved in when
+// none is given, as `juju deploy` does.
+const defaultCharmChannel = "stable"
+
+func NewCharmDataSource() datasource.DataSource {
+	return &charmDataSource{}
+}
+
+type charmDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// charmDataSourceModel is the juju data stored by terraform.
+// tfsdk must match charm data source schema attribute names.
+type charmDataSourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Channel      types.String `tfsdk:"channel"`
+	Base         types.String `tfsdk:"base"`
+	Architecture types.String `tfsdk:"architecture"`
+	Revision     types.Int64  `tfsdk:"revision"`
+	Bases        types.List   `tfsdk:"bases"`
+	Config       types.Map    `tfsdk:"config"`
+	Resources    types.Map    `tfsdk:"resources"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+var charmConfigOptionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":        types.StringType,
+		"default":     types.StringType,
+		"description": types.StringType,
+	},
+}
+
+func (d *charmDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_charm"
+}
+
+func (d *charmDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source resolving a charm in a channel of Charmhub to its current revision, as " +
+			"`juju info` does. Charmhub is queried directly, the controller is not used.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the charm.",
+				Required:    true,
+			},
+			"channel": schema.StringAttribute{
+				Description: "The channel of the charm, e.g. `14/stable`. Defaults to `stable`. Set to the " +
+					"channel the revision is released in.",
+				Optional: true,
+				Computed: true,
+			},
+			"base": schema.StringAttribute{
+				Description: "The base the revision is released for, e.g. `ubuntu@22.04`. Any base of the " +
+					"channel is used when not set.",
+				Optional: true,
+			},
+			"architecture": schema.StringAttribute{
+				Description: "The architecture the revision is released for. Defaults to `amd64`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The revision of the charm released in the channel.",
+				Computed:    true,
+			},
+			"bases": schema.ListAttribute{
+				Description: "The bases supported by the revision, e.g. `ubuntu@22.04`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"config": schema.MapNestedAttribute{
+				Description: "The config options of the charm, keyed by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the option, e.g. `string`, `int` or `boolean`.",
+							Computed:    true,
+						},
+						"default": schema.StringAttribute{
+							Description: "The default value of the option, empty when it has none.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the option.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"resources": schema.MapAttribute{
+				Description: "The revisions of the charm resources released with the charm, keyed by " +
+					"resource name.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *charmDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceCharm)
+}
+
+func (d *charmDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "charm")
+		return
+	}
+
+	var data charmDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel := defaultCharmChannel
+	if !data.Channel.IsNull() {
+		channel = data.Channel.ValueString()
+	}
+	charm, err := d.client.Charms.ReadCharm(ctx, juju.ReadCharmInput{
+		Name:         data.Name.ValueString(),
+		Channel:      channel,
+		Base:         data.Base.ValueString(),
+		Architecture: data.Architecture.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read charm, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju charm %q data source", data.Name.ValueString()))
+
+	// Save data into Terraform state
+	if data.Architecture.IsNull() {
+		data.Architecture = types.StringValue(charm.Architecture)
+	}
+	data.Channel = types.StringValue(charm.Channel)
+	data.Revision = types.Int64Value(int64(charm.Revision))
+	bases, dErr := types.ListValueFrom(ctx, types.StringType, charm.Bases)
+	resp.Diagnostics.Append(dErr...)
+	config := make(map[string]attr.Value, len(charm.Config))
+	for name, option := range charm.Config {
+		value, dErr := types.ObjectValue(charmConfigOptionType.AttrTypes, map[string]attr.Value{
+			"type":        types.StringValue(option.Type),
+			"default":     types.StringValue(option.Default),
+			"description": types.StringValue(option.Description),
+		})
+		resp.Diagnostics.Append(dErr...)
+		config[name] = value
+	}
+	configValue, dErr := types.MapValue(charmConfigOptionType, config)
+	resp.Diagnostics.Append(dErr...)
+	resources, dErr := types.MapValueFrom(ctx, types.Int64Type, charm.Resources)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Bases = bases
+	data.Config = configValue
+	data.Resources = resources
+	data.ID = types.StringValue(fmt.Sprintf("%s:%d", charm.Name, charm.Revision))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *charmDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-charm", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-charm","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceCharm, msg, additionalFields...)
+}
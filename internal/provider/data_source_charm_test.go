@@ -0,0 +1,40 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceCharm(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCharm("juju-qa-test", "latest/stable", "ubuntu@22.04"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_charm.this", "channel", "latest/stable"),
+					resource.TestCheckResourceAttr("data.juju_charm.this", "architecture", "amd64"),
+					resource.TestCheckResourceAttrSet("data.juju_charm.this", "revision"),
+					resource.TestCheckTypeSetElemAttr("data.juju_charm.this", "bases.*", "ubuntu@22.04"),
+					resource.TestCheckResourceAttr("data.juju_charm.this", "config.foo-file.type", "boolean"),
+					resource.TestCheckResourceAttrSet("data.juju_charm.this", "resources.foo-file"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCharm(name, channel, base string) string {
+	return fmt.Sprintf(`
+data "juju_charm" "this" {
+  name    = %q
+  channel = %q
+  base    = %q
+}`, name, channel, base)
+}
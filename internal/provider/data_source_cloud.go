@@ -0,0 +1,208 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &cloudDataSource{}
+
+func NewCloudDataSource() datasource.DataSource {
+	return &cloudDataSource{}
+}
+
+type cloudDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// cloudDataSourceModel is the juju data stored by terraform.
+// tfsdk must match cloud data source schema attribute names.
+type cloudDataSourceModel struct {
+	Name             types.String        `tfsdk:"name"`
+	Type             types.String        `tfsdk:"type"`
+	AuthTypes        types.List          `tfsdk:"auth_types"`
+	Endpoint         types.String        `tfsdk:"endpoint"`
+	IdentityEndpoint types.String        `tfsdk:"identity_endpoint"`
+	StorageEndpoint  types.String        `tfsdk:"storage_endpoint"`
+	DefaultRegion    types.String        `tfsdk:"default_region"`
+	Regions          []nestedCloudRegion `tfsdk:"regions"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *cloudDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud"
+}
+
+func (d *cloudDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing a cloud of the controller.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the cloud.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the cloud, e.g. `lxd` or `maas`.",
+				Computed:    true,
+			},
+			"auth_types": schema.ListAttribute{
+				Description: "The authentication types supported by the cloud.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "The API endpoint of the cloud.",
+				Computed:    true,
+			},
+			"identity_endpoint": schema.StringAttribute{
+				Description: "The identity endpoint of the cloud.",
+				Computed:    true,
+			},
+			"storage_endpoint": schema.StringAttribute{
+				Description: "The storage endpoint of the cloud.",
+				Computed:    true,
+			},
+			"default_region": schema.StringAttribute{
+				Description: "The default region of the cloud, empty if the cloud has no regions.",
+				Computed:    true,
+			},
+			"regions": schema.ListNestedAttribute{
+				Description: "The regions of the cloud.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the region.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The API endpoint of the region.",
+							Computed:    true,
+						},
+						"identity_endpoint": schema.StringAttribute{
+							Description: "The identity endpoint of the region.",
+							Computed:    true,
+						},
+						"storage_endpoint": schema.StringAttribute{
+							Description: "The storage endpoint of the region.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *cloudDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceCloud)
+}
+
+func (d *cloudDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "cloud")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "cloud")
+		return
+	}
+
+	var data cloudDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudName := data.Name.ValueString()
+	response, err := d.client.Clouds.ReadCloud(juju.ReadCloudInput{
+		Name: cloudName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju cloud %q data source", cloudName))
+
+	authTypes, dErr := types.ListValueFrom(ctx, types.StringType, response.AuthTypes)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	data.Type = types.StringValue(response.Type)
+	data.AuthTypes = authTypes
+	data.Endpoint = types.StringValue(response.Endpoint)
+	data.IdentityEndpoint = types.StringValue(response.IdentityEndpoint)
+	data.StorageEndpoint = types.StringValue(response.StorageEndpoint)
+	data.DefaultRegion = types.StringValue(cloudDefaultRegion(response.Regions))
+	data.Regions = make([]nestedCloudRegion, 0, len(response.Regions))
+	for _, region := range response.Regions {
+		data.Regions = append(data.Regions, nestedCloudRegion{
+			Name:             types.StringValue(region.Name),
+			Endpoint:         types.StringValue(region.Endpoint),
+			IdentityEndpoint: types.StringValue(region.IdentityEndpoint),
+			StorageEndpoint:  types.StringValue(region.StorageEndpoint),
+		})
+	}
+	data.ID = types.StringValue(cloudName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// cloudDefaultRegion returns the name of the default region of a
+// cloud, the first of its regions.
+func cloudDefaultRegion(regions []juju.CloudRegion) string {
+	if len(regions) == 0 {
+		return ""
+	}
+	return regions[0].Name
+}
+
+func (d *cloudDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-cloud", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-cloud","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceCloud, msg, additionalFields...)
+}
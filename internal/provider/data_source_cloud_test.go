@@ -0,0 +1,37 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceCloud(t *testing.T) {
+	cloudName := testingCloud.CloudName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCloud(cloudName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_cloud.this", "name", cloudName),
+					resource.TestCheckResourceAttrSet("data.juju_cloud.this", "type"),
+					resource.TestCheckResourceAttrPair("data.juju_cloud.this", "default_region", "data.juju_cloud.this", "regions.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCloud(cloudName string) string {
+	return fmt.Sprintf(`
+data "juju_cloud" "this" {
+  name = %q
+}`, cloudName)
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &cloudsDataSource{}
+
+func NewCloudsDataSource() datasource.DataSource {
+	return &cloudsDataSource{}
+}
+
+type cloudsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// cloudsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match clouds data source schema attribute names.
+type cloudsDataSourceModel struct {
+	Clouds []nestedDataSourceCloud `tfsdk:"clouds"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceCloud represents an element of the clouds list of the
+// clouds data source.
+type nestedDataSourceCloud struct {
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	AuthTypes     types.List   `tfsdk:"auth_types"`
+	DefaultRegion types.String `tfsdk:"default_region"`
+	Regions       types.List   `tfsdk:"regions"`
+}
+
+func (d *cloudsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clouds"
+}
+
+func (d *cloudsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the clouds of the controller the user can see.",
+		Attributes: map[string]schema.Attribute{
+			"clouds": schema.ListNestedAttribute{
+				Description: "The clouds of the controller, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the cloud.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the cloud.",
+							Computed:    true,
+						},
+						"auth_types": schema.ListAttribute{
+							Description: "The authentication types supported by the cloud.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"default_region": schema.StringAttribute{
+							Description: "The default region of the cloud, empty if the cloud has no regions.",
+							Computed:    true,
+						},
+						"regions": schema.ListAttribute{
+							Description: "The names of the regions of the cloud.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *cloudsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceClouds)
+}
+
+func (d *cloudsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "clouds")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "clouds")
+		return
+	}
+
+	var data cloudsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clouds, err := d.client.Clouds.ListClouds()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list clouds, got error: %s", err))
+		return
+	}
+	d.trace("read juju clouds data source")
+
+	// Save data into Terraform state
+	data.Clouds = make([]nestedDataSourceCloud, 0, len(clouds))
+	for _, cloud := range clouds {
+		authTypes, dErr := types.ListValueFrom(ctx, types.StringType, cloud.AuthTypes)
+		resp.Diagnostics.Append(dErr...)
+		regionNames := make([]string, 0, len(cloud.Regions))
+		for _, region := range cloud.Regions {
+			regionNames = append(regionNames, region.Name)
+		}
+		regions, dErr := types.ListValueFrom(ctx, types.StringType, regionNames)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Clouds = append(data.Clouds, nestedDataSourceCloud{
+			Name:          types.StringValue(cloud.Name),
+			Type:          types.StringValue(cloud.Type),
+			AuthTypes:     authTypes,
+			DefaultRegion: types.StringValue(cloudDefaultRegion(cloud.Regions)),
+			Regions:       regions,
+		})
+	}
+	data.ID = types.StringValue("clouds")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *cloudsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-clouds", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-clouds","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceClouds, msg, additionalFields...)
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceClouds(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceClouds(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.juju_clouds.all", "clouds.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_clouds.all", "clouds.*", map[string]string{
+						"name": testingCloud.CloudName(),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceClouds() string {
+	return `
+data "juju_clouds" "all" {}`
+}
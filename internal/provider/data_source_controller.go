@@ -0,0 +1,163 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &controllerDataSource{}
+
+func NewControllerDataSource() datasource.DataSource {
+	return &controllerDataSource{}
+}
+
+type controllerDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// controllerDataSourceModel is the juju data stored by terraform.
+// tfsdk must match controller data source schema attribute names.
+type controllerDataSourceModel struct {
+	UUID         types.String `tfsdk:"uuid"`
+	Name         types.String `tfsdk:"name"`
+	Version      types.String `tfsdk:"version"`
+	APIAddresses types.List   `tfsdk:"api_addresses"`
+	CACert       types.String `tfsdk:"ca_cert"`
+	Cloud        types.String `tfsdk:"cloud"`
+	CloudRegion  types.String `tfsdk:"cloud_region"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *controllerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller"
+}
+
+func (d *controllerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the controller the provider is connected to.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the controller.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the controller.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The Juju version of the controller, e.g. `3.3.0`.",
+				Computed:    true,
+			},
+			"api_addresses": schema.ListAttribute{
+				Description: "The addresses of the controller API, as `host:port`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"ca_cert": schema.StringAttribute{
+				Description: "The CA certificate of the controller.",
+				Computed:    true,
+			},
+			"cloud": schema.StringAttribute{
+				Description: "The cloud the controller is running on.",
+				Computed:    true,
+			},
+			"cloud_region": schema.StringAttribute{
+				Description: "The region of the cloud the controller is running on.",
+				Computed:    true,
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *controllerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceController)
+}
+
+func (d *controllerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "controller")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "controller")
+		return
+	}
+
+	var data controllerDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Controller.ReadController()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controller, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju controller %q data source", response.Name))
+
+	addresses, dErr := types.ListValueFrom(ctx, types.StringType, response.APIAddresses)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	data.UUID = types.StringValue(response.UUID)
+	data.Name = types.StringValue(response.Name)
+	data.Version = types.StringValue(response.Version)
+	data.APIAddresses = addresses
+	data.CACert = types.StringValue(response.CACert)
+	data.Cloud = types.StringValue(response.Cloud)
+	data.CloudRegion = types.StringValue(response.CloudRegion)
+	data.ID = types.StringValue(response.UUID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *controllerDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-controller", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-controller","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceController, msg, additionalFields...)
+}
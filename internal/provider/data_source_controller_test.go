@@ -0,0 +1,34 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceController(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceController(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.juju_controller.this", "version", regexp.MustCompile(`^\d+\.\d+`)),
+					resource.TestCheckResourceAttrSet("data.juju_controller.this", "uuid"),
+					resource.TestCheckResourceAttrSet("data.juju_controller.this", "ca_cert"),
+					resource.TestCheckResourceAttrSet("data.juju_controller.this", "api_addresses.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceController() string {
+	return `
+data "juju_controller" "this" {}`
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &credentialsDataSource{}
+
+func NewCredentialsDataSource() datasource.DataSource {
+	return &credentialsDataSource{}
+}
+
+type credentialsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// credentialsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match credentials data source schema attribute names.
+type credentialsDataSourceModel struct {
+	Cloud       types.String                 `tfsdk:"cloud"`
+	Credentials []nestedDataSourceCredential `tfsdk:"credentials"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceCredential represents an element of the credentials
+// list of the credentials data source.
+type nestedDataSourceCredential struct {
+	Name     types.String `tfsdk:"name"`
+	Cloud    types.String `tfsdk:"cloud"`
+	AuthType types.String `tfsdk:"auth_type"`
+	Models   types.List   `tfsdk:"models"`
+}
+
+func (d *credentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials"
+}
+
+func (d *credentialsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the cloud credentials of the user stored on the controller, " +
+			"with the models using them. The attributes of the credentials are not read.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				Description: "Only list the credentials of the cloud.",
+				Optional:    true,
+			},
+			"credentials": schema.ListNestedAttribute{
+				Description: "The credentials, sorted by cloud and name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the credential.",
+							Computed:    true,
+						},
+						"cloud": schema.StringAttribute{
+							Description: "The name of the cloud of the credential.",
+							Computed:    true,
+						},
+						"auth_type": schema.StringAttribute{
+							Description: "The authentication type of the credential.",
+							Computed:    true,
+						},
+						"models": schema.ListAttribute{
+							Description: "The names of the models using the credential, sorted.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *credentialsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceCredentials)
+}
+
+func (d *credentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "credentials")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "credentials")
+		return
+	}
+
+	var data credentialsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := d.client.Credentials.ListCredentials(juju.ListCredentialsInput{
+		CloudName: data.Cloud.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list credentials, got error: %s", err))
+		return
+	}
+	d.trace("read juju credentials data source")
+
+	// Save data into Terraform state
+	data.Credentials = make([]nestedDataSourceCredential, 0, len(credentials))
+	for _, credential := range credentials {
+		models, dErr := types.ListValueFrom(ctx, types.StringType, credential.Models)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Credentials = append(data.Credentials, nestedDataSourceCredential{
+			Name:     types.StringValue(credential.Name),
+			Cloud:    types.StringValue(credential.Cloud),
+			AuthType: types.StringValue(credential.AuthType),
+			Models:   models,
+		})
+	}
+	data.ID = types.StringValue("credentials")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *credentialsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-credentials", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-credentials","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceCredentials, msg, additionalFields...)
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceCredentials(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCredentials(credentialName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_credentials.localhost", "credentials.*", map[string]string{
+						"name":      credentialName,
+						"cloud":     "localhost",
+						"auth_type": "certificate",
+						"models.#":  "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCredentials(credentialName string) string {
+	return fmt.Sprintf(`
+resource "juju_credential" "test" {
+  name = %q
+
+  cloud {
+    name = "localhost"
+  }
+
+  auth_type = "certificate"
+}
+
+data "juju_credentials" "localhost" {
+  cloud = "localhost"
+
+  depends_on = [juju_credential.test]
+}`, credentialName)
+}
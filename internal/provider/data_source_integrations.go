@@ -0,0 +1,222 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &integrationsDataSource{}
+
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &integrationsDataSource{}
+}
+
+type integrationsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// integrationsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match integrations data source schema attribute names.
+type integrationsDataSourceModel struct {
+	ModelName       types.String                  `tfsdk:"model"`
+	ApplicationName types.String                  `tfsdk:"application_name"`
+	Integrations    []nestedDataSourceIntegration `tfsdk:"integrations"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceIntegration represents an element of the
+// integrations list of the integrations data source.
+type nestedDataSourceIntegration struct {
+	Applications []nestedDataSourceIntegrationApplication `tfsdk:"applications"`
+	ImportID     types.String                             `tfsdk:"import_id"`
+}
+
+// nestedDataSourceIntegrationApplication represents an application of
+// an integration of the integrations data source.
+type nestedDataSourceIntegrationApplication struct {
+	Name     types.String `tfsdk:"name"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	Role     types.String `tfsdk:"role"`
+	OfferURL types.String `tfsdk:"offer_url"`
+}
+
+func (d *integrationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+func (d *integrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the Juju Integrations of a model with the IDs to import them " +
+			"with, e.g. to generate `import` blocks of juju_integration resources.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model.",
+				Required:    true,
+			},
+			"application_name": schema.StringAttribute{
+				Description: "Only list the integrations of the application.",
+				Optional:    true,
+			},
+			"integrations": schema.ListNestedAttribute{
+				Description: "The integrations of the model. Peer integrations are not included.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"applications": schema.ListNestedAttribute{
+							Description: "The two applications of the integration, the provider first.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name of the application, or of the consumed offer.",
+										Computed:    true,
+									},
+									"endpoint": schema.StringAttribute{
+										Description: "The endpoint name.",
+										Computed:    true,
+									},
+									"role": schema.StringAttribute{
+										Description: "The role of the endpoint, provider or requirer.",
+										Computed:    true,
+									},
+									"offer_url": schema.StringAttribute{
+										Description: "The URL of the offer, when the application is consumed from an offer.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"import_id": schema.StringAttribute{
+							Description: "The ID to import the integration as a juju_integration resource.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *integrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceIntegrations)
+}
+
+func (d *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "integrations")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "integrations")
+		return
+	}
+
+	var data integrationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	integrations, err := d.client.Integrations.ListIntegrations(juju.ListIntegrationsInput{
+		ModelName: modelName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list integrations, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju integrations of model %q data source", modelName))
+
+	// The import IDs are keyed on the model UUID, as the IDs of the
+	// resources are.
+	modelUUID, err := modelUUIDForID(d.client, modelName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the UUID of model %q, got error: %s", modelName, err))
+		return
+	}
+
+	// Save data into Terraform state
+	appName := data.ApplicationName.ValueString()
+	data.Integrations = make([]nestedDataSourceIntegration, 0, len(integrations))
+	for _, integration := range integrations {
+		integrated := appName == ""
+		applications := make([]nestedDataSourceIntegrationApplication, 0, len(integration.Applications))
+		for _, app := range integration.Applications {
+			if app.Name == appName {
+				integrated = true
+			}
+			application := nestedDataSourceIntegrationApplication{
+				Name:     types.StringValue(app.Name),
+				Endpoint: types.StringValue(app.Endpoint),
+				Role:     types.StringValue(app.Role),
+				OfferURL: types.StringNull(),
+			}
+			if app.OfferURL != nil {
+				application.OfferURL = types.StringValue(*app.OfferURL)
+			}
+			// The provider is listed first, as in the import ID.
+			if app.Role == "provider" {
+				applications = append([]nestedDataSourceIntegrationApplication{application}, applications...)
+			} else {
+				applications = append(applications, application)
+			}
+		}
+		if !integrated {
+			continue
+		}
+		data.Integrations = append(data.Integrations, nestedDataSourceIntegration{
+			Applications: applications,
+			ImportID:     types.StringValue(newIDForIntegrationResource(modelUUID, integration.Applications)),
+		})
+	}
+	data.ID = types.StringValue(modelName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *integrationsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-integrations", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-integrations","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceIntegrations, msg, additionalFields...)
+}
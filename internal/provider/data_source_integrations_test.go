@@ -0,0 +1,98 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceIntegrations(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-integrations-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIntegrations(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_integrations.this", "integrations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.juju_integrations.this", "integrations.0.import_id", "juju_integration.this", "id"),
+					resource.TestCheckResourceAttr("data.juju_integrations.this", "integrations.0.applications.#", "2"),
+					resource.TestCheckResourceAttr("data.juju_integrations.this", "integrations.0.applications.0.role", "provider"),
+					resource.TestCheckResourceAttr("data.juju_integrations.other", "integrations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIntegrations(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "one" {
+	model = juju_model.this.name
+	name  = "one"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_application" "two" {
+	model = juju_model.this.name
+	name  = "two"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_application" "three" {
+	model = juju_model.this.name
+	name  = "three"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_integration" "this" {
+	model = juju_model.this.name
+
+	application {
+		name     = juju_application.one.name
+		endpoint = "source"
+	}
+
+	application {
+		name     = juju_application.two.name
+		endpoint = "sink"
+	}
+}
+
+data "juju_integrations" "this" {
+	model = juju_model.this.name
+
+	depends_on = [juju_integration.this]
+}
+
+data "juju_integrations" "other" {
+	model            = juju_model.this.name
+	application_name = juju_application.three.name
+
+	depends_on = [juju_integration.this]
+}
+`, modelName)
+}
@@ -30,8 +30,15 @@ type machineDataSource struct {
 }
 
 type machineDataSourceModel struct {
-	Model     types.String `tfsdk:"model"`
-	MachineID types.String `tfsdk:"machine_id"`
+	Model       types.String `tfsdk:"model"`
+	MachineID   types.String `tfsdk:"machine_id"`
+	Base        types.String `tfsdk:"base"`
+	Constraints types.String `tfsdk:"constraints"`
+	Status      types.String `tfsdk:"status"`
+	InstanceId  types.String `tfsdk:"instance_id"`
+	Hostname    types.String `tfsdk:"hostname"`
+	IPAddresses types.List   `tfsdk:"ip_addresses"`
+	Hardware    types.Map    `tfsdk:"hardware"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -46,13 +53,43 @@ func (d *machineDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 		Description: "A data source representing a Juju Machine.",
 		Attributes: map[string]schema.Attribute{
 			"model": schema.StringAttribute{
-				Description: "The name of the model.",
+				Description: "The name or UUID of the model.",
 				Required:    true,
 			},
 			"machine_id": schema.StringAttribute{
 				Description: "The Juju id of the machine.",
 				Required:    true,
 			},
+			"base": schema.StringAttribute{
+				Description: "The base of the machine, e.g. ubuntu@22.04.",
+				Computed:    true,
+			},
+			"constraints": schema.StringAttribute{
+				Description: "The constraints of the machine.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the machine agent, e.g. pending or started.",
+				Computed:    true,
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The id of the instance of the machine in the cloud.",
+				Computed:    true,
+			},
+			"hostname": schema.StringAttribute{
+				Description: "The hostname of the machine.",
+				Computed:    true,
+			},
+			"ip_addresses": schema.ListAttribute{
+				Description: "The IP addresses of the machine.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"hardware": schema.MapAttribute{
+				Description: "The hardware characteristics of the machine, e.g. arch, cores and mem.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			// ID required by the testing framework
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -92,6 +129,10 @@ func (d *machineDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		addDSClientNotConfiguredError(&resp.Diagnostics, "machine")
 		return
 	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "machine")
+		return
+	}
 
 	var data machineDataSourceModel
 
@@ -109,15 +150,30 @@ func (d *machineDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	d.trace(fmt.Sprintf("reading juju machine %q data source", machine_id))
 
 	// Verify the machine exists in the model provided
-	if _, err := d.client.Machines.ReadMachine(
+	response, err := d.client.Machines.ReadMachine(
 		juju.ReadMachineInput{
 			ModelName: data.Model.ValueString(),
 			ID:        machine_id,
 		},
-	); err != nil {
+	)
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read machine %q, got error: %s", machine_id, err))
 		return
 	}
+	ipAddresses, dErr := types.ListValueFrom(ctx, types.StringType, response.IPAddresses)
+	resp.Diagnostics.Append(dErr...)
+	hardware, dErr := types.MapValueFrom(ctx, types.StringType, response.Hardware)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Base = types.StringValue(response.Base)
+	data.Constraints = types.StringValue(response.Constraints)
+	data.Status = types.StringValue(response.Status)
+	data.InstanceId = types.StringValue(response.InstanceId)
+	data.Hostname = types.StringValue(response.Hostname)
+	data.IPAddresses = ipAddresses
+	data.Hardware = hardware
 
 	// machine_id is not unique, however it matches the
 	// SDK value used. "id" is required for tests.
@@ -25,6 +25,8 @@ func TestAcc_DataSourceMachine_Edge(t *testing.T) {
 				Config: testAccDataSourceMachine(modelName, "base = \"ubuntu@22.04\""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.juju_machine.machine", "model", modelName),
+					resource.TestCheckResourceAttr("data.juju_machine.machine", "base", "ubuntu@22.04"),
+					resource.TestCheckResourceAttrPair("data.juju_machine.machine", "machine_id", "juju_machine.machine", "machine_id"),
 				),
 			},
 		},
@@ -0,0 +1,241 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &machinesDataSource{}
+
+func NewMachinesDataSource() datasource.DataSource {
+	return &machinesDataSource{}
+}
+
+type machinesDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// machinesDataSourceModel is the juju data stored by terraform.
+// tfsdk must match machines data source schema attribute names.
+type machinesDataSourceModel struct {
+	Model       types.String `tfsdk:"model"`
+	Base        types.String `tfsdk:"base"`
+	Constraints types.String `tfsdk:"constraints"`
+	Machines    types.List   `tfsdk:"machines"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+var machinesDataSourceMachineType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"machine_id":   types.StringType,
+		"base":         types.StringType,
+		"constraints":  types.StringType,
+		"status":       types.StringType,
+		"instance_id":  types.StringType,
+		"hostname":     types.StringType,
+		"ip_addresses": types.ListType{ElemType: types.StringType},
+		"hardware":     types.MapType{ElemType: types.StringType},
+	},
+}
+
+func (d *machinesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machines"
+}
+
+func (d *machinesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the machines of a Juju Model, containers included.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name or UUID of the model.",
+				Required:    true,
+			},
+			"base": schema.StringAttribute{
+				Description: "Only list the machines with the base, e.g. ubuntu@22.04.",
+				Optional:    true,
+			},
+			"constraints": schema.StringAttribute{
+				Description: "Only list the machines with all of the constraints, e.g. `arch=amd64 mem=8G`.",
+				Optional:    true,
+			},
+			"machines": schema.ListNestedAttribute{
+				Description: "The machines of the model, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"machine_id": schema.StringAttribute{
+							Description: "The Juju id of the machine.",
+							Computed:    true,
+						},
+						"base": schema.StringAttribute{
+							Description: "The base of the machine, e.g. ubuntu@22.04.",
+							Computed:    true,
+						},
+						"constraints": schema.StringAttribute{
+							Description: "The constraints of the machine.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the machine agent, e.g. pending or started.",
+							Computed:    true,
+						},
+						"instance_id": schema.StringAttribute{
+							Description: "The id of the instance of the machine in the cloud.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "The hostname of the machine.",
+							Computed:    true,
+						},
+						"ip_addresses": schema.ListAttribute{
+							Description: "The IP addresses of the machine.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"hardware": schema.MapAttribute{
+							Description: "The hardware characteristics of the machine, e.g. arch, cores and mem.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *machinesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceMachines)
+}
+
+func (d *machinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "machines")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "machines")
+		return
+	}
+
+	var data machinesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.Model.ValueString()
+	machines, err := d.client.Machines.ListMachines(juju.ListMachinesInput{
+		ModelName: modelName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list machines, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju machines of model %q data source", modelName))
+
+	// Save data into Terraform state
+	constraintFilter := strings.Fields(data.Constraints.ValueString())
+	values := make([]attr.Value, 0, len(machines))
+	for _, machine := range machines {
+		if !data.Base.IsNull() && machine.Base != data.Base.ValueString() {
+			continue
+		}
+		if !machineHasConstraints(machine.Constraints, constraintFilter) {
+			continue
+		}
+		ipAddresses, dErr := types.ListValueFrom(ctx, types.StringType, machine.IPAddresses)
+		resp.Diagnostics.Append(dErr...)
+		hardware, dErr := types.MapValueFrom(ctx, types.StringType, machine.Hardware)
+		resp.Diagnostics.Append(dErr...)
+		value, dErr := types.ObjectValue(machinesDataSourceMachineType.AttrTypes, map[string]attr.Value{
+			"machine_id":   types.StringValue(machine.ID),
+			"base":         types.StringValue(machine.Base),
+			"constraints":  types.StringValue(machine.Constraints),
+			"status":       types.StringValue(machine.Status),
+			"instance_id":  types.StringValue(machine.InstanceId),
+			"hostname":     types.StringValue(machine.Hostname),
+			"ip_addresses": ipAddresses,
+			"hardware":     hardware,
+		})
+		resp.Diagnostics.Append(dErr...)
+		values = append(values, value)
+	}
+	machinesValue, dErr := types.ListValue(machinesDataSourceMachineType, values)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Machines = machinesValue
+	data.ID = types.StringValue(modelName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// machineHasConstraints returns whether the constraints of a machine
+// include all of the given constraints, e.g. `mem=8G`.
+func machineHasConstraints(machineConstraints string, constraints []string) bool {
+	have := strings.Fields(machineConstraints)
+	for _, constraint := range constraints {
+		found := false
+		for _, c := range have {
+			if c == constraint {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *machinesDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-machines", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-machines","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceMachines, msg, additionalFields...)
+}
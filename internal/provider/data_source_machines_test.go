@@ -0,0 +1,68 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceMachines(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-machines-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMachines(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_machines.all", "machines.#", "2"),
+					resource.TestCheckResourceAttrPair("data.juju_machines.all", "machines.0.machine_id", "juju_machine.jammy", "machine_id"),
+					resource.TestCheckResourceAttr("data.juju_machines.focal", "machines.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_machines.focal", "machines.0.base", "ubuntu@20.04"),
+					resource.TestCheckResourceAttrPair("data.juju_machines.focal", "machines.0.machine_id", "juju_machine.focal", "machine_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMachines(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "model" {
+  name = %q
+}
+
+resource "juju_machine" "jammy" {
+  model = juju_model.model.name
+  base  = "ubuntu@22.04"
+}
+
+resource "juju_machine" "focal" {
+  model = juju_model.model.name
+  base  = "ubuntu@20.04"
+
+  depends_on = [juju_machine.jammy]
+}
+
+data "juju_machines" "all" {
+  model = juju_model.model.name
+
+  depends_on = [juju_machine.jammy, juju_machine.focal]
+}
+
+data "juju_machines" "focal" {
+  model = juju_model.model.name
+  base  = "ubuntu@20.04"
+
+  depends_on = [juju_machine.jammy, juju_machine.focal]
+}`, modelName)
+}
@@ -6,11 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v4"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -30,8 +35,17 @@ type modelDataSource struct {
 }
 
 type modelDataSourceModel struct {
-	Name types.String `tfsdk:"name"`
-	UUID types.String `tfsdk:"uuid"`
+	Name         types.String `tfsdk:"name"`
+	Owner        types.String `tfsdk:"owner"`
+	AgentVersion types.String `tfsdk:"agent_version"`
+	Life         types.String `tfsdk:"life"`
+	Status       types.String `tfsdk:"status"`
+	UUID         types.String `tfsdk:"uuid"`
+	Cloud        types.String `tfsdk:"cloud"`
+	Region       types.String `tfsdk:"region"`
+	Credential   types.String `tfsdk:"credential"`
+	Config       types.Map    `tfsdk:"config"`
+	Constraints  types.String `tfsdk:"constraints"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -47,11 +61,57 @@ func (d *modelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 		Description: "A data source representing a Juju Model.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Description: "The name of the model.",
-				Required:    true,
+				Description: "The name of the model. Models owned by another user are named by their " +
+					"qualified name, e.g. `bob/development`. Either the name or the UUID of the model is required.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("uuid"),
+					}...),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "The name of the user owning the model.",
+				Computed:    true,
 			},
 			"uuid": schema.StringAttribute{
 				Description: "The UUID of the model.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud of the model.",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "The region of the cloud of the model.",
+				Computed:    true,
+			},
+			"credential": schema.StringAttribute{
+				Description: "The name of the cloud credential used by the model.",
+				Computed:    true,
+			},
+			"config": schema.MapAttribute{
+				Description: "The config of the model, including the values inherited from the controller " +
+					"and cloud. Values which are not strings are JSON encoded.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"constraints": schema.StringAttribute{
+				Description: "The constraints of the model.",
+				Computed:    true,
+			},
+			"agent_version": schema.StringAttribute{
+				Description: "The version of the juju agents of the model, e.g. 3.4.0.",
+				Computed:    true,
+			},
+			"life": schema.StringAttribute{
+				Description: "The life of the model, e.g. alive or dying.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the model, e.g. available or busy.",
 				Computed:    true,
 			},
 			// ID required by the testing framework
@@ -93,6 +153,10 @@ func (d *modelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		addDSClientNotConfiguredError(&resp.Diagnostics, "model")
 		return
 	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "model")
+		return
+	}
 
 	var data modelDataSourceModel
 
@@ -102,17 +166,60 @@ func (d *modelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Get current juju model data source values.
-	model, err := d.client.Models.GetModelByName(data.Name.ValueString())
+	// Get current juju model data source values, the model is
+	// found either by its name or its UUID.
+	nameOrUUID := data.Name.ValueString()
+	if nameOrUUID == "" {
+		nameOrUUID = data.UUID.ValueString()
+	}
+	response, err := d.client.Models.ReadModel(nameOrUUID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model, got error: %s", err))
 		return
 	}
-	d.trace(fmt.Sprintf("read juju model %q data source", data.Name))
+	d.trace(fmt.Sprintf("read juju model %q data source", nameOrUUID))
+	model := response.ModelInfo
 
-	// Save data into Terraform state
-	data.Name = types.StringValue(model.Name)
+	// Save data into Terraform state, the name is kept as
+	// configured as it may be qualified by the model owner.
+	if data.Name.IsNull() {
+		data.Name = types.StringValue(model.Name)
+	}
+	data.Owner = types.StringValue(strings.TrimPrefix(model.OwnerTag, juju.PrefixUser))
 	data.UUID = types.StringValue(model.UUID)
+	data.Life = types.StringValue(string(model.Life))
+	data.Status = types.StringValue(string(model.Status.Status))
+	data.AgentVersion = types.StringValue("")
+	if model.AgentVersion != nil {
+		data.AgentVersion = types.StringValue(model.AgentVersion.String())
+	}
+	data.Cloud = types.StringValue(strings.TrimPrefix(model.CloudTag, juju.PrefixCloud))
+	data.Region = types.StringValue(model.CloudRegion)
+	data.Credential = types.StringValue("")
+	if model.CloudCredentialTag != "" {
+		tag, err := names.ParseCloudCredentialTag(model.CloudCredentialTag)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse cloud credential tag for model, got error: %s", err))
+			return
+		}
+		data.Credential = types.StringValue(tag.Name())
+	}
+	config := make(map[string]string, len(response.ModelConfig))
+	for key, value := range response.ModelConfig {
+		configValue, err := modelConfigValueString("", value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model config %q, got error: %s", key, err))
+			return
+		}
+		config[key] = configValue
+	}
+	configValue, dErr := types.MapValueFrom(ctx, types.StringType, config)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Config = configValue
+	data.Constraints = types.StringValue(response.ModelConstraints.String())
 	data.ID = types.StringValue(model.UUID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,304 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &modelExportDataSource{}
+
+func NewModelExportDataSource() datasource.DataSource {
+	return &modelExportDataSource{}
+}
+
+type modelExportDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// modelExportDataSourceModel is the juju data stored by terraform.
+// tfsdk must match model export data source schema attribute names.
+type modelExportDataSourceModel struct {
+	ModelName    types.String                        `tfsdk:"model"`
+	Applications []nestedDataSourceExportApplication `tfsdk:"applications"`
+	Integrations []nestedDataSourceExportIntegration `tfsdk:"integrations"`
+	Offers       []nestedDataSourceExportOffer       `tfsdk:"offers"`
+	Machines     []nestedDataSourceExportMachine     `tfsdk:"machines"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceExportApplication represents an element of the
+// applications list of the model export data source.
+type nestedDataSourceExportApplication struct {
+	Name     types.String `tfsdk:"name"`
+	ImportID types.String `tfsdk:"import_id"`
+}
+
+// nestedDataSourceExportIntegration represents an element of the
+// integrations list of the model export data source.
+type nestedDataSourceExportIntegration struct {
+	Endpoints types.List   `tfsdk:"endpoints"`
+	ImportID  types.String `tfsdk:"import_id"`
+}
+
+// nestedDataSourceExportOffer represents an element of the offers
+// list of the model export data source.
+type nestedDataSourceExportOffer struct {
+	Name     types.String `tfsdk:"name"`
+	URL      types.String `tfsdk:"url"`
+	ImportID types.String `tfsdk:"import_id"`
+}
+
+// nestedDataSourceExportMachine represents an element of the machines
+// list of the model export data source.
+type nestedDataSourceExportMachine struct {
+	MachineID types.String `tfsdk:"machine_id"`
+	ImportID  types.String `tfsdk:"import_id"`
+}
+
+func (d *modelExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_export"
+}
+
+func (d *modelExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the applications, integrations, offers and machines of an " +
+			"existing Juju Model with the IDs to import them with, e.g. to generate `import` blocks " +
+			"and adopt a model which was not created by Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model.",
+				Required:    true,
+			},
+			"applications": schema.ListNestedAttribute{
+				Description: "The applications of the model, sorted by name. Applications consumed " +
+					"from offers are not included.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the application.",
+							Computed:    true,
+						},
+						"import_id": schema.StringAttribute{
+							Description: "The ID to import the application as a juju_application resource.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"integrations": schema.ListNestedAttribute{
+				Description: "The integrations of the model. Peer integrations are not included.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoints": schema.ListAttribute{
+							Description: "The endpoints of the integration as `application:endpoint`, the " +
+								"provider first.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"import_id": schema.StringAttribute{
+							Description: "The ID to import the integration as a juju_integration resource.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"offers": schema.ListNestedAttribute{
+				Description: "The offers of the model, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the offer.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "The offer URL.",
+							Computed:    true,
+						},
+						"import_id": schema.StringAttribute{
+							Description: "The ID to import the offer as a juju_offer resource.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"machines": schema.ListNestedAttribute{
+				Description: "The machines of the model, containers included, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"machine_id": schema.StringAttribute{
+							Description: "The Juju id of the machine.",
+							Computed:    true,
+						},
+						"import_id": schema.StringAttribute{
+							Description: "The ID to import the machine as a juju_machine resource, with " +
+								"the default machine name.",
+							Computed: true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *modelExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceModelExport)
+}
+
+func (d *modelExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "model_export")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "model_export")
+		return
+	}
+
+	var data modelExportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	applications, err := d.client.Applications.ListApplications(juju.ListApplicationsInput{
+		ModelName: modelName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list applications, got error: %s", err))
+		return
+	}
+	integrations, err := d.client.Integrations.ListIntegrations(juju.ListIntegrationsInput{
+		ModelName: modelName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list integrations, got error: %s", err))
+		return
+	}
+	offers, err := d.client.Offers.ListOffers(&juju.ListOffersInput{
+		ModelName: modelName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list offers, got error: %s", err))
+		return
+	}
+	machines, err := d.client.Machines.ListMachines(juju.ListMachinesInput{
+		ModelName: modelName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list machines, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju model export of model %q data source", modelName))
+
+	// The import IDs are keyed on the model UUID, as the IDs of the
+	// resources are.
+	modelUUID, err := modelUUIDForID(d.client, modelName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the UUID of model %q, got error: %s", modelName, err))
+		return
+	}
+
+	// Save data into Terraform state
+	data.Applications = make([]nestedDataSourceExportApplication, 0, len(applications))
+	for _, name := range applications {
+		data.Applications = append(data.Applications, nestedDataSourceExportApplication{
+			Name:     types.StringValue(name),
+			ImportID: types.StringValue(newAppID(modelUUID, name)),
+		})
+	}
+	data.Integrations = make([]nestedDataSourceExportIntegration, 0, len(integrations))
+	for _, integration := range integrations {
+		importID := newIDForIntegrationResource(modelUUID, integration.Applications)
+		// The import ID holds the provider endpoint first.
+		endpoints, dErr := types.ListValueFrom(ctx, types.StringType, integrationEndpointsFromID(importID))
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Integrations = append(data.Integrations, nestedDataSourceExportIntegration{
+			Endpoints: endpoints,
+			ImportID:  types.StringValue(importID),
+		})
+	}
+	data.Offers = make([]nestedDataSourceExportOffer, 0, len(offers))
+	for _, offer := range offers {
+		data.Offers = append(data.Offers, nestedDataSourceExportOffer{
+			Name:     types.StringValue(offer.Name),
+			URL:      types.StringValue(offer.OfferURL),
+			ImportID: types.StringValue(offer.OfferURL),
+		})
+	}
+	data.Machines = make([]nestedDataSourceExportMachine, 0, len(machines))
+	for _, machine := range machines {
+		data.Machines = append(data.Machines, nestedDataSourceExportMachine{
+			MachineID: types.StringValue(machine.ID),
+			ImportID:  types.StringValue(newMachineID(modelUUID, machine.ID, fmt.Sprintf("machine-%s", machine.ID))),
+		})
+	}
+	data.ID = types.StringValue(modelName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// integrationEndpointsFromID returns the endpoints of an integration
+// ID as `application:endpoint`, in the order of the ID.
+func integrationEndpointsFromID(id string) []string {
+	_, provider, requirer, diags := modelNameAndEndpointsFromID(id)
+	if diags.HasError() {
+		return nil
+	}
+	return []string{provider, requirer}
+}
+
+func (d *modelExportDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-model-export", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-model-export","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceModelExport, msg, additionalFields...)
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceModelExport(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-model-export-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceModelExport(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_model_export.this", "applications.#", "2"),
+					resource.TestCheckResourceAttr("data.juju_model_export.this", "applications.0.name", "one"),
+					testAccCheckModelUUIDAttr("data.juju_model_export.this", "applications.0.import_id", "juju_model.this", "one"),
+					resource.TestCheckResourceAttr("data.juju_model_export.this", "integrations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.juju_model_export.this", "integrations.0.import_id", "juju_integration.this", "id"),
+					resource.TestCheckResourceAttr("data.juju_model_export.this", "offers.#", "0"),
+					resource.TestCheckResourceAttr("data.juju_model_export.this", "machines.#", "2"),
+					testAccCheckModelUUIDAttr("data.juju_model_export.this", "machines.0.import_id", "juju_model.this", "0:machine-0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceModelExport(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "one" {
+	model = juju_model.this.name
+	name  = "one"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_application" "two" {
+	model = juju_model.this.name
+	name  = "two"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_integration" "this" {
+	model = juju_model.this.name
+
+	application {
+		name     = juju_application.one.name
+		endpoint = "sink"
+	}
+
+	application {
+		name     = juju_application.two.name
+		endpoint = "source"
+	}
+}
+
+data "juju_model_export" "this" {
+	model = juju_model.this.name
+
+	depends_on = [juju_integration.this]
+}
+`, modelName)
+}
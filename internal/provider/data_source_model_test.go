@@ -23,6 +23,30 @@ func TestAcc_DataSourceModel_Edge(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.juju_model.test-model", "name", modelName),
 					resource.TestCheckResourceAttrSet("data.juju_model.test-model", "uuid"),
+					resource.TestCheckResourceAttr("data.juju_model.test-model", "life", "alive"),
+					resource.TestCheckResourceAttrSet("data.juju_model.test-model", "agent_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DataSourceModel_UUID(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-model-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFrameworkDataSourceModelUUID(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_model.test-model", "name", modelName),
+					resource.TestCheckResourceAttrPair("data.juju_model.test-model", "uuid", "juju_model.test-model", "uuid"),
+					resource.TestCheckResourceAttrSet("data.juju_model.test-model", "cloud"),
+					resource.TestCheckResourceAttrSet("data.juju_model.test-model", "credential"),
+					resource.TestCheckResourceAttr("data.juju_model.test-model", "config.development", "true"),
+					resource.TestCheckResourceAttr("data.juju_model.test-model", "constraints", "arch=amd64"),
 				),
 			},
 		},
@@ -62,3 +86,19 @@ data "juju_model" "test-model" {
 	name = juju_model.test-model.name
 }`, modelName)
 }
+
+func testAccFrameworkDataSourceModelUUID(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test-model" {
+  name        = %q
+  constraints = "arch=amd64"
+
+  config = {
+    development = true
+  }
+}
+
+data "juju_model" "test-model" {
+  uuid = juju_model.test-model.uuid
+}`, modelName)
+}
@@ -33,7 +33,11 @@ type offerDataSource struct {
 // tfsdk must match offer data source schema attribute names.
 type offerDataSourceModel struct {
 	ApplicationName types.String `tfsdk:"application_name"`
+	ConnectionCount types.Int64  `tfsdk:"connection_count"`
+	Consumers       types.List   `tfsdk:"consumers"`
 	Endpoint        types.String `tfsdk:"endpoint"`
+	Endpoints       types.Set    `tfsdk:"endpoints"`
+	EndpointURLs    types.Map    `tfsdk:"endpoint_urls"`
 	ModelName       types.String `tfsdk:"model"`
 	OfferName       types.String `tfsdk:"name"`
 	OfferURL        types.String `tfsdk:"url"`
@@ -68,8 +72,51 @@ func (d *offerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 			},
 			"endpoint": schema.StringAttribute{
-				Description: "The endpoint name.",
+				Description:        "The endpoint name, if the offer has a single endpoint.",
+				Computed:           true,
+				DeprecationMessage: "Use endpoints instead. This attribute will be removed in the next major version of the provider.",
+			},
+			"endpoints": schema.SetAttribute{
+				Description: "The endpoint names of the offer.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"endpoint_urls": schema.MapAttribute{
+				Description: "The offer URL of each offered endpoint, keyed by endpoint name.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"connection_count": schema.Int64Attribute{
+				Description: "The number of connections made to the offer.",
+				Computed:    true,
+			},
+			"consumers": schema.ListNestedAttribute{
+				Description: "The connections made to the offer by consuming models.",
 				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_model_uuid": schema.StringAttribute{
+							Description: "The UUID of the consuming model.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The user consuming the offer.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The offered endpoint in use.",
+							Computed:    true,
+						},
+						"relation_id": schema.Int64Attribute{
+							Description: "The id of the relation for this connection.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the connection.",
+							Computed:    true,
+						},
+					},
+				},
 			},
 			// ID required by the testing framework
 			"id": schema.StringAttribute{
@@ -104,6 +151,10 @@ func (d *offerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		addDSClientNotConfiguredError(&resp.Diagnostics, "offer")
 		return
 	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "offer")
+		return
+	}
 
 	var data offerDataSourceModel
 
@@ -125,7 +176,24 @@ func (d *offerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	// Save data into Terraform state
 	data.ApplicationName = types.StringValue(offer.ApplicationName)
-	data.Endpoint = types.StringValue(offer.Endpoint)
+	data.Endpoint = offerEndpointValue(offer.Endpoints)
+	endpoints, dErr := types.SetValueFrom(ctx, types.StringType, offer.Endpoints)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	data.Endpoints = endpoints
+	data.EndpointURLs, dErr = offerEndpointURLsValue(ctx, offer.OfferURL, offer.Endpoints)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	data.ConnectionCount = types.Int64Value(int64(len(offer.Connections)))
+	data.Consumers, dErr = offerConsumersValue(ctx, offer.Connections)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
 	data.ModelName = types.StringValue(offer.ModelName)
 	data.OfferName = types.StringValue(offer.Name)
 	data.OfferURL = types.StringValue(offer.OfferURL)
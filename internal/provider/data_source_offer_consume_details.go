@@ -0,0 +1,132 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &offerConsumeDetailsDataSource{}
+
+func NewOfferConsumeDetailsDataSource() datasource.DataSource {
+	return &offerConsumeDetailsDataSource{}
+}
+
+type offerConsumeDetailsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// offerConsumeDetailsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match offer consume details data source schema attribute names.
+type offerConsumeDetailsDataSourceModel struct {
+	OfferURL       types.String `tfsdk:"offer_url"`
+	ConsumeDetails types.String `tfsdk:"consume_details"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *offerConsumeDetailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_offer_consume_details"
+}
+
+func (d *offerConsumeDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the details needed to consume a Juju Offer from another " +
+			"controller, as `juju consume` reads them. They are given to the `offer_consume_details` of a " +
+			"juju_integration resource of the provider connected to the consuming controller.",
+		Attributes: map[string]schema.Attribute{
+			"offer_url": schema.StringAttribute{
+				Description: "The offer URL.",
+				Required:    true,
+			},
+			"consume_details": schema.StringAttribute{
+				Description: "The consume details of the offer, its endpoints, the addresses of the " +
+					"controller and a macaroon allowing to consume the offer.",
+				Computed:  true,
+				Sensitive: true,
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *offerConsumeDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceOfferConsumeDetails)
+}
+
+func (d *offerConsumeDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "offer_consume_details")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "offer_consume_details")
+		return
+	}
+
+	var data offerConsumeDetailsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offerURL := data.OfferURL.ValueString()
+	consumeDetails, err := d.client.Offers.ReadOfferConsumeDetails(&juju.ReadOfferConsumeDetailsInput{
+		OfferURL: offerURL,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read offer consume details, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju offer consume details %q data source", offerURL))
+
+	// Save data into Terraform state
+	data.ConsumeDetails = types.StringValue(consumeDetails)
+	data.ID = types.StringValue(offerURL)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *offerConsumeDetailsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-offer-consume-details", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-offer-consume-details","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceOfferConsumeDetails, msg, additionalFields...)
+}
@@ -25,6 +25,8 @@ func TestAcc_DataSourceOffer(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.juju_offer.this", "model", modelName),
 					resource.TestCheckResourceAttr("data.juju_offer.this", "name", offerName),
+					resource.TestCheckResourceAttr("data.juju_offer.this", "endpoint_urls.db", fmt.Sprintf("admin/%s.%s:db", modelName, offerName)),
+					resource.TestCheckResourceAttr("data.juju_offer.this", "connection_count", "0"),
 				),
 			},
 		},
@@ -0,0 +1,187 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &offersDataSource{}
+
+func NewOffersDataSource() datasource.DataSource {
+	return &offersDataSource{}
+}
+
+type offersDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// offersDataSourceModel is the juju data stored by terraform.
+// tfsdk must match offers data source schema attribute names.
+type offersDataSourceModel struct {
+	ModelName types.String            `tfsdk:"model"`
+	Offers    []nestedDataSourceOffer `tfsdk:"offers"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceOffer represents an element of the offers list of
+// the offers data source.
+type nestedDataSourceOffer struct {
+	OfferName       types.String `tfsdk:"name"`
+	OfferURL        types.String `tfsdk:"url"`
+	ApplicationName types.String `tfsdk:"application_name"`
+	Endpoints       types.Set    `tfsdk:"endpoints"`
+	EndpointURLs    types.Map    `tfsdk:"endpoint_urls"`
+	ConnectionCount types.Int64  `tfsdk:"connection_count"`
+}
+
+func (d *offersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_offers"
+}
+
+func (d *offersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the Juju Offers of a model.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the offers. Models owned by another user are given " +
+					"as `owner/name`.",
+				Required: true,
+			},
+			"offers": schema.ListNestedAttribute{
+				Description: "The offers of the model, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the offer.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "The offer URL.",
+							Computed:    true,
+						},
+						"application_name": schema.StringAttribute{
+							Description: "The name of the application.",
+							Computed:    true,
+						},
+						"endpoints": schema.SetAttribute{
+							Description: "The endpoint names of the offer.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"endpoint_urls": schema.MapAttribute{
+							Description: "The offer URL of each offered endpoint, keyed by endpoint name.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"connection_count": schema.Int64Attribute{
+							Description: "The number of connections made to the offer.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *offersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceOffers)
+}
+
+func (d *offersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "offers")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "offers")
+		return
+	}
+
+	var data offersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	offers, err := d.client.Offers.ListOffers(&juju.ListOffersInput{
+		ModelName: modelName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list offers, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju offers of model %q data source", modelName))
+
+	// Save data into Terraform state
+	data.Offers = make([]nestedDataSourceOffer, 0, len(offers))
+	for _, offer := range offers {
+		endpoints, dErr := types.SetValueFrom(ctx, types.StringType, offer.Endpoints)
+		resp.Diagnostics.Append(dErr...)
+		endpointURLs, dErr := offerEndpointURLsValue(ctx, offer.OfferURL, offer.Endpoints)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Offers = append(data.Offers, nestedDataSourceOffer{
+			OfferName:       types.StringValue(offer.Name),
+			OfferURL:        types.StringValue(offer.OfferURL),
+			ApplicationName: types.StringValue(offer.ApplicationName),
+			Endpoints:       endpoints,
+			EndpointURLs:    endpointURLs,
+			ConnectionCount: types.Int64Value(int64(len(offer.Connections))),
+		})
+	}
+	data.ID = types.StringValue(modelName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *offersDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-offers", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-offers","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceOffers, msg, additionalFields...)
+}
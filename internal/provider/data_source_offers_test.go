@@ -0,0 +1,66 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceOffers(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-offers-test-model")
+	// ...-test-[0-9]+ is not a valid offer name, need to remove the dash before numbers
+	offerName := fmt.Sprintf("tf-datasource-offers-test%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOffers(modelName, offerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_offers.this", "offers.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_offers.this", "offers.0.name", offerName),
+					resource.TestCheckResourceAttr("data.juju_offers.this", "offers.0.application_name", "this"),
+					resource.TestCheckResourceAttrPair("data.juju_offers.this", "offers.0.url", "juju_offer.this", "url"),
+					resource.TestCheckResourceAttr("data.juju_offers.this", "offers.0.connection_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOffers(modelName, offerName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "this" {
+	model = juju_model.this.name
+	name  = "this"
+
+	charm {
+		name = "postgresql"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "this" {
+	model            = juju_model.this.name
+	application_name = juju_application.this.name
+	endpoint         = "db"
+	name             = %q
+}
+
+data "juju_offers" "this" {
+	model = juju_model.this.name
+
+	depends_on = [juju_offer.this]
+}
+`, modelName, offerName)
+}
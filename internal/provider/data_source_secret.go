@@ -0,0 +1,209 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &secretDataSource{}
+
+func NewSecretDataSource() datasource.DataSource {
+	return &secretDataSource{}
+}
+
+type secretDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// secretDataSourceModel is the juju data stored by terraform.
+// tfsdk must match secret data source schema attribute names.
+type secretDataSourceModel struct {
+	Model          types.String `tfsdk:"model"`
+	SecretId       types.String `tfsdk:"secret_id"`
+	Label          types.String `tfsdk:"label"`
+	Description    types.String `tfsdk:"description"`
+	Owner          types.String `tfsdk:"owner"`
+	Revision       types.Int64  `tfsdk:"revision"`
+	RotatePolicy   types.String `tfsdk:"rotate_policy"`
+	NextRotateTime types.String `tfsdk:"next_rotate_time"`
+	ExpireTime     types.String `tfsdk:"expire_time"`
+	CreateTime     types.String `tfsdk:"create_time"`
+	UpdateTime     types.String `tfsdk:"update_time"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *secretDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (d *secretDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the metadata of a Juju Secret, found by its ID or label. " +
+			"The content of the secret is not read.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name or UUID of the model of the secret.",
+				Required:    true,
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "The URI of the secret, e.g. `secret:coj8mulh8b41e8nv6p90`. Either the ID or " +
+					"the label of the secret is required.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("label"),
+					}...),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The label of the secret.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the secret.",
+				Computed:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "The tag of the owner of the secret, e.g. `application-postgresql`.",
+				Computed:    true,
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The latest revision of the secret.",
+				Computed:    true,
+			},
+			"rotate_policy": schema.StringAttribute{
+				Description: "How often the secret is rotated, e.g. `daily`, empty when it is not rotated.",
+				Computed:    true,
+			},
+			"next_rotate_time": schema.StringAttribute{
+				Description: "When the secret is next rotated, in RFC3339 format.",
+				Computed:    true,
+			},
+			"expire_time": schema.StringAttribute{
+				Description: "When the latest revision of the secret expires, in RFC3339 format.",
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Description: "When the secret was created, in RFC3339 format.",
+				Computed:    true,
+			},
+			"update_time": schema.StringAttribute{
+				Description: "When the secret was last updated, in RFC3339 format.",
+				Computed:    true,
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *secretDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceSecret)
+}
+
+func (d *secretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "secret")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "secret")
+		return
+	}
+	resp.Diagnostics.Append(checkJujuVersion(d.client, "Secrets", secretsJujuVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data secretDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := d.client.Secrets.ReadSecret(juju.ReadSecretInput{
+		ModelName: data.Model.ValueString(),
+		SecretId:  data.SecretId.ValueString(),
+		Label:     data.Label.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju secret %q data source", secret.SecretId))
+
+	// Save data into Terraform state
+	data.SecretId = types.StringValue(secret.SecretId)
+	data.Label = types.StringValue(secret.Label)
+	data.Description = types.StringValue(secret.Description)
+	data.Owner = types.StringValue(secret.Owner)
+	data.Revision = types.Int64Value(int64(secret.Revision))
+	data.RotatePolicy = types.StringValue(secret.RotatePolicy)
+	data.NextRotateTime = secretTimeValue(secret.NextRotateTime)
+	data.ExpireTime = secretTimeValue(secret.ExpireTime)
+	data.CreateTime = secretTimeValue(&secret.CreateTime)
+	data.UpdateTime = secretTimeValue(&secret.UpdateTime)
+	data.ID = types.StringValue(secret.SecretId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// secretTimeValue returns the time in RFC3339 format, an empty string
+// if it is not set.
+func secretTimeValue(t *time.Time) types.String {
+	if t == nil || t.IsZero() {
+		return types.StringValue("")
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+func (d *secretDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-secret", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-secret","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceSecret, msg, additionalFields...)
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceSecret(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-secret-test-model")
+
+	// User secrets cannot be added with the provider, the model and
+	// the secret are added out of band.
+	secretURI := testAccCreateModelWithSecret(t, modelName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecretByLabel(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_secret.tls", "secret_id", secretURI),
+					resource.TestCheckResourceAttr("data.juju_secret.tls", "revision", "1"),
+					resource.TestCheckResourceAttrSet("data.juju_secret.tls", "create_time"),
+				),
+			},
+			{
+				Config: testAccDataSourceSecretByID(modelName, secretURI),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_secret.tls", "label", "tls"),
+					resource.TestCheckResourceAttr("data.juju_secret.tls", "id", secretURI),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecretByLabel(modelName string) string {
+	return fmt.Sprintf(`
+data "juju_secret" "tls" {
+  model = %q
+  label = "tls"
+}`, modelName)
+}
+
+func testAccDataSourceSecretByID(modelName, secretURI string) string {
+	return fmt.Sprintf(`
+data "juju_secret" "tls" {
+  model     = %q
+  secret_id = %q
+}`, modelName, secretURI)
+}
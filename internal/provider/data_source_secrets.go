@@ -0,0 +1,209 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &secretsDataSource{}
+
+func NewSecretsDataSource() datasource.DataSource {
+	return &secretsDataSource{}
+}
+
+type secretsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// secretsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match secrets data source schema attribute names.
+type secretsDataSourceModel struct {
+	Model   types.String `tfsdk:"model"`
+	Owner   types.String `tfsdk:"owner"`
+	Secrets types.List   `tfsdk:"secrets"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+var secretsDataSourceSecretType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"secret_id":        types.StringType,
+		"label":            types.StringType,
+		"description":      types.StringType,
+		"owner":            types.StringType,
+		"revision":         types.Int64Type,
+		"rotate_policy":    types.StringType,
+		"next_rotate_time": types.StringType,
+		"expire_time":      types.StringType,
+	},
+}
+
+func (d *secretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+func (d *secretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the metadata of the secrets of a Juju Model. The content of " +
+			"the secrets is not read.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name or UUID of the model.",
+				Required:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "Only list the secrets of the owner, given by its tag, e.g. `application-postgresql`.",
+				Optional:    true,
+			},
+			"secrets": schema.ListNestedAttribute{
+				Description: "The secrets of the model, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"secret_id": schema.StringAttribute{
+							Description: "The URI of the secret.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The label of the secret.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the secret.",
+							Computed:    true,
+						},
+						"owner": schema.StringAttribute{
+							Description: "The tag of the owner of the secret.",
+							Computed:    true,
+						},
+						"revision": schema.Int64Attribute{
+							Description: "The latest revision of the secret.",
+							Computed:    true,
+						},
+						"rotate_policy": schema.StringAttribute{
+							Description: "How often the secret is rotated, empty when it is not rotated.",
+							Computed:    true,
+						},
+						"next_rotate_time": schema.StringAttribute{
+							Description: "When the secret is next rotated, in RFC3339 format.",
+							Computed:    true,
+						},
+						"expire_time": schema.StringAttribute{
+							Description: "When the latest revision of the secret expires, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *secretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceSecrets)
+}
+
+func (d *secretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "secrets")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "secrets")
+		return
+	}
+	resp.Diagnostics.Append(checkJujuVersion(d.client, "Secrets", secretsJujuVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data secretsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.Model.ValueString()
+	secrets, err := d.client.Secrets.ListSecrets(juju.ListSecretsInput{
+		ModelName: modelName,
+		Owner:     data.Owner.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list secrets, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju secrets of model %q data source", modelName))
+
+	// Save data into Terraform state
+	values := make([]attr.Value, 0, len(secrets))
+	for _, secret := range secrets {
+		value, dErr := types.ObjectValue(secretsDataSourceSecretType.AttrTypes, map[string]attr.Value{
+			"secret_id":        types.StringValue(secret.SecretId),
+			"label":            types.StringValue(secret.Label),
+			"description":      types.StringValue(secret.Description),
+			"owner":            types.StringValue(secret.Owner),
+			"revision":         types.Int64Value(int64(secret.Revision)),
+			"rotate_policy":    types.StringValue(secret.RotatePolicy),
+			"next_rotate_time": secretTimeValue(secret.NextRotateTime),
+			"expire_time":      secretTimeValue(secret.ExpireTime),
+		})
+		resp.Diagnostics.Append(dErr...)
+		values = append(values, value)
+	}
+	secretsValue, dErr := types.ListValue(secretsDataSourceSecretType, values)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Secrets = secretsValue
+	data.ID = types.StringValue(modelName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *secretsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-secrets", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-secrets","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceSecrets, msg, additionalFields...)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceSecrets(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-secrets-test-model")
+
+	// User secrets cannot be added with the provider, the model and
+	// the secret are added out of band.
+	secretURI := testAccCreateModelWithSecret(t, modelName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecrets(modelName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_secrets.all", "secrets.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_secrets.all", "secrets.0.secret_id", secretURI),
+					resource.TestCheckResourceAttr("data.juju_secrets.all", "secrets.0.label", "tls"),
+				),
+			},
+			{
+				Config: testAccDataSourceSecrets(modelName, "application-postgresql"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_secrets.all", "secrets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecrets(modelName, owner string) string {
+	return fmt.Sprintf(`
+data "juju_secrets" "all" {
+  model = %q
+  owner = %q != "" ? %[2]q : null
+}`, modelName, owner)
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &spacesDataSource{}
+
+func NewSpacesDataSource() datasource.DataSource {
+	return &spacesDataSource{}
+}
+
+type spacesDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// spacesDataSourceModel is the juju data stored by terraform.
+// tfsdk must match spaces data source schema attribute names.
+type spacesDataSourceModel struct {
+	ModelName types.String            `tfsdk:"model"`
+	Spaces    []nestedDataSourceSpace `tfsdk:"spaces"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceSpace represents an element of the spaces list of
+// the spaces data source.
+type nestedDataSourceSpace struct {
+	Name    types.String `tfsdk:"name"`
+	Subnets types.Set    `tfsdk:"subnets"`
+}
+
+func (d *spacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spaces"
+}
+
+func (d *spacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the network spaces of a model, as `juju spaces` does.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model.",
+				Required:    true,
+			},
+			"spaces": schema.ListNestedAttribute{
+				Description: "The spaces of the model, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the space.",
+							Computed:    true,
+						},
+						"subnets": schema.SetAttribute{
+							Description: "The CIDRs of the subnets in the space.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *spacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceSpaces)
+}
+
+func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "spaces")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "spaces")
+		return
+	}
+
+	var data spacesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	spaces, err := d.client.Spaces.ListSpaces(juju.ListSpacesInput{
+		ModelName: modelName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list spaces, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju spaces of model %q data source", modelName))
+
+	// Save data into Terraform state
+	data.Spaces = make([]nestedDataSourceSpace, 0, len(spaces))
+	for _, space := range spaces {
+		subnets, dErr := types.SetValueFrom(ctx, types.StringType, space.Subnets)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Spaces = append(data.Spaces, nestedDataSourceSpace{
+			Name:    types.StringValue(space.Name),
+			Subnets: subnets,
+		})
+	}
+	data.ID = types.StringValue(modelName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *spacesDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-spaces", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-spaces","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceSpaces, msg, additionalFields...)
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceSpaces(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-spaces-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSpaces(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_spaces.this", "spaces.#", "2"),
+					resource.TestCheckResourceAttr("data.juju_spaces.this", "spaces.0.name", "alpha"),
+					resource.TestCheckResourceAttr("data.juju_spaces.this", "spaces.1.name", "public"),
+					resource.TestCheckResourceAttr("data.juju_spaces.this", "spaces.1.subnets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSpaces(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_space" "public" {
+  model = juju_model.this.name
+  name  = "public"
+}
+
+data "juju_spaces" "this" {
+  model = juju_model.this.name
+
+  depends_on = [juju_space.public]
+}`, modelName)
+}
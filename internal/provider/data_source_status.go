@@ -0,0 +1,224 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &statusDataSource{}
+
+func NewStatusDataSource() datasource.DataSource {
+	return &statusDataSource{}
+}
+
+type statusDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// statusDataSourceModel is the juju data stored by terraform.
+// tfsdk must match status data source schema attribute names.
+type statusDataSourceModel struct {
+	ModelName       types.String `tfsdk:"model"`
+	ApplicationName types.String `tfsdk:"application_name"`
+	Status          types.String `tfsdk:"status"`
+	Message         types.String `tfsdk:"message"`
+	Units           types.List   `tfsdk:"units"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+var statusDataSourceUnitType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":             types.StringType,
+		"machine":          types.StringType,
+		"leader":           types.BoolType,
+		"workload_status":  types.StringType,
+		"workload_message": types.StringType,
+		"agent_status":     types.StringType,
+		"agent_message":    types.StringType,
+		"public_address":   types.StringType,
+		"private_address":  types.StringType,
+	},
+}
+
+func (d *statusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status"
+}
+
+func (d *statusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the status of a Juju Application and of its units, as " +
+			"`juju status` does. It can be used in postconditions to check the health of an application.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the application.",
+				Required:    true,
+			},
+			"application_name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the application, e.g. `active` or `blocked`.",
+				Computed:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "The status message of the application.",
+				Computed:    true,
+			},
+			"units": schema.ListNestedAttribute{
+				Description: "The status of the units of the application, sorted by unit number.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the unit, e.g. `postgresql/0`.",
+							Computed:    true,
+						},
+						"machine": schema.StringAttribute{
+							Description: "The ID of the machine the unit runs on.",
+							Computed:    true,
+						},
+						"leader": schema.BoolAttribute{
+							Description: "Whether the unit is the leader of the application.",
+							Computed:    true,
+						},
+						"workload_status": schema.StringAttribute{
+							Description: "The workload status of the unit, e.g. `active`.",
+							Computed:    true,
+						},
+						"workload_message": schema.StringAttribute{
+							Description: "The workload status message of the unit.",
+							Computed:    true,
+						},
+						"agent_status": schema.StringAttribute{
+							Description: "The agent status of the unit, e.g. `idle`.",
+							Computed:    true,
+						},
+						"agent_message": schema.StringAttribute{
+							Description: "The agent status message of the unit.",
+							Computed:    true,
+						},
+						"public_address": schema.StringAttribute{
+							Description: "The public address of the unit.",
+							Computed:    true,
+						},
+						"private_address": schema.StringAttribute{
+							Description: "The private address of the unit.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *statusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceStatus)
+}
+
+func (d *statusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "status")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "status")
+		return
+	}
+
+	var data statusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	appName := data.ApplicationName.ValueString()
+	status, err := d.client.Applications.ReadApplicationStatus(&juju.ReadApplicationStatusInput{
+		ModelName: modelName,
+		AppName:   appName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application status, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju status of application %q data source", appName))
+
+	// Save data into Terraform state
+	units := make([]attr.Value, 0, len(status.Units))
+	for _, unit := range status.Units {
+		value, dErr := types.ObjectValue(statusDataSourceUnitType.AttrTypes, map[string]attr.Value{
+			"name":             types.StringValue(unit.Name),
+			"machine":          types.StringValue(unit.Machine),
+			"leader":           types.BoolValue(unit.Leader),
+			"workload_status":  types.StringValue(unit.WorkloadStatus),
+			"workload_message": types.StringValue(unit.WorkloadMessage),
+			"agent_status":     types.StringValue(unit.AgentStatus),
+			"agent_message":    types.StringValue(unit.AgentMessage),
+			"public_address":   types.StringValue(unit.PublicAddress),
+			"private_address":  types.StringValue(unit.PrivateAddress),
+		})
+		resp.Diagnostics.Append(dErr...)
+		units = append(units, value)
+	}
+	unitsValue, dErr := types.ListValue(statusDataSourceUnitType, units)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Status = types.StringValue(status.Status)
+	data.Message = types.StringValue(status.Message)
+	data.Units = unitsValue
+	data.ID = types.StringValue(newAppID(modelName, appName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *statusDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-status", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-status","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceStatus, msg, additionalFields...)
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceStatus(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-status-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStatus(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_status.this", "units.#", "2"),
+					resource.TestCheckResourceAttr("data.juju_status.this", "units.0.name", "this/0"),
+					resource.TestCheckResourceAttr("data.juju_status.this", "units.1.name", "this/1"),
+					resource.TestCheckResourceAttrSet("data.juju_status.this", "units.0.agent_status"),
+					resource.TestCheckResourceAttrSet("data.juju_status.this", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStatus(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "this"
+  units = 2
+
+  charm {
+    name = "jameinel-ubuntu-lite"
+  }
+}
+
+data "juju_status" "this" {
+  model            = juju_model.this.name
+  application_name = juju_application.this.name
+}`, modelName)
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &storagePoolsDataSource{}
+
+func NewStoragePoolsDataSource() datasource.DataSource {
+	return &storagePoolsDataSource{}
+}
+
+type storagePoolsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// storagePoolsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match storage pools data source schema attribute names.
+type storagePoolsDataSourceModel struct {
+	ModelName       types.String                  `tfsdk:"model"`
+	StorageProvider types.String                  `tfsdk:"storage_provider"`
+	Pools           []nestedDataSourceStoragePool `tfsdk:"pools"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceStoragePool represents an element of the pools list
+// of the storage pools data source.
+type nestedDataSourceStoragePool struct {
+	Name            types.String `tfsdk:"name"`
+	StorageProvider types.String `tfsdk:"storage_provider"`
+	Attributes      types.Map    `tfsdk:"attributes"`
+}
+
+func (d *storagePoolsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_pools"
+}
+
+func (d *storagePoolsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the storage pools of a model, as `juju storage-pools` does.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model.",
+				Required:    true,
+			},
+			"storage_provider": schema.StringAttribute{
+				Description: "Only list the pools of the storage provider, e.g. `ebs` or `lxd`.",
+				Optional:    true,
+			},
+			"pools": schema.ListNestedAttribute{
+				Description: "The storage pools of the model, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the pool.",
+							Computed:    true,
+						},
+						"storage_provider": schema.StringAttribute{
+							Description: "The storage provider of the pool.",
+							Computed:    true,
+						},
+						"attributes": schema.MapAttribute{
+							Description: "The attributes of the pool.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *storagePoolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceStoragePools)
+}
+
+func (d *storagePoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "storage_pools")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "storage_pools")
+		return
+	}
+
+	var data storagePoolsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	var providers []string
+	if provider := data.StorageProvider.ValueString(); provider != "" {
+		providers = []string{provider}
+	}
+	pools, err := d.client.Storage.ListStoragePools(juju.ListStoragePoolsInput{
+		ModelName: modelName,
+		Providers: providers,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list storage pools, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju storage pools of model %q data source", modelName))
+
+	// Save data into Terraform state
+	data.Pools = make([]nestedDataSourceStoragePool, 0, len(pools))
+	for _, pool := range pools {
+		attributes, dErr := types.MapValueFrom(ctx, types.StringType, pool.Attributes)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Pools = append(data.Pools, nestedDataSourceStoragePool{
+			Name:            types.StringValue(pool.Name),
+			StorageProvider: types.StringValue(pool.Provider),
+			Attributes:      attributes,
+		})
+	}
+	data.ID = types.StringValue(modelName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *storagePoolsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-storage-pools", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-storage-pools","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceStoragePools, msg, additionalFields...)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceStoragePools(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-storage-pools-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStoragePools(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_storage_pools.all", "pools.*", map[string]string{
+						"name":             "tmpfs",
+						"storage_provider": "tmpfs",
+					}),
+					resource.TestCheckResourceAttr("data.juju_storage_pools.lxd", "pools.0.storage_provider", "lxd"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStoragePools(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+data "juju_storage_pools" "all" {
+  model = juju_model.this.name
+}
+
+data "juju_storage_pools" "lxd" {
+  model            = juju_model.this.name
+  storage_provider = "lxd"
+}`, modelName)
+}
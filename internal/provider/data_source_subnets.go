@@ -0,0 +1,189 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &subnetsDataSource{}
+
+func NewSubnetsDataSource() datasource.DataSource {
+	return &subnetsDataSource{}
+}
+
+type subnetsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// subnetsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match subnets data source schema attribute names.
+type subnetsDataSourceModel struct {
+	ModelName types.String             `tfsdk:"model"`
+	Space     types.String             `tfsdk:"space"`
+	Zone      types.String             `tfsdk:"zone"`
+	Subnets   []nestedDataSourceSubnet `tfsdk:"subnets"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceSubnet represents an element of the subnets list of
+// the subnets data source.
+type nestedDataSourceSubnet struct {
+	CIDR       types.String `tfsdk:"cidr"`
+	Space      types.String `tfsdk:"space"`
+	ProviderID types.String `tfsdk:"provider_id"`
+	VLANTag    types.Int64  `tfsdk:"vlan_tag"`
+	Zones      types.Set    `tfsdk:"zones"`
+}
+
+func (d *subnetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnets"
+}
+
+func (d *subnetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the subnets known to a model, as `juju subnets` does.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model.",
+				Required:    true,
+			},
+			"space": schema.StringAttribute{
+				Description: "Only list the subnets of the space.",
+				Optional:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Only list the subnets of the availability zone.",
+				Optional:    true,
+			},
+			"subnets": schema.ListNestedAttribute{
+				Description: "The subnets of the model, sorted by CIDR.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							Description: "The CIDR of the subnet.",
+							Computed:    true,
+						},
+						"space": schema.StringAttribute{
+							Description: "The name of the space of the subnet.",
+							Computed:    true,
+						},
+						"provider_id": schema.StringAttribute{
+							Description: "The identifier of the subnet in the cloud provider.",
+							Computed:    true,
+						},
+						"vlan_tag": schema.Int64Attribute{
+							Description: "The VLAN tag of the subnet, 0 when it is not a VLAN.",
+							Computed:    true,
+						},
+						"zones": schema.SetAttribute{
+							Description: "The availability zones of the subnet.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *subnetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceSubnets)
+}
+
+func (d *subnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "subnets")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "subnets")
+		return
+	}
+
+	var data subnetsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.ModelName.ValueString()
+	subnets, err := d.client.Spaces.ListSubnets(juju.ListSubnetsInput{
+		ModelName: modelName,
+		Space:     data.Space.ValueString(),
+		Zone:      data.Zone.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list subnets, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju subnets of model %q data source", modelName))
+
+	// Save data into Terraform state
+	data.Subnets = make([]nestedDataSourceSubnet, 0, len(subnets))
+	for _, subnet := range subnets {
+		zones, dErr := types.SetValueFrom(ctx, types.StringType, subnet.Zones)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Subnets = append(data.Subnets, nestedDataSourceSubnet{
+			CIDR:       types.StringValue(subnet.CIDR),
+			Space:      types.StringValue(subnet.Space),
+			ProviderID: types.StringValue(subnet.ProviderId),
+			VLANTag:    types.Int64Value(int64(subnet.VLANTag)),
+			Zones:      zones,
+		})
+	}
+	data.ID = types.StringValue(modelName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *subnetsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-subnets", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-subnets","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceSubnets, msg, additionalFields...)
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceSubnets(t *testing.T) {
+	// The subnet is discovered from the cloud provider, it has to be
+	// known beforehand.
+	cidr := os.Getenv("TEST_SUBNET_CIDR")
+	if cidr == "" {
+		t.Skip(t.Name() + " requires TEST_SUBNET_CIDR to be set")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-subnets-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSubnets(modelName, cidr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_subnets.one", "subnets.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_subnets.one", "subnets.0.cidr", cidr),
+					resource.TestCheckResourceAttr("data.juju_subnets.one", "subnets.0.space", "one"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_subnets.all", "subnets.*", map[string]string{
+						"cidr": cidr,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSubnets(modelName, cidr string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_space" "one" {
+  model = juju_model.test.name
+  name  = "one"
+}
+
+resource "juju_subnet" "test" {
+  model = juju_model.test.name
+  cidr  = %q
+  space = juju_space.one.name
+}
+
+data "juju_subnets" "all" {
+  model = juju_model.test.name
+
+  depends_on = [juju_subnet.test]
+}
+
+data "juju_subnets" "one" {
+  model = juju_model.test.name
+  space = juju_space.one.name
+
+  depends_on = [juju_subnet.test]
+}`, modelName, cidr)
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &usersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// usersDataSourceModel is the juju data stored by terraform.
+// tfsdk must match users data source schema attribute names.
+type usersDataSourceModel struct {
+	IncludeDisabled types.Bool             `tfsdk:"include_disabled"`
+	Users           []nestedDataSourceUser `tfsdk:"users"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedDataSourceUser represents an element of the users list of the
+// users data source.
+type nestedDataSourceUser struct {
+	Name           types.String `tfsdk:"name"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	Access         types.String `tfsdk:"access"`
+	CreatedBy      types.String `tfsdk:"created_by"`
+	DateCreated    types.String `tfsdk:"date_created"`
+	LastConnection types.String `tfsdk:"last_connection"`
+	Disabled       types.Bool   `tfsdk:"disabled"`
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the users of the controller, as `juju users` does.",
+		Attributes: map[string]schema.Attribute{
+			"include_disabled": schema.BoolAttribute{
+				Description: "Whether disabled users are listed too. Defaults to false.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users of the controller, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the user.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the user.",
+							Computed:    true,
+						},
+						"access": schema.StringAttribute{
+							Description: "The access of the user to the controller, e.g. `login` or `superuser`.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "The user who created the user.",
+							Computed:    true,
+						},
+						"date_created": schema.StringAttribute{
+							Description: "When the user was created, in RFC3339 format.",
+							Computed:    true,
+						},
+						"last_connection": schema.StringAttribute{
+							Description: "When the user last connected, in RFC3339 format, empty if the user " +
+								"never connected.",
+							Computed: true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Whether the user is disabled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceUsers)
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "users")
+		return
+	}
+	if d.client.Offline {
+		addDSOfflineWarning(&resp.Diagnostics, "users")
+		return
+	}
+
+	var data usersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Users.ListUsers(juju.ListUsersInput{
+		IncludeDisabled: data.IncludeDisabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+		return
+	}
+	d.trace("read juju users data source")
+
+	// Save data into Terraform state
+	data.Users = make([]nestedDataSourceUser, 0, len(response.Users))
+	for _, user := range response.Users {
+		lastConnection := ""
+		if user.LastConnection != nil {
+			lastConnection = user.LastConnection.Format(time.RFC3339)
+		}
+		data.Users = append(data.Users, nestedDataSourceUser{
+			Name:           types.StringValue(user.Username),
+			DisplayName:    types.StringValue(user.DisplayName),
+			Access:         types.StringValue(user.Access),
+			CreatedBy:      types.StringValue(user.CreatedBy),
+			DateCreated:    types.StringValue(user.DateCreated.Format(time.RFC3339)),
+			LastConnection: types.StringValue(lastConnection),
+			Disabled:       types.BoolValue(user.Disabled),
+		})
+	}
+	data.ID = types.StringValue("users")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *usersDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-users", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-users","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceUsers, msg, additionalFields...)
+}
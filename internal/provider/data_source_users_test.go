@@ -0,0 +1,51 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceUsers(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUsers(userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_users.all", "users.*", map[string]string{
+						"name":         userName,
+						"display_name": "Test User",
+						"access":       "login",
+						"disabled":     "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_users.all", "users.*", map[string]string{
+						"name":   "admin",
+						"access": "superuser",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUsers(userName string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "test" {
+  name         = %q
+  display_name = "Test User"
+  password     = "password"
+}
+
+data "juju_users" "all" {
+  depends_on = [juju_user.test]
+}`, userName)
+}
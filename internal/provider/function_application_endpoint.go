@@ -0,0 +1,62 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &applicationEndpointFunction{}
+
+func NewApplicationEndpointFunction() function.Function {
+	return &applicationEndpointFunction{}
+}
+
+// applicationEndpointFunction returns the endpoint string of an
+// application, e.g. to name the endpoint of an offer.
+type applicationEndpointFunction struct{}
+
+func (f *applicationEndpointFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "application_endpoint"
+}
+
+func (f *applicationEndpointFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build an application endpoint",
+		Description: "Returns the endpoint of an application, `<application>:<endpoint>`, after validating each of its parts.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "application",
+				Description: "The name of the application.",
+			},
+			function.StringParameter{
+				Name:        "endpoint",
+				Description: "The name of the endpoint, e.g. `db`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *applicationEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var application, endpoint string
+	resp.Error = req.Arguments.Get(ctx, &application, &endpoint)
+	if resp.Error != nil {
+		return
+	}
+
+	if rule := applicationNameRule(application); rule != "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid application name %q, it %s", application, rule))
+		return
+	}
+	if rule := endpointNameRule(endpoint); rule != "" {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid endpoint name %q, it %s", endpoint, rule))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, fmt.Sprintf("%s:%s", application, endpoint))
+}
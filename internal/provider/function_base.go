@@ -0,0 +1,65 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/juju/juju/core/base"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &baseFunction{}
+
+func NewBaseFunction() function.Function {
+	return &baseFunction{}
+}
+
+// baseFunction returns a base from its operating system and channel,
+// e.g. to deploy the applications of a module on the same base.
+type baseFunction struct{}
+
+func (f *baseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "base"
+}
+
+func (f *baseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a base",
+		Description: "Returns the base of an operating system and channel, `<os>@<channel>`, as the `base` " +
+			"attributes of applications and machines expect, e.g. `ubuntu@22.04`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "os",
+				Description: "The operating system, e.g. `ubuntu`.",
+			},
+			function.StringParameter{
+				Name:        "channel",
+				Description: "The channel of the operating system, e.g. `22.04`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *baseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var osName, channel string
+	resp.Error = req.Arguments.Get(ctx, &osName, &channel)
+	if resp.Error != nil {
+		return
+	}
+
+	if osName == "" {
+		resp.Error = function.NewArgumentFuncError(0, "Missing operating system of the base")
+		return
+	}
+	parsed, err := base.ParseBase(osName, channel)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Invalid base %s@%s, got error: %s", osName, channel, err))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, fmt.Sprintf("%s@%s", parsed.OS, parsed.Channel.DisplayString()))
+}
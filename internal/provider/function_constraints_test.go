@@ -0,0 +1,87 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runFunction(f function.Function, args ...string) (attr.Value, *function.FuncError) {
+	ctx := context.Background()
+	values := make([]attr.Value, 0, len(args))
+	for _, arg := range args {
+		values = append(values, types.StringValue(arg))
+	}
+	var definition function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+	resp := function.RunResponse{
+		Result: function.NewResultData(definition.Definition.Return.GetType().ValueType(ctx)),
+	}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(values)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseConstraintsFunction(t *testing.T) {
+	value, funcErr := runFunction(NewParseConstraintsFunction(), "mem=16G cores=4 zones=a,b allocate-public-ip=true")
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	object, ok := value.(types.Object)
+	if !ok {
+		t.Fatalf("expected an object, got %T", value)
+	}
+	attributes := object.Attributes()
+	expected := map[string]attr.Value{
+		"mem":                types.Int64Value(16384),
+		"cores":              types.Int64Value(4),
+		"zones":              types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+		"allocate_public_ip": types.BoolValue(true),
+		"arch":               types.StringNull(),
+		"tags":               types.ListNull(types.StringType),
+	}
+	for name, want := range expected {
+		if got := attributes[name]; !want.Equal(got) {
+			t.Errorf("attribute %q: expected %s, got %s", name, want, got)
+		}
+	}
+
+	if _, funcErr := runFunction(NewParseConstraintsFunction(), "mem=lots"); funcErr == nil {
+		t.Error("expected an error parsing invalid constraints")
+	}
+}
+
+func TestMergeConstraintsFunction(t *testing.T) {
+	tests := []struct {
+		defaults, overrides string
+		merged              string
+		err                 string
+	}{
+		{defaults: "mem=4G cores=2", overrides: "mem=16G", merged: "cores=2 mem=16384M"},
+		{defaults: "mem=4G zones=a", overrides: "", merged: "mem=4096M zones=a"},
+		{defaults: "mem=4G cores=2 root-disk=8G", overrides: "instance-type=m5.large", merged: "instance-type=m5.large root-disk=8192M"},
+		{defaults: "mem=4G", overrides: "mem=lots", err: "Unable to parse constraints"},
+	}
+	for _, test := range tests {
+		value, funcErr := runFunction(NewMergeConstraintsFunction(), test.defaults, test.overrides)
+		if test.err != "" {
+			if funcErr == nil || !strings.Contains(funcErr.Error(), test.err) {
+				t.Errorf("merging %q and %q: expected error %q, got %v", test.defaults, test.overrides, test.err, funcErr)
+			}
+			continue
+		}
+		if funcErr != nil {
+			t.Errorf("merging %q and %q: unexpected error: %s", test.defaults, test.overrides, funcErr)
+			continue
+		}
+		if got := value.(types.String).ValueString(); got != test.merged {
+			t.Errorf("merging %q and %q: expected %q, got %q", test.defaults, test.overrides, test.merged, got)
+		}
+	}
+}
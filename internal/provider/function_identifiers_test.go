@@ -0,0 +1,127 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildIdentifierFunctions(t *testing.T) {
+	tests := []struct {
+		function func() function.Function
+		args     []string
+		expected string
+	}{
+		{function: NewOfferURLFunction, args: []string{"admin", "development", "mysql"}, expected: "admin/development.mysql"},
+		{function: NewOfferURLFunction, args: []string{"admin", "Development", "mysql"}},
+		{function: NewOfferURLFunction, args: []string{"admin", "development", "mysql:db"}},
+		{function: NewApplicationEndpointFunction, args: []string{"mysql", "db"}, expected: "mysql:db"},
+		{function: NewApplicationEndpointFunction, args: []string{"mysql", "juju-info"}, expected: "mysql:juju-info"},
+		{function: NewApplicationEndpointFunction, args: []string{"mysql", ""}},
+		{function: NewApplicationEndpointFunction, args: []string{"my_sql", "db"}},
+		{function: NewBaseFunction, args: []string{"ubuntu", "22.04"}, expected: "ubuntu@22.04"},
+		{function: NewBaseFunction, args: []string{"ubuntu", "22.04/edge"}, expected: "ubuntu@22.04/edge"},
+		{function: NewBaseFunction, args: []string{"ubuntu", ""}},
+		{function: NewBaseFunction, args: []string{"", ""}},
+	}
+	for _, test := range tests {
+		value, funcErr := runFunction(test.function(), test.args...)
+		if test.expected == "" {
+			if funcErr == nil {
+				t.Errorf("%q: expected an error, got %s", test.args, value)
+			}
+			continue
+		}
+		if funcErr != nil {
+			t.Errorf("%q: unexpected error: %s", test.args, funcErr)
+			continue
+		}
+		if got := value.(types.String).ValueString(); got != test.expected {
+			t.Errorf("%q: expected %q, got %q", test.args, test.expected, got)
+		}
+	}
+}
+
+func TestParseIdentifierFunctions(t *testing.T) {
+	tests := []struct {
+		function func() function.Function
+		arg      string
+		expected map[string]attr.Value
+	}{{
+		function: NewParseOfferURLFunction,
+		arg:      "admin/development.mysql",
+		expected: map[string]attr.Value{
+			"controller": types.StringNull(),
+			"user":       types.StringValue("admin"),
+			"model":      types.StringValue("development"),
+			"offer":      types.StringValue("mysql"),
+			"endpoint":   types.StringNull(),
+		},
+	}, {
+		function: NewParseOfferURLFunction,
+		arg:      "prod:admin/development.mysql:db",
+		expected: map[string]attr.Value{
+			"controller": types.StringValue("prod"),
+			"user":       types.StringValue("admin"),
+			"model":      types.StringValue("development"),
+			"offer":      types.StringValue("mysql"),
+			"endpoint":   types.StringValue("db"),
+		},
+	}, {
+		function: NewParseOfferURLFunction,
+		arg:      "admin/development",
+	}, {
+		function: NewParseApplicationEndpointFunction,
+		arg:      "mysql:db",
+		expected: map[string]attr.Value{
+			"application": types.StringValue("mysql"),
+			"endpoint":    types.StringValue("db"),
+		},
+	}, {
+		function: NewParseApplicationEndpointFunction,
+		arg:      "mysql",
+		expected: map[string]attr.Value{
+			"application": types.StringValue("mysql"),
+			"endpoint":    types.StringNull(),
+		},
+	}, {
+		function: NewParseApplicationEndpointFunction,
+		arg:      "mysql:",
+	}, {
+		function: NewParseBaseFunction,
+		arg:      "ubuntu@22.04",
+		expected: map[string]attr.Value{
+			"os":      types.StringValue("ubuntu"),
+			"channel": types.StringValue("22.04"),
+			"track":   types.StringValue("22.04"),
+			"risk":    types.StringValue("stable"),
+		},
+	}, {
+		function: NewParseBaseFunction,
+		arg:      "jammy",
+	}}
+	for _, test := range tests {
+		value, funcErr := runFunction(test.function(), test.arg)
+		if test.expected == nil {
+			if funcErr == nil {
+				t.Errorf("%q: expected an error, got %s", test.arg, value)
+			}
+			continue
+		}
+		if funcErr != nil {
+			t.Errorf("%q: unexpected error: %s", test.arg, funcErr)
+			continue
+		}
+		attributes := value.(types.Object).Attributes()
+		for name, want := range test.expected {
+			if got := attributes[name]; !want.Equal(got) {
+				t.Errorf("%q: attribute %q: expected %s, got %s", test.arg, name, want, got)
+			}
+		}
+	}
+}
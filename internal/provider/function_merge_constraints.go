@@ -0,0 +1,75 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/juju/juju/core/constraints"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &mergeConstraintsFunction{}
+
+func NewMergeConstraintsFunction() function.Function {
+	return &mergeConstraintsFunction{}
+}
+
+// mergeConstraintsFunction merges overriding constraints into default
+// constraints, e.g. to override the constraints of a module.
+type mergeConstraintsFunction struct{}
+
+func (f *mergeConstraintsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_constraints"
+}
+
+func (f *mergeConstraintsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge juju constraints",
+		Description: "Returns the default constraints overridden by the overriding constraints, as juju merges " +
+			"model and application constraints. The default constraints the instance type sets, e.g. `mem`, " +
+			"are dropped when the overriding constraints set `instance-type`, and the other way around.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "defaults",
+				Description: "The default constraints, e.g. `mem=4G cores=2`.",
+			},
+			function.StringParameter{
+				Name:        "overrides",
+				Description: "The overriding constraints, e.g. `mem=16G`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *mergeConstraintsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var defaults, overrides string
+	resp.Error = req.Arguments.Get(ctx, &defaults, &overrides)
+	if resp.Error != nil {
+		return
+	}
+
+	defaultConstraints, err := constraints.Parse(defaults)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse constraints %q, got error: %s", defaults, err))
+		return
+	}
+	overridingConstraints, err := constraints.Parse(overrides)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to parse constraints %q, got error: %s", overrides, err))
+		return
+	}
+
+	validator := constraints.NewValidator()
+	validator.RegisterConflicts([]string{constraints.InstanceType}, conflictingConstraints)
+	merged, err := validator.Merge(defaultConstraints, overridingConstraints)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to merge constraints, got error: %s", err))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, merged.String())
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/juju/juju/core/crossmodel"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &offerURLFunction{}
+
+func NewOfferURLFunction() function.Function {
+	return &offerURLFunction{}
+}
+
+// offerURLFunction returns the URL of an offer from its parts, e.g. to
+// consume an offer of another model owned by another user.
+type offerURLFunction struct{}
+
+func (f *offerURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "offer_url"
+}
+
+func (f *offerURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build an offer URL",
+		Description: "Returns the URL of an offer, `<user>/<model>.<offer>`, after validating each of its parts.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "user",
+				Description: "The owner of the model of the offer, e.g. `admin`.",
+			},
+			function.StringParameter{
+				Name:        "model",
+				Description: "The name of the model of the offer.",
+			},
+			function.StringParameter{
+				Name:        "offer",
+				Description: "The name of the offer.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *offerURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var user, model, offer string
+	resp.Error = req.Arguments.Get(ctx, &user, &model, &offer)
+	if resp.Error != nil {
+		return
+	}
+
+	if rule := userNameRule(user); rule != "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid user name %q, it %s", user, rule))
+		return
+	}
+	if rule := modelNameRule(model); rule != "" {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid model name %q, it %s", model, rule))
+		return
+	}
+	if rule := applicationNameRule(offer); rule != "" {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid offer name %q, it %s", offer, rule))
+		return
+	}
+
+	url := crossmodel.OfferURL{User: user, ModelName: model, ApplicationName: offer}
+	resp.Error = resp.Result.Set(ctx, url.String())
+}
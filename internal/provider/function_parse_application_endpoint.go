@@ -0,0 +1,80 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &parseApplicationEndpointFunction{}
+
+func NewParseApplicationEndpointFunction() function.Function {
+	return &parseApplicationEndpointFunction{}
+}
+
+// parseApplicationEndpointFunction returns the parts of an application
+// endpoint as an object, e.g. to fill the application block of an
+// integration.
+type parseApplicationEndpointFunction struct{}
+
+// parsedApplicationEndpoint is the object returned by the
+// parse_application_endpoint function.
+type parsedApplicationEndpoint struct {
+	Application types.String `tfsdk:"application"`
+	Endpoint    types.String `tfsdk:"endpoint"`
+}
+
+func (f *parseApplicationEndpointFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_application_endpoint"
+}
+
+func (f *parseApplicationEndpointFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an application endpoint",
+		Description: "Returns the parts of an application endpoint, `<application>[:<endpoint>]`, as an object. " +
+			"The endpoint is null when it is not in the string.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "endpoint",
+				Description: "The application endpoint to parse, e.g. `mysql:db`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"application": types.StringType,
+				"endpoint":    types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseApplicationEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	application, endpoint, hasEndpoint := strings.Cut(value, ":")
+	if rule := applicationNameRule(application); rule != "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid application name %q in endpoint %q, it %s", application, value, rule))
+		return
+	}
+	if rule := endpointNameRule(endpoint); hasEndpoint && rule != "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid endpoint name %q in endpoint %q, it %s", endpoint, value, rule))
+		return
+	}
+	result := parsedApplicationEndpoint{
+		Application: types.StringValue(application),
+		Endpoint:    optionalStringValue(endpoint),
+	}
+	resp.Error = resp.Result.Set(ctx, &result)
+}
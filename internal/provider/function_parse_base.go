@@ -0,0 +1,80 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/juju/juju/core/base"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &parseBaseFunction{}
+
+func NewParseBaseFunction() function.Function {
+	return &parseBaseFunction{}
+}
+
+// parseBaseFunction returns the parts of a base as an object, e.g. to
+// read the release of the base of a machine.
+type parseBaseFunction struct{}
+
+// parsedBase is the object returned by the parse_base function.
+type parsedBase struct {
+	OS      types.String `tfsdk:"os"`
+	Channel types.String `tfsdk:"channel"`
+	Track   types.String `tfsdk:"track"`
+	Risk    types.String `tfsdk:"risk"`
+}
+
+func (f *parseBaseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_base"
+}
+
+func (f *parseBaseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a base",
+		Description: "Returns the parts of a base, `<os>@<channel>`, as an object. The risk of the channel " +
+			"defaults to `stable`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "base",
+				Description: "The base to parse, e.g. `ubuntu@22.04`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"os":      types.StringType,
+				"channel": types.StringType,
+				"track":   types.StringType,
+				"risk":    types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseBaseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := base.ParseBaseFromString(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse base %q, got error: %s", value, err))
+		return
+	}
+	result := parsedBase{
+		OS:      types.StringValue(parsed.OS),
+		Channel: types.StringValue(parsed.Channel.DisplayString()),
+		Track:   types.StringValue(parsed.Channel.Track),
+		Risk:    types.StringValue(string(parsed.Channel.Risk)),
+	}
+	resp.Error = resp.Result.Set(ctx, &result)
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/juju/juju/core/constraints"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &parseConstraintsFunction{}
+
+func NewParseConstraintsFunction() function.Function {
+	return &parseConstraintsFunction{}
+}
+
+// parseConstraintsFunction returns the constraints of a string as an
+// object, e.g. to read the memory of the constraints of a model.
+type parseConstraintsFunction struct{}
+
+// parsedConstraints is the object returned by the parse_constraints
+// function. tfsdk must match the attribute names of
+// parsedConstraintsAttributeTypes.
+type parsedConstraints struct {
+	AllocatePublicIP types.Bool   `tfsdk:"allocate_public_ip"`
+	Arch             types.String `tfsdk:"arch"`
+	Container        types.String `tfsdk:"container"`
+	Cores            types.Int64  `tfsdk:"cores"`
+	CpuPower         types.Int64  `tfsdk:"cpu_power"`
+	ImageID          types.String `tfsdk:"image_id"`
+	InstanceRole     types.String `tfsdk:"instance_role"`
+	InstanceType     types.String `tfsdk:"instance_type"`
+	Mem              types.Int64  `tfsdk:"mem"`
+	RootDisk         types.Int64  `tfsdk:"root_disk"`
+	RootDiskSource   types.String `tfsdk:"root_disk_source"`
+	Spaces           types.List   `tfsdk:"spaces"`
+	Tags             types.List   `tfsdk:"tags"`
+	VirtType         types.String `tfsdk:"virt_type"`
+	Zones            types.List   `tfsdk:"zones"`
+}
+
+// parsedConstraintsAttributeTypes holds the attribute types of the
+// object returned by the parse_constraints function.
+var parsedConstraintsAttributeTypes = map[string]attr.Type{
+	"allocate_public_ip": types.BoolType,
+	"arch":               types.StringType,
+	"container":          types.StringType,
+	"cores":              types.Int64Type,
+	"cpu_power":          types.Int64Type,
+	"image_id":           types.StringType,
+	"instance_role":      types.StringType,
+	"instance_type":      types.StringType,
+	"mem":                types.Int64Type,
+	"root_disk":          types.Int64Type,
+	"root_disk_source":   types.StringType,
+	"spaces":             types.ListType{ElemType: types.StringType},
+	"tags":               types.ListType{ElemType: types.StringType},
+	"virt_type":          types.StringType,
+	"zones":              types.ListType{ElemType: types.StringType},
+}
+
+func (f *parseConstraintsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_constraints"
+}
+
+func (f *parseConstraintsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse juju constraints",
+		Description: "Returns juju constraints, as used by `juju set-constraints`, as an object. Constraints " +
+			"which are not set are null, memory and disk sizes are in MiB.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "constraints",
+				Description: "The constraints to parse, e.g. `mem=16G cores=4`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedConstraintsAttributeTypes,
+		},
+	}
+}
+
+func (f *parseConstraintsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := constraints.Parse(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse constraints %q, got error: %s", value, err))
+		return
+	}
+	result, diags := newParsedConstraints(ctx, parsed)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, &result)
+}
+
+// newParsedConstraints returns the object of the given constraints.
+func newParsedConstraints(ctx context.Context, value constraints.Value) (parsedConstraints, diag.Diagnostics) {
+	result := parsedConstraints{
+		AllocatePublicIP: types.BoolPointerValue(value.AllocatePublicIP),
+		Arch:             types.StringPointerValue(value.Arch),
+		Container:        types.StringNull(),
+		Cores:            uint64PointerValue(value.CpuCores),
+		CpuPower:         uint64PointerValue(value.CpuPower),
+		ImageID:          types.StringPointerValue(value.ImageID),
+		InstanceRole:     types.StringPointerValue(value.InstanceRole),
+		InstanceType:     types.StringPointerValue(value.InstanceType),
+		Mem:              uint64PointerValue(value.Mem),
+		RootDisk:         uint64PointerValue(value.RootDisk),
+		RootDiskSource:   types.StringPointerValue(value.RootDiskSource),
+		VirtType:         types.StringPointerValue(value.VirtType),
+	}
+	if value.Container != nil {
+		result.Container = types.StringValue(string(*value.Container))
+	}
+
+	var diags diag.Diagnostics
+	result.Spaces = stringPointerListValue(ctx, value.Spaces, &diags)
+	result.Tags = stringPointerListValue(ctx, value.Tags, &diags)
+	result.Zones = stringPointerListValue(ctx, value.Zones, &diags)
+	return result, diags
+}
+
+// uint64PointerValue returns an Int64 value of the given pointer, null
+// when the pointer is nil.
+func uint64PointerValue(value *uint64) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+// stringPointerListValue returns a List value of the given pointer,
+// null when the pointer is nil.
+func stringPointerListValue(ctx context.Context, value *[]string, diags *diag.Diagnostics) types.List {
+	if value == nil {
+		return types.ListNull(types.StringType)
+	}
+	list, dErr := types.ListValueFrom(ctx, types.StringType, *value)
+	diags.Append(dErr...)
+	return list
+}
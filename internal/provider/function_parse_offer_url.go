@@ -0,0 +1,86 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/juju/juju/core/crossmodel"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &parseOfferURLFunction{}
+
+func NewParseOfferURLFunction() function.Function {
+	return &parseOfferURLFunction{}
+}
+
+// parseOfferURLFunction returns the parts of an offer URL as an object,
+// e.g. to read the model of the offer an integration consumes.
+type parseOfferURLFunction struct{}
+
+// parsedOfferURL is the object returned by the parse_offer_url function.
+type parsedOfferURL struct {
+	Controller types.String `tfsdk:"controller"`
+	User       types.String `tfsdk:"user"`
+	Model      types.String `tfsdk:"model"`
+	Offer      types.String `tfsdk:"offer"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+}
+
+func (f *parseOfferURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_offer_url"
+}
+
+func (f *parseOfferURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an offer URL",
+		Description: "Returns the parts of an offer URL, `[<controller>:][<user>/]<model>.<offer>[:<endpoint>]`, " +
+			"as an object. Parts which are not in the URL are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "The offer URL to parse, e.g. `admin/development.mysql`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"controller": types.StringType,
+				"user":       types.StringType,
+				"model":      types.StringType,
+				"offer":      types.StringType,
+				"endpoint":   types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseOfferURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	url, err := crossmodel.ParseOfferURL(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse offer URL %q, got error: %s", value, err))
+		return
+	}
+	// The application name of the URL includes the endpoint, if any.
+	offer, endpoint, _ := strings.Cut(url.ApplicationName, ":")
+	result := parsedOfferURL{
+		Controller: optionalStringValue(url.Source),
+		User:       optionalStringValue(url.User),
+		Model:      types.StringValue(url.ModelName),
+		Offer:      types.StringValue(offer),
+		Endpoint:   optionalStringValue(endpoint),
+	}
+	resp.Error = resp.Result.Set(ctx, &result)
+}
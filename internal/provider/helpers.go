@@ -4,10 +4,27 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/juju/juju/core/base"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/utils/v3"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 // model names for logging
@@ -16,18 +33,60 @@ import (
 //
 //	@module=juju.resource-application
 const (
-	LogDataSourceMachine = "datasource-machine"
-	LogDataSourceModel   = "datasource-model"
-	LogDataSourceOffer   = "datasource-offer"
-
-	LogResourceApplication = "resource-application"
-	LogResourceAccessModel = "resource-assess-model"
-	LogResourceCredential  = "resource-credential"
-	LogResourceMachine     = "resource-machine"
-	LogResourceModel       = "resource-model"
-	LogResourceOffer       = "resource-offer"
-	LogResourceSSHKey      = "resource-sshkey"
-	LogResourceUser        = "resource-user"
+	LogDataSourceAccess              = "datasource-access"
+	LogDataSourceActions             = "datasource-actions"
+	LogDataSourceApplication         = "datasource-application"
+	LogDataSourceCharm               = "datasource-charm"
+	LogDataSourceCloud               = "datasource-cloud"
+	LogDataSourceClouds              = "datasource-clouds"
+	LogDataSourceController          = "datasource-controller"
+	LogDataSourceCredentials         = "datasource-credentials"
+	LogDataSourceIntegrations        = "datasource-integrations"
+	LogDataSourceMachine             = "datasource-machine"
+	LogDataSourceMachines            = "datasource-machines"
+	LogDataSourceModel               = "datasource-model"
+	LogDataSourceModelExport         = "datasource-model-export"
+	LogDataSourceOffer               = "datasource-offer"
+	LogDataSourceOfferConsumeDetails = "datasource-offer-consume-details"
+	LogDataSourceOffers              = "datasource-offers"
+	LogDataSourceSecret              = "datasource-secret"
+	LogDataSourceSecrets             = "datasource-secrets"
+	LogDataSourceSpaces              = "datasource-spaces"
+	LogDataSourceStatus              = "datasource-status"
+	LogDataSourceStoragePools        = "datasource-storage-pools"
+	LogDataSourceSubnets             = "datasource-subnets"
+	LogDataSourceUsers               = "datasource-users"
+
+	LogResourceApplication         = "resource-application"
+	LogResourceApplicationResource = "resource-application-resource"
+	LogResourceBackup              = "resource-backup"
+	LogResourceBundle              = "resource-bundle"
+	LogResourceCloud               = "resource-cloud"
+	LogResourceAccessCloud         = "resource-access-cloud"
+	LogResourceAccessController    = "resource-access-controller"
+	LogResourceAccessModel         = "resource-assess-model"
+	LogResourceAccessOffer         = "resource-access-offer"
+	LogResourceControllerConfig    = "resource-controller-config"
+	LogResourceCredential          = "resource-credential"
+	LogResourceExec                = "resource-exec"
+	LogResourceFirewallRule        = "resource-firewall-rule"
+	LogResourceJAASAccess          = "resource-jaas-access-relation"
+	LogResourceJAASGroup           = "resource-jaas-group"
+	LogResourceJAASRole            = "resource-jaas-role"
+	LogResourceJAASServiceAccount  = "resource-jaas-service-account"
+	LogResourceKubernetesCloud     = "resource-kubernetes-cloud"
+	LogResourceMachine             = "resource-machine"
+	LogResourceModel               = "resource-model"
+	LogResourceModelDefaults       = "resource-model-defaults"
+	LogResourceModelMigration      = "resource-model-migration"
+	LogResourceOffer               = "resource-offer"
+	LogResourceSecretAccess        = "resource-secret-access"
+	LogResourceSecretBackend       = "resource-secret-backend"
+	LogResourceSSHKey              = "resource-sshkey"
+	LogResourceSpace               = "resource-space"
+	LogResourceStorage             = "resource-storage"
+	LogResourceSubnet              = "resource-subnet"
+	LogResourceUser                = "resource-user"
 )
 
 const LogResourceIntegration = "resource-integration"
@@ -48,7 +107,195 @@ func addDSClientNotConfiguredError(diag *diag.Diagnostics, dataSource string) {
 	)
 }
 
+// addDSOfflineWarning warns a data source is not read when planning
+// offline: its values computed by the controller are left null.
+func addDSOfflineWarning(diag *diag.Diagnostics, dataSource string) {
+	diag.AddWarning(
+		"Data Source Not Read Offline",
+		fmt.Sprintf("Unable to read data source %s while planning offline, "+
+			"its values computed by the controller are null.", dataSource),
+	)
+}
+
+// removeMissingResource handles a resource whose read found it removed
+// outside of terraform: it is removed from the state with a warning, so
+// the next apply creates it again if it is still configured, unless the
+// provider fails on missing resources.
+func removeMissingResource(ctx context.Context, client *juju.Client, st *tfsdk.State, resource string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client.FailOnMissingResources {
+		diags.AddError("Not Found", fmt.Sprintf("Unable to read %s, it was removed outside of terraform: %s", resource, err))
+		return diags
+	}
+	st.RemoveResource(ctx)
+	diags.AddWarning("Resource Removed",
+		fmt.Sprintf("The %s was removed outside of terraform, it is removed from the state: %s", resource, err))
+	return diags
+}
+
+// modelUUIDForID returns the UUID of a model, which the IDs of the
+// resources of the model hold rather than its name: the resources are
+// still found once the model is renamed outside of terraform.
+func modelUUIDForID(client *juju.Client, model string) (string, error) {
+	return client.Models.ModelUUID(model)
+}
+
+// modelUUIDKeyedID returns the ID '<model>:<...>' of a resource of a
+// model keyed on the model UUID, from an ID keyed on the model name or
+// its UUID.
+func modelUUIDKeyedID(client *juju.Client, id string) (string, error) {
+	model, rest, ok := strings.Cut(id, ":")
+	if !ok {
+		return "", fmt.Errorf("malformed ID %q", id)
+	}
+	if utils.IsValidUUIDString(model) {
+		return id, nil
+	}
+	if client == nil {
+		return "", errors.New("the provider is not configured")
+	}
+	modelUUID, err := modelUUIDForID(client, model)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the UUID of model %q: %w", model, err)
+	}
+	return modelUUID + ":" + rest, nil
+}
+
+// importModelKeyedID imports a resource of a model by its ID, which
+// may hold the model name, keyed on the model UUID.
+func importModelKeyedID(ctx context.Context, client *juju.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := modelUUIDKeyedID(client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import %q, got error: %s", req.ID, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// upgradeModelKeyedID keys the ID of a resource of a model, from a
+// state keyed on the model name, on the model UUID. The controller is
+// read to upgrade the state.
+func upgradeModelKeyedID(client *juju.Client, id types.String, diags *diag.Diagnostics) types.String {
+	upgraded, err := modelUUIDKeyedID(client, id.ValueString())
+	if err != nil {
+		diags.AddError("State Upgrade Error",
+			fmt.Sprintf("Unable to key the ID %q on the UUID of its model, the controller must be reachable to upgrade the state, got error: %s", id.ValueString(), err))
+		return id
+	}
+	return types.StringValue(upgraded)
+}
+
+// importedModelName returns the model attribute of an imported resource,
+// read from the model of its ID: a model UUID is read back as the name
+// of the model.
+func importedModelName(client *juju.Client, model string) (string, error) {
+	if !utils.IsValidUUIDString(model) {
+		return model, nil
+	}
+	resolved, err := client.Models.ResolveModel(model)
+	if err != nil {
+		return "", err
+	}
+	return resolved.Reference(), nil
+}
+
+// isModelNotFound reports whether the read of a resource failed as its
+// model does not exist, e.g. once the model was destroyed outside of
+// terraform.
+func isModelNotFound(err error) bool {
+	return errors.As(err, &juju.ModelNotFoundError) || params.IsCodeModelNotFound(err)
+}
+
 func intPtr(value types.Int64) *int {
 	count := int(value.ValueInt64())
 	return &count
 }
+
+// importedPrivateKey is the private state key of the resources which
+// are imported, until they are first updated. The attributes which
+// cannot be read back from the controller are null once imported.
+const importedPrivateKey = "imported"
+
+// replaceUnlessImportedDescription describes the plan modifiers of the
+// attributes which are set in place once the resource is imported.
+const replaceUnlessImportedDescription = "The resource is replaced when the attribute changes, unless the " +
+	"attribute is null once the resource is imported."
+
+// setImported records in the private state that the resource is
+// imported.
+func setImported(ctx context.Context, private privateState) diag.Diagnostics {
+	return private.SetKey(ctx, importedPrivateKey, []byte("true"))
+}
+
+// clearImported removes the record of the private state that the
+// resource is imported.
+func clearImported(ctx context.Context, private privateState) diag.Diagnostics {
+	return private.SetKey(ctx, importedPrivateKey, nil)
+}
+
+// replaceUnlessImported returns whether the resource is replaced when
+// an attribute changes: the attributes which are null once the
+// resource is imported are set in place instead.
+func replaceUnlessImported(ctx context.Context, stateNull bool, private privateState) (bool, diag.Diagnostics) {
+	if !stateNull {
+		return true, nil
+	}
+	imported, diags := private.GetKey(ctx, importedPrivateKey)
+	return imported == nil, diags
+}
+
+func stringReplaceUnlessImported(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = replaceUnlessImported(ctx, req.StateValue.IsNull(), req.Private)
+}
+
+func int64ReplaceUnlessImported(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = replaceUnlessImported(ctx, req.StateValue.IsNull(), req.Private)
+}
+
+func listReplaceUnlessImported(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = replaceUnlessImported(ctx, req.StateValue.IsNull(), req.Private)
+}
+
+// baseFromSeries returns the base of a series, e.g. ubuntu@22.04 for
+// jammy, to upgrade states holding a series and no base. The base is
+// returned unchanged when it is set or the series is unknown.
+func baseFromSeries(series, value types.String) types.String {
+	if value.ValueString() != "" || series.ValueString() == "" {
+		return value
+	}
+	seriesBase, err := base.GetBaseFromSeries(series.ValueString())
+	if err != nil {
+		return value
+	}
+	return types.StringValue(seriesBase.DisplayString())
+}
+
+// DeletionProtectionKey is the attribute of the resources refusing to
+// be destroyed while it is set.
+const DeletionProtectionKey = "deletion_protection"
+
+func deletionProtectionAttribute(resource string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Refuse to destroy the %s, including to replace it, while set. Unlike the "+
+			"`prevent_destroy` lifecycle argument, it is enforced by the provider, so it also guards against "+
+			"destroying the workspace or removing the resource from the configuration: it must be set to false "+
+			"and applied before the %s can be destroyed. Defaults to false.", resource, resource),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// checkDeletionProtection returns an error when the state of a resource
+// being destroyed has deletion_protection set.
+func checkDeletionProtection(ctx context.Context, state tfsdk.State, resource string) diag.Diagnostics {
+	var protected types.Bool
+	diags := state.GetAttribute(ctx, path.Root(DeletionProtectionKey), &protected)
+	if diags.HasError() || !protected.ValueBool() {
+		return diags
+	}
+	diags.AddAttributeError(path.Root(DeletionProtectionKey), "Deletion Protection",
+		fmt.Sprintf("Unable to destroy the %s, %s is set. Set it to false and apply before destroying the %s.",
+			resource, DeletionProtectionKey, resource))
+	return diags
+}
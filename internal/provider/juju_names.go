@@ -0,0 +1,185 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/juju/names/v4"
+	"github.com/juju/utils/v3"
+)
+
+// The naming rules of Juju, checked while planning rather than by the
+// controller once part of the plan is applied. Each check returns the
+// rule the name breaks, empty when the name is valid.
+
+var (
+	// validEndpointName matches the names charms give to their
+	// endpoints, e.g. `db`, `juju-info` or `ingress_per_unit`.
+	validEndpointName = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+	onlyDigits        = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// applicationNameRule checks the name of an application, or of an
+// offer, which follow the same rules.
+func applicationNameRule(name string) string {
+	switch {
+	case name == "":
+		return "must not be empty"
+	case strings.Trim(name, "abcdefghijklmnopqrstuvwxyz0123456789-") != "":
+		return "must only contain lowercase letters, digits and hyphens"
+	case name[0] < 'a' || name[0] > 'z':
+		return "must start with a letter"
+	case strings.HasSuffix(name, "-") || strings.Contains(name, "--"):
+		return "must not end with a hyphen, nor contain consecutive hyphens"
+	}
+	for _, part := range strings.Split(name, "-") {
+		if onlyDigits.MatchString(part) {
+			return fmt.Sprintf("must not have a part between hyphens of only digits, as %q", part)
+		}
+	}
+	if !names.IsValidApplication(name) {
+		return "must be a valid application name"
+	}
+	return ""
+}
+
+// modelNameRule checks the name of a model.
+func modelNameRule(name string) string {
+	switch {
+	case name == "":
+		return "must not be empty"
+	case strings.Trim(name, "abcdefghijklmnopqrstuvwxyz0123456789-") != "":
+		return "must only contain lowercase letters, digits and hyphens"
+	case strings.HasPrefix(name, "-"):
+		return "must not start with a hyphen"
+	case !names.IsValidModelName(name):
+		return "must be a valid model name"
+	}
+	return ""
+}
+
+// modelRule checks a reference to a model: its UUID, its name or its
+// name qualified by its owner, e.g. `bob/development`.
+func modelRule(model string) string {
+	if utils.IsValidUUIDString(model) {
+		return ""
+	}
+	owner, name, qualified := strings.Cut(model, "/")
+	if !qualified {
+		return modelNameRule(model)
+	}
+	if rule := userNameRule(owner); rule != "" {
+		return fmt.Sprintf("must be qualified by the name of its owner, which %s", rule)
+	}
+	return modelNameRule(name)
+}
+
+// endpointNameRule checks the name of an endpoint of a charm.
+func endpointNameRule(name string) string {
+	switch {
+	case name == "":
+		return "must not be empty"
+	case name[0] < 'a' || name[0] > 'z':
+		return "must start with a letter"
+	case !validEndpointName.MatchString(name):
+		return "must only contain lowercase letters, digits, hyphens and underscores"
+	}
+	return ""
+}
+
+// spaceNameRule checks the name of a space.
+func spaceNameRule(name string) string {
+	switch {
+	case name == "":
+		return "must not be empty"
+	case strings.Trim(name, "abcdefghijklmnopqrstuvwxyz0123456789-") != "":
+		return "must only contain lowercase letters, digits and hyphens"
+	case strings.HasPrefix(name, "-") || strings.HasSuffix(name, "-") || strings.Contains(name, "--"):
+		return "must not start or end with a hyphen, nor contain consecutive hyphens"
+	case !names.IsValidSpace(name):
+		return "must be a valid space name"
+	}
+	return ""
+}
+
+// userNameRule checks the name of a user, local or external, e.g.
+// `bob` or `bob@external`.
+func userNameRule(name string) string {
+	switch {
+	case name == "":
+		return "must not be empty"
+	case !names.IsValidUser(name):
+		return "must start with a letter or digit, and only contain letters, digits, dots, " +
+			"hyphens and plus signs, optionally followed by @ and a domain"
+	}
+	return ""
+}
+
+// jujuNameValidator validates the names of an entity of Juju, with the
+// rule of the entity the name breaks.
+type jujuNameValidator struct {
+	entity string
+	rule   func(string) string
+}
+
+func applicationNameValidator() jujuNameValidator {
+	return jujuNameValidator{entity: "application", rule: applicationNameRule}
+}
+
+func offerNameValidator() jujuNameValidator {
+	return jujuNameValidator{entity: "offer", rule: applicationNameRule}
+}
+
+func modelNameValidator() jujuNameValidator {
+	return jujuNameValidator{entity: "model", rule: modelNameRule}
+}
+
+// modelValidator validates the references to a model, by UUID or by
+// name, which may be qualified by the name of its owner.
+func modelValidator() jujuNameValidator {
+	return jujuNameValidator{entity: "model", rule: modelRule}
+}
+
+func endpointNameValidator() jujuNameValidator {
+	return jujuNameValidator{entity: "endpoint", rule: endpointNameRule}
+}
+
+func spaceNameValidator() jujuNameValidator {
+	return jujuNameValidator{entity: "space", rule: spaceNameRule}
+}
+
+func userNameValidator() jujuNameValidator {
+	return jujuNameValidator{entity: "user", rule: userNameRule}
+}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v jujuNameValidator) Description(context.Context) string {
+	return fmt.Sprintf("string must be a valid %s name", v.entity)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v jujuNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v jujuNameValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if rule := v.rule(req.ConfigValue.ValueString()); rule != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			fmt.Sprintf("Invalid %s Name", strings.ToUpper(v.entity[:1])+v.entity[1:]),
+			fmt.Sprintf("The %s name %q %s.", v.entity, req.ConfigValue.ValueString(), rule),
+		)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJujuNameRules(t *testing.T) {
+	tests := []struct {
+		rule  func(string) string
+		name  string
+		error string
+	}{
+		{rule: applicationNameRule, name: "postgresql-k8s"},
+		{rule: applicationNameRule, name: "", error: "must not be empty"},
+		{rule: applicationNameRule, name: "PostgreSQL", error: "lowercase letters, digits and hyphens"},
+		{rule: applicationNameRule, name: "my_app", error: "lowercase letters, digits and hyphens"},
+		{rule: applicationNameRule, name: "1app", error: "must start with a letter"},
+		{rule: applicationNameRule, name: "app-", error: "must not end with a hyphen"},
+		{rule: applicationNameRule, name: "app--db", error: "consecutive hyphens"},
+		{rule: applicationNameRule, name: "app-2", error: `of only digits, as "2"`},
+		{rule: modelNameRule, name: "development"},
+		{rule: modelNameRule, name: "2024-prod"},
+		{rule: modelNameRule, name: "Development", error: "lowercase letters, digits and hyphens"},
+		{rule: modelNameRule, name: "-prod", error: "must not start with a hyphen"},
+		{rule: modelRule, name: "bob/development"},
+		{rule: modelRule, name: "bob@external/development"},
+		{rule: modelRule, name: "0a5f0d8c-9d2b-4c9e-8f1e-6a1e2f3b4c5d"},
+		{rule: modelRule, name: "-bob/development", error: "qualified by the name of its owner"},
+		{rule: modelRule, name: "bob/dev_1", error: "lowercase letters, digits and hyphens"},
+		{rule: endpointNameRule, name: "ingress_per_unit"},
+		{rule: endpointNameRule, name: "juju-info"},
+		{rule: endpointNameRule, name: "2db", error: "must start with a letter"},
+		{rule: endpointNameRule, name: "db:1", error: "lowercase letters, digits, hyphens and underscores"},
+		{rule: spaceNameRule, name: "alpha"},
+		{rule: spaceNameRule, name: "alpha-", error: "must not start or end with a hyphen"},
+		{rule: userNameRule, name: "bob"},
+		{rule: userNameRule, name: "bob@external"},
+		{rule: userNameRule, name: "bob smith", error: "must start with a letter or digit"},
+	}
+	for _, test := range tests {
+		got := test.rule(test.name)
+		if test.error == "" {
+			if got != "" {
+				t.Errorf("%q: unexpected rule broken: %s", test.name, got)
+			}
+			continue
+		}
+		if !strings.Contains(got, test.error) {
+			t.Errorf("%q: expected rule %q to be broken, got %q", test.name, test.error, got)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/juju/version/v2"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// The Juju versions needed by the features which are not supported by
+// every controller the provider supports.
+var (
+	secretsJujuVersion        = version.MustParse("3.0.0")
+	secretBackendsJujuVersion = version.MustParse("3.1.0")
+	userSecretsJujuVersion    = version.MustParse("3.3.0")
+)
+
+// versionConstraint is a constraint on a Juju version, e.g. ">= 3.1".
+type versionConstraint struct {
+	operator string
+	version  version.Number
+}
+
+func (c versionConstraint) check(v version.Number) bool {
+	cmp := v.Compare(c.version)
+	switch c.operator {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+// parseVersionConstraints parses comma separated version constraints,
+// as terraform's required_version, e.g. ">= 3.1, < 4.0". A version
+// without an operator must be equal, its missing minor and patch
+// numbers are 0.
+func parseVersionConstraints(value string) ([]versionConstraint, error) {
+	var constraints []versionConstraint
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		operator := "="
+		for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+			if strings.HasPrefix(part, op) {
+				operator = op
+				part = strings.TrimSpace(strings.TrimPrefix(part, op))
+				break
+			}
+		}
+		number, err := parseVersionPrefix(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q: %w", value, err)
+		}
+		constraints = append(constraints, versionConstraint{operator: operator, version: number})
+	}
+	return constraints, nil
+}
+
+// parseVersionPrefix parses a version, which may lack its minor and
+// patch numbers.
+func parseVersionPrefix(value string) (version.Number, error) {
+	number, err := version.Parse(value)
+	for _, suffix := range []string{".0", ".0.0"} {
+		if err == nil {
+			break
+		}
+		number, err = version.Parse(value + suffix)
+	}
+	if err != nil {
+		return version.Zero, fmt.Errorf("invalid version %q", value)
+	}
+	return number, nil
+}
+
+// checkRequiredJujuVersion returns an error when the version of the
+// controller does not satisfy the required version constraints.
+func checkRequiredJujuVersion(required string, controllerVersion version.Number) diag.Diagnostics {
+	var diags diag.Diagnostics
+	constraints, err := parseVersionConstraints(required)
+	if err != nil {
+		diags.AddError("Invalid Provider Configuration", fmt.Sprintf("Unable to parse `%s`, got error: %s", JujuRequiredVersion, err))
+		return diags
+	}
+	for _, constraint := range constraints {
+		if !constraint.check(controllerVersion) {
+			diags.AddError("Unsupported Juju Version",
+				fmt.Sprintf("The controller runs Juju %s, which does not satisfy the required version %q.", controllerVersion, required))
+			return diags
+		}
+	}
+	return diags
+}
+
+// checkJujuVersion returns an error when the controller runs a version
+// of Juju older than the version the feature needs, rather than letting
+// the feature fail with an unsupported facade version during the apply.
+// The version is not checked when it is unknown, e.g. when planning
+// offline.
+func checkJujuVersion(client *juju.Client, feature string, minVersion version.Number) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || client.ControllerVersion == version.Zero {
+		return diags
+	}
+	if client.ControllerVersion.Compare(minVersion) < 0 {
+		diags.AddError("Unsupported Juju Version",
+			fmt.Sprintf("%s requires Juju %s or later, the controller runs Juju %s.", feature, minVersion, client.ControllerVersion))
+	}
+	return diags
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/juju/version/v2"
+)
+
+func TestCheckRequiredJujuVersion(t *testing.T) {
+	tests := []struct {
+		required  string
+		version   string
+		satisfied bool
+	}{
+		{required: ">= 3.1", version: "3.1.6", satisfied: true},
+		{required: ">= 3.1", version: "2.9.45"},
+		{required: ">= 3.1, < 4.0", version: "3.4.2", satisfied: true},
+		{required: ">= 3.1, < 4.0", version: "4.0.0"},
+		{required: "3.4.2", version: "3.4.2", satisfied: true},
+		{required: "= 3.4.2", version: "3.4.3"},
+		{required: "!= 3.4.2", version: "3.4.3", satisfied: true},
+		{required: "> 3.4", version: "3.4.0"},
+		{required: "< 4", version: "3.6.1", satisfied: true},
+		{required: "<= 3.4", version: "3.4-beta1", satisfied: true},
+	}
+	for _, test := range tests {
+		diags := checkRequiredJujuVersion(test.required, version.MustParse(test.version))
+		if diags.HasError() == test.satisfied {
+			t.Errorf("%q with Juju %s: expected satisfied %t, got %s", test.required, test.version, test.satisfied, diags)
+		}
+	}
+}
+
+func TestParseVersionConstraintsInvalid(t *testing.T) {
+	for _, value := range []string{"", ">=", "3.x", ">= 3.1,", "~> 3.1"} {
+		if _, err := parseVersionConstraints(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
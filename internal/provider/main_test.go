@@ -19,6 +19,10 @@ const TestCloudEnvKey string = "TEST_CLOUD"
 const TestMachineIPEnvKey string = "TEST_ADD_MACHINE_IP"
 const TestSSHPublicKeyFileEnvKey string = "TEST_SSH_PUB_KEY_PATH"
 const TestSSHPrivateKeyFileEnvKey string = "TEST_SSH_PRIV_KEY_PATH"
+const TestUpgradeAgentVersionEnvKey string = "TEST_UPGRADE_AGENT_VERSION"
+
+// TestJAASEnvKey is set when the tests run against JAAS.
+const TestJAASEnvKey string = "IS_JAAS"
 
 // CloudTesting is a value indicating the current cloud
 // available for testing
@@ -73,6 +77,14 @@ var testAddMachineIP = ""
 var testSSHPubKeyPath = ""
 var testSSHPrivKeyPath = ""
 
+// skipIfNotJAAS skips the tests which require the provider to be
+// connected to JAAS.
+func skipIfNotJAAS(t *testing.T) {
+	if os.Getenv(TestJAASEnvKey) == "" {
+		t.Skipf("%s only runs with JAAS, set %s", t.Name(), TestJAASEnvKey)
+	}
+}
+
 func TestMain(m *testing.M) {
 	testCloud := os.Getenv(TestCloudEnvKey)
 
@@ -10,10 +10,12 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -23,15 +25,23 @@ import (
 )
 
 const (
-	JujuControllerEnvKey = "JUJU_CONTROLLER_ADDRESSES"
-	JujuUsernameEnvKey   = "JUJU_USERNAME"
-	JujuPasswordEnvKey   = "JUJU_PASSWORD"
-	JujuCACertEnvKey     = "JUJU_CA_CERT"
-
-	JujuController = "controller_addresses"
-	JujuUsername   = "username"
-	JujuPassword   = "password"
-	JujuCACert     = "ca_certificate"
+	JujuControllerEnvKey             = "JUJU_CONTROLLER_ADDRESSES"
+	JujuUsernameEnvKey               = "JUJU_USERNAME"
+	JujuPasswordEnvKey               = "JUJU_PASSWORD"
+	JujuCACertEnvKey                 = "JUJU_CA_CERT"
+	JujuCharmhubCacheDirEnvKey       = "JUJU_CHARMHUB_CACHE_DIR"
+	JujuPlanOfflineEnvKey            = "JUJU_PLAN_OFFLINE"
+	JujuFailOnMissingResourcesEnvKey = "JUJU_FAIL_ON_MISSING_RESOURCES"
+	JujuRequiredVersionEnvKey        = "JUJU_REQUIRED_VERSION"
+
+	JujuController             = "controller_addresses"
+	JujuUsername               = "username"
+	JujuPassword               = "password"
+	JujuCACert                 = "ca_certificate"
+	JujuCharmhubCacheDir       = "charmhub_cache_dir"
+	JujuPlanOffline            = "plan_offline"
+	JujuFailOnMissingResources = "fail_on_missing_resources"
+	JujuRequiredVersion        = "required_juju_version"
 )
 
 // populateJujuProviderModelLive gets the controller config,
@@ -53,6 +63,20 @@ func populateJujuProviderModelLive() (jujuProviderModel, error) {
 	return data, nil
 }
 
+// boolOrEnv returns the value of a boolean attribute of the provider,
+// or of its environment variable when the attribute is not set.
+func boolOrEnv(value types.Bool, envKey string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !value.IsNull() || os.Getenv(envKey) == "" {
+		return value.ValueBool(), diags
+	}
+	parsed, err := strconv.ParseBool(os.Getenv(envKey))
+	if err != nil {
+		diags.AddError("Invalid Provider Configuration", fmt.Sprintf("Unable to parse the `%s` environment variable, got error: %s", envKey, err))
+	}
+	return parsed, diags
+}
+
 func getField(field string, config map[string]string) string {
 	// get the value from the environment variable
 	controller := os.Getenv(field)
@@ -65,6 +89,7 @@ func getField(field string, config map[string]string) string {
 
 // Ensure jujuProvider satisfies various provider interfaces.
 var _ provider.Provider = &jujuProvider{}
+var _ provider.ProviderWithFunctions = &jujuProvider{}
 
 // NewJujuProvider returns a framework style terraform provider.
 func NewJujuProvider(version string) provider.Provider {
@@ -76,10 +101,14 @@ type jujuProvider struct {
 }
 
 type jujuProviderModel struct {
-	ControllerAddrs types.String `tfsdk:"controller_addresses"`
-	UserName        types.String `tfsdk:"username"`
-	Password        types.String `tfsdk:"password"`
-	CACert          types.String `tfsdk:"ca_certificate"`
+	ControllerAddrs        types.String `tfsdk:"controller_addresses"`
+	UserName               types.String `tfsdk:"username"`
+	Password               types.String `tfsdk:"password"`
+	CACert                 types.String `tfsdk:"ca_certificate"`
+	CharmhubCacheDir       types.String `tfsdk:"charmhub_cache_dir"`
+	PlanOffline            types.Bool   `tfsdk:"plan_offline"`
+	FailOnMissingResources types.Bool   `tfsdk:"fail_on_missing_resources"`
+	RequiredVersion        types.String `tfsdk:"required_juju_version"`
 }
 
 func (j jujuProviderModel) valid() bool {
@@ -119,6 +148,29 @@ func (p *jujuProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Description: fmt.Sprintf("This is the certificate to use for identification. This can also be set by the `%s` environment variable", JujuCACertEnvKey),
 				Optional:    true,
 			},
+			JujuCharmhubCacheDir: schema.StringAttribute{
+				Description: fmt.Sprintf("This is a directory where the Charmhub queries are cached for 10 minutes, to be shared by the runs of the provider. "+
+					"The queries are only cached for the run of the provider when it is not set. This can also be set by the `%s` environment variable", JujuCharmhubCacheDirEnvKey),
+				Optional: true,
+			},
+			JujuPlanOffline: schema.BoolAttribute{
+				Description: fmt.Sprintf("When the controller is unreachable, e.g. when plans are produced in another network zone than where they are applied, "+
+					"plan offline rather than failing: the resources keep their prior state, and the values computed by the controller are unknown until apply. "+
+					"Data sources reading from the controller are not read, their computed values are null. This can also be set by the `%s` environment variable", JujuPlanOfflineEnvKey),
+				Optional: true,
+			},
+			JujuFailOnMissingResources: schema.BoolAttribute{
+				Description: fmt.Sprintf("Fail the refresh when applications, integrations, machines, models or offers were removed outside of terraform. "+
+					"By default they are removed from the state with a warning, and created again by the next apply if they are still configured. "+
+					"This can also be set by the `%s` environment variable", JujuFailOnMissingResourcesEnvKey),
+				Optional: true,
+			},
+			JujuRequiredVersion: schema.StringAttribute{
+				Description: fmt.Sprintf("The versions of Juju the controller must run, as comma separated constraints, e.g. `>= 3.1, < 4.0`. "+
+					"The provider fails to configure when the controller runs another version, rather than failing during apply. "+
+					"This can also be set by the `%s` environment variable", JujuRequiredVersionEnvKey),
+				Optional: true,
+			},
 		},
 	}
 }
@@ -143,21 +195,58 @@ func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		Username:            data.UserName.ValueString(),
 		Password:            data.Password.ValueString(),
 		CACert:              data.CACert.ValueString(),
+		CharmhubCacheDir:    data.CharmhubCacheDir.ValueString(),
+	}
+	if config.CharmhubCacheDir == "" {
+		config.CharmhubCacheDir = os.Getenv(JujuCharmhubCacheDirEnvKey)
+	}
+	planOffline, diags := boolOrEnv(data.PlanOffline, JujuPlanOfflineEnvKey)
+	resp.Diagnostics.Append(diags...)
+	failOnMissing, diags := boolOrEnv(data.FailOnMissingResources, JujuFailOnMissingResourcesEnvKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	client, err := juju.NewClient(ctx, config)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create juju client, got error: %s", err))
 		return
 	}
+	client.FailOnMissingResources = failOnMissing
+	requiredVersion := data.RequiredVersion.ValueString()
+	if requiredVersion == "" {
+		requiredVersion = os.Getenv(JujuRequiredVersionEnvKey)
+	}
 
 	// Here we are testing that we can connect successfully to the Juju server
 	// this prevents having logic to check the connection is OK in every function
 	testConn, err := client.Models.GetConnection(nil)
-	if err != nil {
+	switch {
+	case err == nil:
+		controllerVersion, ok := testConn.ServerVersion()
+		_ = testConn.Close()
+		if !ok && requiredVersion != "" {
+			resp.Diagnostics.AddError("Unknown Juju Version",
+				fmt.Sprintf("Unable to read the Juju version of the controller, which `%s` is checked against.", JujuRequiredVersion))
+			return
+		}
+		client.ControllerVersion = controllerVersion
+	case planOffline:
+		// The plan is produced without the controller, the
+		// resources are read from it when the plan is applied.
+		client.Offline = true
+		resp.Diagnostics.AddWarning("Planning Offline",
+			fmt.Sprintf("Unable to connect to the controller, the resources are not refreshed and the values computed by the controller are unknown until apply, got error: %s", err))
+	default:
 		resp.Diagnostics.Append(checkClientErr(err, config)...)
 		return
 	}
-	_ = testConn.Close()
+	if requiredVersion != "" && !client.Offline {
+		resp.Diagnostics.Append(checkRequiredJujuVersion(requiredVersion, client.ControllerVersion)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	resp.ResourceData = client
 	resp.DataSourceData = client
@@ -224,14 +313,36 @@ func getJujuProviderModel(ctx context.Context, req provider.ConfigureRequest) (j
 // the Metadata method. All resources must have unique names.
 func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		func() resource.Resource { return NewAccessCloudResource() },
+		func() resource.Resource { return NewAccessControllerResource() },
 		func() resource.Resource { return NewAccessModelResource() },
+		func() resource.Resource { return NewAccessOfferResource() },
 		func() resource.Resource { return NewApplicationResource() },
 		func() resource.Resource { return NewCredentialResource() },
 		func() resource.Resource { return NewIntegrationResource() },
+		func() resource.Resource { return NewKubernetesCloudResource() },
 		func() resource.Resource { return NewMachineResource() },
 		func() resource.Resource { return NewModelResource() },
+		func() resource.Resource { return NewModelDefaultsResource() },
+		func() resource.Resource { return NewModelMigrationResource() },
+		func() resource.Resource { return NewCloudResource() },
 		func() resource.Resource { return NewOfferResource() },
+		func() resource.Resource { return NewSecretAccessResource() },
+		func() resource.Resource { return NewSecretBackendResource() },
 		func() resource.Resource { return NewSSHKeyResource() },
+		func() resource.Resource { return NewSpaceResource() },
+		func() resource.Resource { return NewSubnetResource() },
+		func() resource.Resource { return NewFirewallRuleResource() },
+		func() resource.Resource { return NewControllerConfigResource() },
+		func() resource.Resource { return NewBackupResource() },
+		func() resource.Resource { return NewJAASGroupResource() },
+		func() resource.Resource { return NewJAASRoleResource() },
+		func() resource.Resource { return NewJAASAccessRelationResource() },
+		func() resource.Resource { return NewJAASServiceAccountResource() },
+		func() resource.Resource { return NewExecResource() },
+		func() resource.Resource { return NewBundleResource() },
+		func() resource.Resource { return NewStorageResource() },
+		func() resource.Resource { return NewApplicationResourceResource() },
 		func() resource.Resource { return NewUserResource() },
 	}
 }
@@ -243,9 +354,47 @@ func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 // the Metadata method. All data sources must have unique names.
 func (p *jujuProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		func() datasource.DataSource { return NewAccessDataSource() },
+		func() datasource.DataSource { return NewActionsDataSource() },
+		func() datasource.DataSource { return NewApplicationDataSource() },
+		func() datasource.DataSource { return NewCharmDataSource() },
+		func() datasource.DataSource { return NewCloudDataSource() },
+		func() datasource.DataSource { return NewCloudsDataSource() },
+		func() datasource.DataSource { return NewControllerDataSource() },
+		func() datasource.DataSource { return NewCredentialsDataSource() },
+		func() datasource.DataSource { return NewIntegrationsDataSource() },
 		func() datasource.DataSource { return NewMachineDataSource() },
+		func() datasource.DataSource { return NewMachinesDataSource() },
 		func() datasource.DataSource { return NewModelDataSource() },
+		func() datasource.DataSource { return NewModelExportDataSource() },
 		func() datasource.DataSource { return NewOfferDataSource() },
+		func() datasource.DataSource { return NewOfferConsumeDetailsDataSource() },
+		func() datasource.DataSource { return NewOffersDataSource() },
+		func() datasource.DataSource { return NewSecretDataSource() },
+		func() datasource.DataSource { return NewSecretsDataSource() },
+		func() datasource.DataSource { return NewSpacesDataSource() },
+		func() datasource.DataSource { return NewStatusDataSource() },
+		func() datasource.DataSource { return NewStoragePoolsDataSource() },
+		func() datasource.DataSource { return NewSubnetsDataSource() },
+		func() datasource.DataSource { return NewUsersDataSource() },
+	}
+}
+
+// Functions returns a slice of functions to instantiate each provider
+// defined function implementation.
+//
+// The function name is determined by the Function implementing the
+// Metadata method. All functions must have unique names.
+func (p *jujuProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		func() function.Function { return NewApplicationEndpointFunction() },
+		func() function.Function { return NewBaseFunction() },
+		func() function.Function { return NewMergeConstraintsFunction() },
+		func() function.Function { return NewOfferURLFunction() },
+		func() function.Function { return NewParseApplicationEndpointFunction() },
+		func() function.Function { return NewParseBaseFunction() },
+		func() function.Function { return NewParseConstraintsFunction() },
+		func() function.Function { return NewParseOfferURLFunction() },
 	}
 }
 
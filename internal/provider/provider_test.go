@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"runtime"
 	"testing"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
@@ -153,6 +156,19 @@ func testAccPreCheck(t *testing.T) {
 	TestClient = confResp.ResourceData.(*juju.Client)
 }
 
+// testAccCheckModelUUIDAttr checks the attribute of a resource, e.g.
+// its ID, is keyed on the UUID of the model resource, followed by the
+// given suffix.
+func testAccCheckModelUUIDAttr(name, key, modelName, suffix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		model, ok := s.RootModule().Resources[modelName]
+		if !ok {
+			return fmt.Errorf("model resource %q not found in state", modelName)
+		}
+		return resource.TestCheckResourceAttr(name, key, model.Primary.Attributes["uuid"]+":"+suffix)(s)
+	}
+}
+
 func configureProvider(t *testing.T, p provider.Provider) provider.ConfigureResponse {
 	schemaResp := provider.SchemaResponse{}
 	Provider.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
@@ -161,10 +177,14 @@ func configureProvider(t *testing.T, p provider.Provider) provider.ConfigureResp
 	conf := jujuProviderModel{}
 
 	mapTypes := map[string]attr.Type{
-		JujuController: types.StringType,
-		JujuUsername:   types.StringType,
-		JujuPassword:   types.StringType,
-		JujuCACert:     types.StringType,
+		JujuController:             types.StringType,
+		JujuUsername:               types.StringType,
+		JujuPassword:               types.StringType,
+		JujuCACert:                 types.StringType,
+		JujuCharmhubCacheDir:       types.StringType,
+		JujuPlanOffline:            types.BoolType,
+		JujuFailOnMissingResources: types.BoolType,
+		JujuRequiredVersion:        types.StringType,
 	}
 
 	val, confObjErr := types.ObjectValueFrom(context.Background(), mapTypes, conf)
@@ -189,5 +209,5 @@ func TestFrameworkProviderSchema(t *testing.T) {
 	resp := provider.SchemaResponse{}
 	jujuProvider.Schema(context.Background(), req, &resp)
 	assert.Equal(t, resp.Diagnostics.HasError(), false)
-	assert.Len(t, resp.Schema.Attributes, 4)
+	assert.Len(t, resp.Schema.Attributes, 8)
 }
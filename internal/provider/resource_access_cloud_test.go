@@ -0,0 +1,62 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceAccessCloud(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	cloudName := testingCloud.CloudName()
+
+	resourceName := "juju_access_cloud.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessCloud(userName, userPassword, cloudName, "add-model"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cloud", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "access", "add-model"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:add-model", cloudName)),
+				),
+			},
+			{
+				Config: testAccResourceAccessCloud(userName, userPassword, cloudName, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "admin"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:admin:%s", cloudName, userName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessCloud(userName, userPassword, cloudName, access string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "test-user" {
+  name     = %q
+  password = %q
+}
+
+resource "juju_access_cloud" "test" {
+  cloud  = %q
+  access = %q
+  users  = [juju_user.test-user.name]
+}`, userName, userPassword, cloudName, access)
+}
@@ -0,0 +1,300 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accessControllerResource{}
+var _ resource.ResourceWithConfigure = &accessControllerResource{}
+var _ resource.ResourceWithImportState = &accessControllerResource{}
+
+func NewAccessControllerResource() resource.Resource {
+	return &accessControllerResource{}
+}
+
+type accessControllerResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type accessControllerResourceModel struct {
+	Users  types.Set    `tfsdk:"users"`
+	Access types.String `tfsdk:"access"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (a *accessControllerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_controller"
+}
+
+func (a *accessControllerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the access of users to the controller, as " +
+			"`juju grant` and `juju revoke` do for the controller. Users are granted login access " +
+			"when created, destroying a resource granting superuser access downgrades the users " +
+			"to login access.",
+		Attributes: map[string]schema.Attribute{
+			"users": schema.SetAttribute{
+				Description: "The users to grant access to.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"access": schema.StringAttribute{
+				Description: "Type of access to the controller. Users granted another access out of band " +
+					"are detected, and granted this access again.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("login", "superuser"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (a *accessControllerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = client
+	a.subCtx = tflog.NewSubsystem(ctx, LogResourceAccessController)
+}
+
+// ImportState reads the access and users from an ID of the form
+// <access>:<user1,user2>. The users are only part of the import ID,
+// the ID of the resource is the access.
+func (a *accessControllerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"ImportState Failure",
+			fmt.Sprintf("Malformed AccessController ID %q, please use format '<access>:<user1,user2>'", req.ID),
+		)
+		return
+	}
+	access, users := parts[0], strings.Split(parts[1], ",")
+	usersValue, errDiag := types.SetValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access"), access)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), usersValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), access)...)
+}
+
+func (a *accessControllerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "create")
+		return
+	}
+
+	var plan accessControllerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Users.GrantControllerAccess(juju.GrantControllerAccessInput{
+		Access: plan.Access.ValueString(),
+		Users:  users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access controller resource, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Access.ValueString())
+	a.trace(fmt.Sprintf("access controller created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the users still granted the access in the state, so
+// the others are granted it again on the next apply.
+func (a *accessControllerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "read")
+		return
+	}
+
+	if a.client.Offline {
+		return
+	}
+
+	var state accessControllerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateUsers []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := a.client.Users.ReadControllerAccess(juju.ReadControllerAccessInput{
+		Users: stateUsers,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access controller resource, got error: %s", err))
+		return
+	}
+	a.trace(fmt.Sprintf("read access controller: %q", state.ID.ValueString()))
+
+	users := []string{}
+	for _, user := range stateUsers {
+		if response.Users[user] == state.Access.ValueString() {
+			users = append(users, user)
+		}
+	}
+	usersValue, errDiag := types.SetValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Users = usersValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (a *accessControllerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "update")
+		return
+	}
+
+	var plan, state accessControllerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planUsers, stateUsers []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &planUsers, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Users.RevokeControllerAccess(juju.RevokeControllerAccessInput{
+		Access: state.Access.ValueString(),
+		Users:  getMissingUsers(stateUsers, planUsers),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access controller resource, got error: %s", err))
+		return
+	}
+
+	// All of the planned users are granted the access, those
+	// already granted it are left as they are.
+	err = a.client.Users.GrantControllerAccess(juju.GrantControllerAccessInput{
+		Access: plan.Access.ValueString(),
+		Users:  planUsers,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access controller resource, got error: %s", err))
+		return
+	}
+
+	a.trace(fmt.Sprintf("access controller updated: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (a *accessControllerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "delete")
+		return
+	}
+
+	var state accessControllerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Users.RevokeControllerAccess(juju.RevokeControllerAccessInput{
+		Access: state.Access.ValueString(),
+		Users:  users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access controller resource, got error: %s", err))
+		return
+	}
+	a.trace(fmt.Sprintf("access controller deleted: %q", state.ID.ValueString()))
+}
+
+func (a *accessControllerResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if a.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(a.subCtx, LogResourceAccessController, msg, additionalFields...)
+}
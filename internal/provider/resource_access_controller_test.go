@@ -0,0 +1,59 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceAccessController(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+
+	resourceName := "juju_access_controller.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessController(userName, userPassword, "superuser"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "superuser"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+					resource.TestCheckResourceAttr(resourceName, "id", "superuser"),
+				),
+			},
+			{
+				Config: testAccResourceAccessController(userName, userPassword, "login"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "login"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("login:%s", userName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessController(userName, userPassword, access string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "test-user" {
+  name     = %q
+  password = %q
+}
+
+resource "juju_access_controller" "test" {
+  access = %q
+  users  = [juju_user.test-user.name]
+}`, userName, userPassword, access)
+}
@@ -57,11 +57,14 @@ func (a *accessModelResource) Schema(_ context.Context, req resource.SchemaReque
 		Description: "A resource that represent a Juju Access Model.",
 		Attributes: map[string]schema.Attribute{
 			"model": schema.StringAttribute{
-				Description: "The name of the model for access management",
+				Description: "The name or UUID of the model for access management",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
 			},
 			"users": schema.ListAttribute{
 				Description: "List of users to grant access to",
@@ -69,11 +72,9 @@ func (a *accessModelResource) Schema(_ context.Context, req resource.SchemaReque
 				ElementType: types.StringType,
 			},
 			"access": schema.StringAttribute{
-				Description: "Type of access to the model",
-				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "Type of access to the model. Users granted another access out of band are " +
+					"detected, and granted this access again.",
+				Required: true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("admin", "read", "write"),
 				},
@@ -155,6 +156,11 @@ func (a *accessModelResource) Read(ctx context.Context, req resource.ReadRequest
 		addClientNotConfiguredError(&resp.Diagnostics, "access model", "read")
 		return
 	}
+
+	if a.client.Offline {
+		return
+	}
+
 	var plan accessModelResourceModel
 
 	// Get the Terraform state from the request into the plan
@@ -206,6 +212,8 @@ func (a *accessModelResource) Read(ctx context.Context, req resource.ReadRequest
 // for missing users - revoke access
 // for new users - apply access
 // access changed - apply new access
+// Users whose access was changed out of band are dropped from the
+// state by Read, they are granted the access again as new users.
 func (a *accessModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Check first if the client is configured
 	if a.client == nil {
@@ -232,7 +240,6 @@ func (a *accessModelResource) Update(ctx context.Context, req resource.UpdateReq
 	// items that could be changed
 	access := state.Access.ValueString()
 	var missingUserList []string
-	var addedUserList []string
 
 	// Get the users that are in the planned state
 	var planUsers []string
@@ -247,13 +254,12 @@ func (a *accessModelResource) Update(ctx context.Context, req resource.UpdateReq
 
 		// Get the users that are in the current state
 		var stateUsers []string
-		resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &stateUsers, false)...)
+		resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
 		missingUserList = getMissingUsers(stateUsers, planUsers)
-		addedUserList = getAddedUsers(stateUsers, planUsers)
 	}
 
 	// Check if access has changed
@@ -267,20 +273,22 @@ func (a *accessModelResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	modelName, oldAccess, _ := retrieveAccessModelDataFromID(ctx, state.ID, state.Users, &resp.Diagnostics)
+	modelName, _, _ := retrieveAccessModelDataFromID(ctx, state.ID, state.Users, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// All of the planned users are granted the access, those
+	// already granted it are left as they are.
 	err := a.client.Models.UpdateAccessModel(juju.UpdateAccessModelInput{
 		ModelName: modelName,
-		OldAccess: oldAccess,
-		Grant:     addedUserList,
+		Grant:     planUsers,
 		Revoke:    missingUserList,
 		Access:    access,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access model resource, got error: %s", err))
+		return
 	}
 	a.trace(fmt.Sprintf("updated access model resource for model %q", modelName))
 
@@ -339,23 +347,6 @@ func getMissingUsers(oldUsers, newUsers []string) []string {
 	return missing
 }
 
-func getAddedUsers(oldUsers, newUsers []string) []string {
-	var added []string
-	for _, user := range newUsers {
-		found := false
-		for _, oldUser := range oldUsers {
-			if user == oldUser {
-				found = true
-				break
-			}
-		}
-		if !found {
-			added = append(added, user)
-		}
-	}
-	return added
-}
-
 func (a *accessModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	IDstr := req.ID
 	if len(strings.Split(IDstr, ":")) != 3 {
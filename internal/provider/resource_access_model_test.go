@@ -6,6 +6,7 @@ package provider
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -66,6 +67,47 @@ func TestAcc_ResourceAccessModel(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceAccessModel_UpdateUsersAndAccess(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userName2 := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	modelName := acctest.RandomWithPrefix("tf-test-access-model")
+
+	resourceName := "juju_access_model.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAccessModelUsers(userName, userName2, userPassword, modelName, "read",
+					"juju_user.test-user.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "read"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceAccessModelUsers(userName, userName2, userPassword, modelName, "read",
+					"juju_user.test-user.name", "juju_user.test-user2.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "read"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName2),
+				),
+			},
+			{
+				Config: testAccResourceAccessModelUsers(userName, userName2, userPassword, modelName, "write",
+					"juju_user.test-user2.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "write"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName2),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceAccessModel_UpgradeProvider(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -121,3 +163,26 @@ resource "juju_access_model" "test" {
   users = [juju_user.test-user.name]
 }`, userName, userPassword, modelName, access)
 }
+
+func testAccResourceAccessModelUsers(userName, userName2, userPassword, modelName, access string, users ...string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "test-user" {
+  name = %q
+  password = %q
+}
+
+resource "juju_user" "test-user2" {
+  name = %q
+  password = %q
+}
+
+resource "juju_model" "test-model" {
+  name = %q
+}
+
+resource "juju_access_model" "test" {
+  access = %q
+  model = juju_model.test-model.name
+  users = [%s]
+}`, userName, userPassword, userName2, userPassword, modelName, access, strings.Join(users, ", "))
+}
@@ -0,0 +1,338 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accessOfferResource{}
+var _ resource.ResourceWithConfigure = &accessOfferResource{}
+var _ resource.ResourceWithImportState = &accessOfferResource{}
+
+func NewAccessOfferResource() resource.Resource {
+	return &accessOfferResource{}
+}
+
+type accessOfferResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type accessOfferResourceModel struct {
+	OfferURL types.String `tfsdk:"offer_url"`
+	Users    types.Set    `tfsdk:"users"`
+	Access   types.String `tfsdk:"access"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (a *accessOfferResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_offer"
+}
+
+func (a *accessOfferResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the access of users to an application offer, as " +
+			"`juju grant` and `juju revoke` do for offers.",
+		Attributes: map[string]schema.Attribute{
+			"offer_url": schema.StringAttribute{
+				Description: "The URL of the offer for access management.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				Description: "The users to grant access to.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"access": schema.StringAttribute{
+				Description: "Type of access to the offer. Users granted another access out of band are " +
+					"detected, and granted this access again.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "consume", "read"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (a *accessOfferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = client
+	a.subCtx = tflog.NewSubsystem(ctx, LogResourceAccessOffer)
+}
+
+// ImportState reads the offer URL, access and users from an ID of
+// the form <offer_url>:<access>:<user1,user2>. The users are only
+// part of the import ID, the ID of the resource is the offer URL and
+// the access.
+func (a *accessOfferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	offerURL, access, users, ok := accessOfferDataFromID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"ImportState Failure",
+			fmt.Sprintf("Malformed AccessOffer ID %q, please use format '<offer_url>:<access>:<user1,user2>'", req.ID),
+		)
+		return
+	}
+	usersValue, errDiag := types.SetValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("offer_url"), offerURL)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access"), access)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), usersValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), newAccessOfferIDFrom(offerURL, access))...)
+}
+
+func (a *accessOfferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access offer", "create")
+		return
+	}
+
+	var plan accessOfferResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Offers.GrantOfferAccess(&juju.GrantOfferAccessInput{
+		OfferURL: plan.OfferURL.ValueString(),
+		Access:   plan.Access.ValueString(),
+		Users:    users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access offer resource, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(newAccessOfferIDFrom(plan.OfferURL.ValueString(), plan.Access.ValueString()))
+	a.trace(fmt.Sprintf("access offer created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the users still granted the access in the state, so
+// the others are granted it again on the next apply.
+func (a *accessOfferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access offer", "read")
+		return
+	}
+
+	if a.client.Offline {
+		return
+	}
+
+	var state accessOfferResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateUsers []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := a.client.Offers.ReadOffer(&juju.ReadOfferInput{
+		OfferURL: state.OfferURL.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(handleOfferNotFoundError(ctx, a.client, err, &resp.State)...)
+		return
+	}
+	a.trace(fmt.Sprintf("read access offer: %q", state.ID.ValueString()))
+
+	users := []string{}
+	for _, user := range stateUsers {
+		if response.Users[user] == state.Access.ValueString() {
+			users = append(users, user)
+		}
+	}
+	usersValue, errDiag := types.SetValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Users = usersValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (a *accessOfferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access offer", "update")
+		return
+	}
+
+	var plan, state accessOfferResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planUsers, stateUsers []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &planUsers, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Offers.RevokeOfferAccess(&juju.RevokeOfferAccessInput{
+		OfferURL: plan.OfferURL.ValueString(),
+		Users:    getMissingUsers(stateUsers, planUsers),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access offer resource, got error: %s", err))
+		return
+	}
+
+	// All of the planned users are granted the access, those
+	// already granted it are left as they are.
+	err = a.client.Offers.GrantOfferAccess(&juju.GrantOfferAccessInput{
+		OfferURL: plan.OfferURL.ValueString(),
+		Access:   plan.Access.ValueString(),
+		Users:    planUsers,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access offer resource, got error: %s", err))
+		return
+	}
+
+	a.trace(fmt.Sprintf("access offer updated: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (a *accessOfferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access offer", "delete")
+		return
+	}
+
+	var state accessOfferResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Offers.RevokeOfferAccess(&juju.RevokeOfferAccessInput{
+		OfferURL: state.OfferURL.ValueString(),
+		Users:    users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access offer resource, got error: %s", err))
+		return
+	}
+	a.trace(fmt.Sprintf("access offer deleted: %q", state.ID.ValueString()))
+}
+
+func (a *accessOfferResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if a.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(a.subCtx, LogResourceAccessOffer, msg, additionalFields...)
+}
+
+// newAccessOfferIDFrom returns the ID of the access to an offer, it
+// does not hold the users for the ID not to change when they do.
+func newAccessOfferIDFrom(offerURL, access string) string {
+	return fmt.Sprintf("%s:%s", offerURL, access)
+}
+
+// accessOfferDataFromID splits an ID of the form
+// <offer_url>:<access>:<user1,user2> from the right, as the offer
+// URL may be prefixed by a controller name and a colon.
+func accessOfferDataFromID(id string) (string, string, []string, bool) {
+	rest, usersStr, ok := cutLast(id, ":")
+	if !ok || usersStr == "" {
+		return "", "", nil, false
+	}
+	offerURL, access, ok := cutLast(rest, ":")
+	if !ok || offerURL == "" || access == "" {
+		return "", "", nil, false
+	}
+	return offerURL, access, strings.Split(usersStr, ","), true
+}
+
+func cutLast(s, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
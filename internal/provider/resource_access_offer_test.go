@@ -0,0 +1,92 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceAccessOffer(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-access-offer")
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+	offerURL := fmt.Sprintf("admin/%s.this", modelName)
+
+	resourceName := "juju_access_offer.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceAccessOffer(modelName, userName, userPassword, "bogus"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match.*"),
+			},
+			{
+				Config: testAccResourceAccessOffer(modelName, userName, userPassword, "consume"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "offer_url", offerURL),
+					resource.TestCheckResourceAttr(resourceName, "access", "consume"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:consume", offerURL)),
+				),
+			},
+			{
+				Config: testAccResourceAccessOffer(modelName, userName, userPassword, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "admin"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:admin:%s", offerURL, userName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessOffer(modelName, userName, userPassword, access string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "this"
+
+  charm {
+    name    = "postgresql"
+    channel = "latest/stable"
+    base    = "ubuntu@22.04"
+  }
+}
+
+resource "juju_offer" "this" {
+  model            = juju_model.this.name
+  application_name = juju_application.this.name
+  endpoint         = "db"
+}
+
+resource "juju_user" "this" {
+  name     = %q
+  password = %q
+}
+
+resource "juju_access_offer" "this" {
+  offer_url = juju_offer.this.url
+  access    = %q
+  users     = [juju_user.this.name]
+}`, modelName, userName, userPassword, access)
+}
@@ -6,9 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,7 +24,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -27,23 +34,47 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/juju/errors"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/model"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 const (
-	CharmKey     = "charm"
-	CidrsKey     = "cidrs"
-	ConfigKey    = "config"
-	EndpointsKey = "endpoints"
-	ExposeKey    = "expose"
-	SpacesKey    = "spaces"
+	ApplyGroupKey         = "apply_group"
+	ApplyPriorityKey      = "apply_priority"
+	CharmKey              = "charm"
+	CidrsKey              = "cidrs"
+	ConfigKey             = "config"
+	ConfigDriftScopeKey   = "config_drift_scope"
+	EndpointsKey          = "endpoints"
+	ExposeKey             = "expose"
+	ForceBaseKey          = "force_base"
+	IgnoreRefreshDriftKey = "ignore_refresh_drift"
+	K8sServiceKey         = "kubernetes_service"
+	MachinesKey           = "machines"
+	ResourceHashesKey     = "resource_hashes"
+	ResourcesKey          = "resources"
+	SensitiveConfigKey    = "sensitive_config"
+	SpacesKey             = "spaces"
+	UnsetConfigKey        = "unset_config"
+)
+
+// The config keys of an application tracked for drift.
+const (
+	// ConfigDriftScopeAll tracks the keys set to a value other than
+	// their default, whether they are set by terraform or not.
+	ConfigDriftScopeAll = "all"
+	// ConfigDriftScopeManaged only tracks the keys set by terraform.
+	ConfigDriftScopeManaged = "managed"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &applicationResource{}
 var _ resource.ResourceWithConfigure = &applicationResource{}
 var _ resource.ResourceWithImportState = &applicationResource{}
+var _ resource.ResourceWithValidateConfig = &applicationResource{}
+var _ resource.ResourceWithModifyPlan = &applicationResource{}
+var _ resource.ResourceWithUpgradeState = &applicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &applicationResource{}
@@ -60,18 +91,41 @@ type applicationResource struct {
 // tfsdk must match user resource schema attribute names.
 type applicationResourceModel struct {
 	ApplicationName types.String `tfsdk:"name"`
-	Charm           types.List   `tfsdk:"charm"`
-	Config          types.Map    `tfsdk:"config"`
-	Constraints     types.String `tfsdk:"constraints"`
-	Expose          types.List   `tfsdk:"expose"`
-	ModelName       types.String `tfsdk:"model"`
-	Placement       types.String `tfsdk:"placement"`
+	// ApplyGroup and ApplyPriority order the applications applied
+	// at the same time, they are not read from the controller.
+	ApplyGroup    types.String `tfsdk:"apply_group"`
+	ApplyPriority types.Int64  `tfsdk:"apply_priority"`
+	Charm         types.List   `tfsdk:"charm"`
+	Config        types.Map    `tfsdk:"config"`
+	// ConfigDriftScope selects the config keys read back from the
+	// controller.
+	ConfigDriftScope types.String     `tfsdk:"config_drift_scope"`
+	Constraints      ConstraintsValue `tfsdk:"constraints"`
+	ConstraintsMap   types.Object     `tfsdk:"constraints_map"`
+	// ConstraintsDrift is the policy applied when the constraints
+	// of the controller differ from those of the state.
+	ConstraintsDrift types.String `tfsdk:"constraints_drift"`
+	// DeletionProtection is only used when the application is
+	// destroyed.
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	Expose             types.List `tfsdk:"expose"`
+	// IgnoreRefreshDrift keeps the charm revision and channel
+	// from state when the charm is refreshed outside of terraform.
+	IgnoreRefreshDrift types.Bool   `tfsdk:"ignore_refresh_drift"`
+	K8sService         types.Object `tfsdk:"kubernetes_service"`
+	Machines           types.Set    `tfsdk:"machines"`
+	ModelName          types.String `tfsdk:"model"`
+	Placement          types.String `tfsdk:"placement"`
 	// TODO - remove Principal when we version the schema
 	// and remove deprecated elements. Once we create upgrade
 	// functionality it can be removed from the structure.
-	Principal types.Bool  `tfsdk:"principal"`
-	Trust     types.Bool  `tfsdk:"trust"`
-	UnitCount types.Int64 `tfsdk:"units"`
+	Principal       types.Bool  `tfsdk:"principal"`
+	Resources       types.Map   `tfsdk:"resources"`
+	ResourceHashes  types.Map   `tfsdk:"resource_hashes"`
+	SensitiveConfig types.Map   `tfsdk:"sensitive_config"`
+	Trust           types.Bool  `tfsdk:"trust"`
+	UnitCount       types.Int64 `tfsdk:"units"`
+	UnsetConfig     types.List  `tfsdk:"unset_config"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -105,8 +159,13 @@ func (r *applicationResource) Configure(ctx context.Context, req resource.Config
 
 func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 sets the base of the charm from its series,
+		// version 2 keys the ID on the model UUID.
+		Version: 2,
 		Description: "A resource that represents a single Juju application deployment from a charm. Deployment of bundles" +
-			" is not supported.",
+			" is not supported. Plans warn about the charms which cannot be resolved, and the machines and spaces" +
+			" which do not exist, as deploying the application would fail. A create which fails once the application" +
+			" is deployed, e.g. when exposing it, is resumed by the next apply rather than deploying it again.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Description: "A custom name for the application deployment. If empty, uses the charm's name.",
@@ -116,13 +175,19 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					applicationNameValidator(),
+				},
 			},
 			"model": schema.StringAttribute{
-				Description: "The name of the model where the application is to be deployed.",
+				Description: "The name or UUID of the model where the application is to be deployed.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
 			},
 			"units": schema.Int64Attribute{
 				Description: "The number of application units to deploy for the charm.",
@@ -135,22 +200,102 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:    true,
 				ElementType: types.StringType,
 			},
-			"constraints": schema.StringAttribute{
-				Description: "Constraints imposed on this application.",
+			ConfigDriftScopeKey: schema.StringAttribute{
+				Description: fmt.Sprintf("The config keys read back from the controller to detect drift: %q tracks "+
+					"every key set to a value other than its default, %q only tracks the keys of `config` and "+
+					"`sensitive_config`, e.g. for charms writing generated values back to their options. Defaults to %q.",
+					ConfigDriftScopeAll, ConfigDriftScopeManaged, ConfigDriftScopeAll),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(ConfigDriftScopeAll),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ConfigDriftScopeAll, ConfigDriftScopeManaged),
+				},
+			},
+			ResourcesKey: schema.MapAttribute{
+				Description: "Charm resources to use, keyed by resource name. A value is either a charmhub resource " +
+					"revision number, or the path of a local file prefixed with \"file:\", e.g. \"file:./tls.pem\". " +
+					"Resources not specified use the latest charmhub revision. Removing a resource from the map " +
+					"keeps its current content.",
 				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(\d+|file:.+)$`),
+						"must be a revision number or a filename prefixed with \"file:\"",
+					)),
+				},
+			},
+			ResourceHashesKey: schema.MapAttribute{
+				Description: "The SHA-384 hashes of the local files used as resources, keyed by resource name. " +
+					"Changes to the content of the files are uploaded to the application.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			SensitiveConfigKey: schema.MapAttribute{
+				Description: "Application specific configuration holding sensitive values, such as passwords or API keys. " +
+					"Values are masked in the plan output. Charm config options of type secret not found in config are " +
+					"tracked here. Must evaluate to a string, integer or boolean.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			UnsetConfigKey: schema.ListAttribute{
+				Description: "A list of application configuration keys to reset to the charm default value. The keys " +
+					"are reset when the application is created, and when they are added to the list. Keys removed " +
+					"from the config map are also reset to their default value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"constraints": schema.StringAttribute{
+				Description: "Constraints imposed on this application. Constraints are compared by value, the order " +
+					"and units used do not matter. Changing the constraints replaces all of the application " +
+					"constraints, as `juju set-constraints` does, and only applies to units added afterwards.",
+				CustomType: ConstraintsType{},
+				Optional:   true,
 				// Set as "computed" to pre-populate and preserve any implicit constraints
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"constraints_map": constraintsMapAttribute("Constraints imposed on this application, as an object " +
+				"rather than a string. It conflicts with `constraints`, which holds the resulting constraints. " +
+				"Memory and disk sizes are in MiB."),
+			"constraints_drift":   constraintsDriftAttribute(),
+			DeletionProtectionKey: deletionProtectionAttribute("application"),
 			"trust": schema.BoolAttribute{
 				Description: "Set the trust for the application.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			ApplyGroupKey: schema.StringAttribute{
+				Description: "The group of applications this application is ordered with when terraform applies " +
+					"them at the same time, without depending on each other. Within a group, the applications of a " +
+					"lower `apply_priority` are created and updated first, and destroyed last. The ordering is best " +
+					"effort: an application only waits for the applications of its group being applied when it starts, " +
+					"use `depends_on` when the order is required.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			ApplyPriorityKey: schema.Int64Attribute{
+				Description: "The priority of the application within its `apply_group`, 0 by default. The " +
+					"applications of the same priority are applied at the same time.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot(ApplyGroupKey)),
+				},
+			},
+			IgnoreRefreshDriftKey: schema.BoolAttribute{
+				Description: "Ignore charm revision and channel changes made outside of terraform, e.g. by an " +
+					"automatic refresh. By default such a change is reported as drift and reverted on the next apply.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"placement": schema.StringAttribute{
 				Description: "Specify the target location for the application's units",
 				Optional:    true,
@@ -160,6 +305,37 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			MachinesKey: schema.SetAttribute{
+				Description: "A set of machine ids to deploy the application units to, one unit per machine. " +
+					"Units are added or removed as the set changes. Conflicts with units and placement.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("units"),
+						path.MatchRoot("placement"),
+					}...),
+				},
+			},
+			K8sServiceKey: schema.SingleNestedAttribute{
+				Description: "The kubernetes service of the application. Only available in kubernetes models.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"provider_id": schema.StringAttribute{
+						Description: "The kubernetes id of the service.",
+						Computed:    true,
+					},
+					"address": schema.StringAttribute{
+						Description: "The address of the service. The load balancer ingress address if one has " +
+							"been provisioned, otherwise the cluster IP.",
+						Computed: true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"principal": schema.BoolAttribute{
 				Description: "Whether this is a Principal application",
 				Computed:    true,
@@ -231,6 +407,14 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 								stringIsBaseValidator{},
 							},
 						},
+						ForceBaseKey: schema.BoolAttribute{
+							Description: "Deploy or refresh the charm even if it does not declare support for the requested " +
+								"operating system. Equivalent to the `--force` flag of `juju deploy` and the `--force-base` " +
+								"flag of `juju refresh`, useful when migrating applications to a new operating system.",
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
 					},
 				},
 				Validators: []validator.List{
@@ -264,14 +448,313 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+// ValidateConfig is called during terraform validate. It ensures a
+// configuration key is set in only one of config, sensitive_config
+// and unset_config.
+func (r *applicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData applicationResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configData.Config.IsUnknown() || configData.SensitiveConfig.IsUnknown() || configData.UnsetConfig.IsUnknown() {
+		return
+	}
+	configMap := map[string]types.String{}
+	resp.Diagnostics.Append(configData.Config.ElementsAs(ctx, &configMap, false)...)
+	sensitiveConfigMap := map[string]types.String{}
+	resp.Diagnostics.Append(configData.SensitiveConfig.ElementsAs(ctx, &sensitiveConfigMap, false)...)
+	var unsetKeys []types.String
+	resp.Diagnostics.Append(configData.UnsetConfig.ElementsAs(ctx, &unsetKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key := range sensitiveConfigMap {
+		if _, found := configMap[key]; found {
+			resp.Diagnostics.AddAttributeError(path.Root(SensitiveConfigKey), "Attribute Error",
+				fmt.Sprintf("the config key %q can not be set in both \"config\" and \"sensitive_config\".", key))
+		}
+	}
+	for _, key := range unsetKeys {
+		if _, found := configMap[key.ValueString()]; found {
+			resp.Diagnostics.AddAttributeError(path.Root(UnsetConfigKey), "Attribute Error",
+				fmt.Sprintf("the config key %q can not be set in both \"config\" and \"unset_config\".", key.ValueString()))
+		}
+		if _, found := sensitiveConfigMap[key.ValueString()]; found {
+			resp.Diagnostics.AddAttributeError(path.Root(UnsetConfigKey), "Attribute Error",
+				fmt.Sprintf("the config key %q can not be set in both \"sensitive_config\" and \"unset_config\".", key.ValueString()))
+		}
+	}
+}
+
+// ModifyPlan is called when the provider has an opportunity to modify
+// the plan. When machines are specified, the number of units follows
+// the number of machines and the placement is recomputed. The hashes
+// of local files used as resources are computed to detect changes in
+// their content. The constraints are read back from juju when the
+// constraints_map changes. The plan fails on constraints drift when
+// constraints_drift is "error". Constraints Kubernetes models ignore
+// are warned about.
+func (r *applicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The plan is null when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(checkDeletionProtection(ctx, req.State, "application")...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.modifyPlanMachines(ctx, req, resp)...)
+	resp.Diagnostics.Append(r.modifyPlanResourceHashes(ctx, req, resp)...)
+	resp.Diagnostics.Append(r.modifyPlanConstraintsMap(ctx, req, resp)...)
+	resp.Diagnostics.Append(checkConstraintsDrift(ctx, req)...)
+	resp.Diagnostics.Append(r.warnUnsupportedConstraints(ctx, req)...)
+	resp.Diagnostics.Append(r.precheckDeploy(ctx, req)...)
+}
+
+// modifyPlanConstraintsMap marks the constraints unknown when the
+// constraints_map changes, they are read back from juju.
+func (r *applicationResource) modifyPlanConstraintsMap(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var planMap, stateMap types.Object
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("constraints_map"), &planMap)...)
+	if diags.HasError() || planMap.IsNull() || req.State.Raw.IsNull() {
+		return diags
+	}
+	diags.Append(req.State.GetAttribute(ctx, path.Root("constraints_map"), &stateMap)...)
+	if diags.HasError() || planMap.Equal(stateMap) {
+		return diags
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("constraints"), ConstraintsValue{StringValue: types.StringUnknown()})...)
+	return diags
+}
+
+// warnUnsupportedConstraints warns about the constraints Kubernetes
+// models ignore. The model type is only known once the model exists.
+func (r *applicationResource) warnUnsupportedConstraints(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil || r.client.Offline {
+		return diags
+	}
+	var modelName types.String
+	var planConstraints ConstraintsValue
+	var planMap types.Object
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("model"), &modelName)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("constraints"), &planConstraints)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("constraints_map"), &planMap)...)
+	if diags.HasError() || modelName.IsUnknown() {
+		return diags
+	}
+	if !planMap.IsNull() {
+		mapConstraints, dErr := constraintsFromMap(ctx, planMap)
+		if dErr.HasError() {
+			return diags
+		}
+		planConstraints = NewConstraintsValue(mapConstraints)
+	}
+	unsupported := planConstraints.UnsupportedOnKubernetes()
+	if len(unsupported) == 0 {
+		return diags
+	}
+	modelType, err := r.client.Applications.ModelType(modelName.ValueString())
+	if err != nil || modelType != model.CAAS {
+		return diags
+	}
+	diags.AddAttributeWarning(path.Root("constraints"), "Unsupported Constraints",
+		fmt.Sprintf("Constraints %q are ignored by applications of Kubernetes model %q.",
+			strings.Join(unsupported, ", "), modelName.ValueString()))
+	return diags
+}
+
+// precheckDeploy warns about the charm, placement and spaces of the
+// application which would make the apply fail, as the controller checks
+// them when deploying. They are checked when the application is created
+// or they change. The checks are skipped when they cannot be made, e.g.
+// when the model is created by the same plan.
+func (r *applicationResource) precheckDeploy(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil || r.client.Offline {
+		return diags
+	}
+	var plan applicationResourceModel
+	diags.Append(req.Plan.Get(ctx, &plan)...)
+	if diags.HasError() || plan.ModelName.IsUnknown() || plan.Charm.IsUnknown() ||
+		plan.Expose.IsUnknown() || plan.ConstraintsMap.IsUnknown() || plan.Machines.IsUnknown() {
+		return diags
+	}
+	if !req.State.Raw.IsNull() {
+		var state applicationResourceModel
+		diags.Append(req.State.Get(ctx, &state)...)
+		if diags.HasError() {
+			return diags
+		}
+		if plan.Charm.Equal(state.Charm) && plan.Placement.Equal(state.Placement) &&
+			plan.Machines.Equal(state.Machines) && plan.Expose.Equal(state.Expose) &&
+			plan.Constraints.Equal(state.Constraints) && plan.ConstraintsMap.Equal(state.ConstraintsMap) {
+			return diags
+		}
+	}
+
+	var charms []nestedCharm
+	diags.Append(plan.Charm.ElementsAs(ctx, &charms, false)...)
+	if diags.HasError() || len(charms) != 1 {
+		return diags
+	}
+	planCharm := charms[0]
+	if planCharm.Name.IsUnknown() {
+		return diags
+	}
+	channel := "stable"
+	if !planCharm.Channel.IsUnknown() && !planCharm.Channel.IsNull() {
+		channel = planCharm.Channel.ValueString()
+	}
+	revision := juju.UnspecifiedRevision
+	if !planCharm.Revision.IsUnknown() && !planCharm.Revision.IsNull() {
+		revision = int(planCharm.Revision.ValueInt64())
+	}
+
+	var expose map[string]interface{}
+	if !plan.Expose.IsNull() {
+		var exposeSlice []nestedExpose
+		diags.Append(plan.Expose.ElementsAs(ctx, &exposeSlice, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		if len(exposeSlice) == 1 {
+			expose = exposeSlice[0].transformToMapStringInterface()
+		}
+	}
+
+	parsedConstraints := constraints.Value{}
+	if !plan.ConstraintsMap.IsNull() {
+		var dErr diag.Diagnostics
+		parsedConstraints, dErr = constraintsFromMap(ctx, plan.ConstraintsMap)
+		if dErr.HasError() {
+			return diags
+		}
+	} else if !plan.Constraints.IsUnknown() && plan.Constraints.ValueString() != "" {
+		var err error
+		parsedConstraints, err = constraints.Parse(plan.Constraints.ValueString())
+		if err != nil {
+			return diags
+		}
+	}
+
+	placement := ""
+	if !plan.Machines.IsNull() {
+		var machines []string
+		diags.Append(plan.Machines.ElementsAs(ctx, &machines, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		placement = strings.Join(machines, ",")
+	} else if !plan.Placement.IsUnknown() {
+		placement = plan.Placement.ValueString()
+	}
+
+	warnings, err := r.client.Applications.PrecheckApplication(&juju.CreateApplicationInput{
+		ApplicationName: plan.ApplicationName.ValueString(),
+		ModelName:       plan.ModelName.ValueString(),
+		CharmName:       planCharm.Name.ValueString(),
+		CharmChannel:    channel,
+		CharmRevision:   revision,
+		CharmBase:       planCharm.Base.ValueString(),
+		CharmSeries:     planCharm.Series.ValueString(),
+		CharmForceBase:  planCharm.ForceBase.ValueBool(),
+		Constraints:     parsedConstraints,
+		Expose:          expose,
+		Placement:       placement,
+	})
+	if err != nil {
+		r.trace("deploy pre-checks skipped", map[string]interface{}{"error": err.Error()})
+		return diags
+	}
+	for _, warning := range warnings {
+		diags.AddWarning("Deploy Pre-check", fmt.Sprintf("Applying the plan is likely to fail: %s.", warning))
+	}
+	return diags
+}
+
+func (r *applicationResource) modifyPlanMachines(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var planMachines, stateMachines types.Set
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root(MachinesKey), &planMachines)...)
+	if diags.HasError() || planMachines.IsNull() {
+		return diags
+	}
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root(MachinesKey), &stateMachines)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	units := types.Int64Unknown()
+	if !planMachines.IsUnknown() {
+		units = types.Int64Value(int64(len(planMachines.Elements())))
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("units"), units)...)
+	if !planMachines.Equal(stateMachines) {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("placement"), types.StringUnknown())...)
+	}
+	return diags
+}
+
+func (r *applicationResource) modifyPlanResourceHashes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var planResources types.Map
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root(ResourcesKey), &planResources)...)
+	if diags.HasError() {
+		return diags
+	}
+	if planResources.IsUnknown() {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root(ResourceHashesKey), types.MapUnknown(types.StringType))...)
+		return diags
+	}
+
+	hashes := make(map[string]string)
+	for name, element := range planResources.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() {
+			diags.Append(resp.Plan.SetAttribute(ctx, path.Root(ResourceHashesKey), types.MapUnknown(types.StringType))...)
+			return diags
+		}
+		filename, isFile := juju.ResourceFilename(value.ValueString())
+		if !isFile {
+			continue
+		}
+		fingerprint, err := juju.ResourceFileFingerprint(filename)
+		if err != nil {
+			diags.AddAttributeError(path.Root(ResourcesKey).AtMapKey(name), "Resource Error",
+				fmt.Sprintf("Unable to read resource file, got error: %s", err))
+			return diags
+		}
+		hashes[name] = fingerprint
+	}
+
+	hashesValue := types.MapNull(types.StringType)
+	if len(hashes) > 0 {
+		var dErr diag.Diagnostics
+		hashesValue, dErr = types.MapValueFrom(ctx, types.StringType, hashes)
+		diags.Append(dErr...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root(ResourceHashesKey), hashesValue)...)
+	return diags
+}
+
 // nestedCharm represents the single element of the charm ListNestedBlock
 // of the in the application resource schema
 type nestedCharm struct {
-	Name     types.String `tfsdk:"name"`
-	Channel  types.String `tfsdk:"channel"`
-	Revision types.Int64  `tfsdk:"revision"`
-	Base     types.String `tfsdk:"base"`
-	Series   types.String `tfsdk:"series"`
+	Name      types.String `tfsdk:"name"`
+	Channel   types.String `tfsdk:"channel"`
+	Revision  types.Int64  `tfsdk:"revision"`
+	Base      types.String `tfsdk:"base"`
+	Series    types.String `tfsdk:"series"`
+	ForceBase types.Bool   `tfsdk:"force_base"`
 }
 
 // nestedExpose represents the single element of expose ListNestedBlock
@@ -298,6 +781,30 @@ func (n nestedExpose) transformToMapStringInterface() map[string]interface{} {
 	return expose
 }
 
+// nestedK8sService represents the computed kubernetes_service
+// attribute of the application resource schema.
+type nestedK8sService struct {
+	ProviderID types.String `tfsdk:"provider_id"`
+	Address    types.String `tfsdk:"address"`
+}
+
+var k8sServiceAttrTypes = map[string]attr.Type{
+	"provider_id": types.StringType,
+	"address":     types.StringType,
+}
+
+// newK8sServiceValue returns the kubernetes_service value for the
+// application. It is null in machine models.
+func newK8sServiceValue(ctx context.Context, response *juju.ReadApplicationResponse) (types.Object, diag.Diagnostics) {
+	if response.ServiceProviderID == "" && response.ServiceAddress == "" {
+		return types.ObjectNull(k8sServiceAttrTypes), nil
+	}
+	return types.ObjectValueFrom(ctx, k8sServiceAttrTypes, nestedK8sService{
+		ProviderID: types.StringValue(response.ServiceProviderID),
+		Address:    types.StringValue(response.ServiceAddress),
+	})
+}
+
 func parseNestedExpose(value map[string]interface{}) nestedExpose {
 	// an empty expose structure, indicates exposure
 	// the values are optional.
@@ -334,6 +841,13 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	r.trace("Create", applicationResourceModelForLogging(ctx, &plan))
 
+	release, dErr := r.waitApplyTurn(ctx, plan, false)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+
 	charms := []nestedCharm{}
 	resp.Diagnostics.Append(plan.Charm.ElementsAs(ctx, &charms, false)...)
 	if resp.Diagnostics.HasError() {
@@ -352,9 +866,9 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	// TODO: investigate using map[string]string here and let
 	// terraform do the conversion, will help in CreateApplication.
-	configField := map[string]string{}
-	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &configField, false)...)
-	if resp.Diagnostics.HasError() {
+	configField, dErr := mergeConfig(ctx, plan.Config, plan.SensitiveConfig)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
 		return
 	}
 
@@ -376,7 +890,10 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	var parsedConstraints = constraints.Value{}
-	if plan.Constraints.ValueString() != "" {
+	if !plan.ConstraintsMap.IsNull() {
+		parsedConstraints, dErr = constraintsFromMap(ctx, plan.ConstraintsMap)
+		resp.Diagnostics.Append(dErr...)
+	} else if plan.Constraints.ValueString() != "" {
 		var err error
 		parsedConstraints, err = constraints.Parse(plan.Constraints.ValueString())
 		if err != nil {
@@ -384,7 +901,33 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
+	var resources map[string]string
+	resp.Diagnostics.Append(plan.Resources.ElementsAs(ctx, &resources, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// When machines are specified, deploy one unit to each of them.
+	placement := plan.Placement.ValueString()
+	unitCount := int(plan.UnitCount.ValueInt64())
+	if !plan.Machines.IsNull() {
+		var machines []string
+		resp.Diagnostics.Append(plan.Machines.ElementsAs(ctx, &machines, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		placement = strings.Join(machines, ",")
+		unitCount = len(machines)
+	}
+
 	modelName := plan.ModelName.ValueString()
+	// The ID is keyed on the model UUID, read before the application
+	// is deployed.
+	modelUUID, err := modelUUIDForID(r.client, modelName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the UUID of model %q, got error: %s", modelName, err))
+		return
+	}
 	createResp, err := r.client.Applications.CreateApplication(ctx,
 		&juju.CreateApplicationInput{
 			ApplicationName: plan.ApplicationName.ValueString(),
@@ -394,12 +937,14 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			CharmRevision:   revision,
 			CharmBase:       planCharm.Base.ValueString(),
 			CharmSeries:     planCharm.Series.ValueString(),
-			Units:           int(plan.UnitCount.ValueInt64()),
+			CharmForceBase:  planCharm.ForceBase.ValueBool(),
+			Units:           unitCount,
 			Config:          configField,
 			Constraints:     parsedConstraints,
 			Trust:           plan.Trust.ValueBool(),
 			Expose:          expose,
-			Placement:       plan.Placement.ValueString(),
+			Placement:       placement,
+			Resources:       resources,
 		},
 	)
 	if err != nil {
@@ -408,18 +953,47 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	r.trace(fmt.Sprintf("create application resource %q", createResp.AppName))
 
-	readResp, err := r.client.Applications.ReadApplicationWithRetryOnNotFound(ctx, &juju.ReadApplicationInput{
+	// The keys of unset_config are reset to the charm default, e.g.
+	// when the create resumes an application already deployed.
+	if !plan.UnsetConfig.IsNull() {
+		var unsetKeys []string
+		resp.Diagnostics.Append(plan.UnsetConfig.ElementsAs(ctx, &unsetKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(unsetKeys) > 0 {
+			err = r.client.Applications.UpdateApplication(&juju.UpdateApplicationInput{
+				ModelName:   modelName,
+				AppName:     createResp.AppName,
+				UnsetConfig: unsetKeys,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unset application config, got error: %s", err))
+				return
+			}
+		}
+	}
+
+	readInput := &juju.ReadApplicationInput{
 		ModelName: modelName,
 		AppName:   createResp.AppName,
-	})
+	}
+	resp.Diagnostics.Append(r.waitForUnits(ctx, readInput, unitCount)...)
+	readResp, err := r.client.Applications.ReadApplicationWithRetryOnNotFound(ctx, readInput)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
 		return
 	}
 	r.trace(fmt.Sprintf("read application resource %q", createResp.AppName))
+	resp.Diagnostics.Append(sensitiveConfigWarnings(ctx, plan.Config, readResp.Config)...)
 
 	// Save plan into Terraform state
-	plan.Constraints = types.StringValue(readResp.Constraints.String())
+	plan.K8sService, dErr = newK8sServiceValue(ctx, readResp)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	plan.Constraints = NewConstraintsValue(readResp.Constraints)
 	plan.Placement = types.StringValue(readResp.Placement)
 	plan.Principal = types.BoolNull()
 	plan.ApplicationName = types.StringValue(createResp.AppName)
@@ -428,23 +1002,21 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	planCharm.Series = types.StringValue(readResp.Series)
 	planCharm.Channel = types.StringValue(readResp.Channel)
 	charmType := req.Config.Schema.GetBlocks()[CharmKey].(schema.ListNestedBlock).NestedObject.Type()
-	var dErr diag.Diagnostics
 	plan.Charm, dErr = types.ListValueFrom(ctx, charmType, []nestedCharm{planCharm})
 	if dErr.HasError() {
 		resp.Diagnostics.Append(dErr...)
 		return
 	}
-	plan.ID = types.StringValue(newAppID(plan.ModelName.ValueString(), createResp.AppName))
+	plan.ID = types.StringValue(newAppID(modelUUID, createResp.AppName))
 	r.trace("Created", applicationResourceModelForLogging(ctx, &plan))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func handleApplicationNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
-	if errors.As(err, &juju.ApplicationNotFoundError) {
+func handleApplicationNotFoundError(ctx context.Context, client *juju.Client, err error, st *tfsdk.State) diag.Diagnostics {
+	if errors.As(err, &juju.ApplicationNotFoundError) || isModelNotFound(err) {
 		// Application manually removed
-		st.RemoveResource(ctx)
-		return diag.Diagnostics{}
+		return removeMissingResource(ctx, client, st, "application", err)
 	}
 	var diags diag.Diagnostics
 	diags.AddError("Not Found", err.Error())
@@ -462,6 +1034,11 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		addClientNotConfiguredError(&resp.Diagnostics, "application", "read")
 		return
 	}
+
+	if r.client.Offline {
+		return
+	}
+
 	var state applicationResourceModel
 
 	// Read Terraform prior state into the model
@@ -481,11 +1058,12 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	response, err := r.client.Applications.ReadApplication(&juju.ReadApplicationInput{
-		ModelName: modelName,
-		AppName:   appName,
+		ModelName:    modelName,
+		AppName:      appName,
+		CachedStatus: true,
 	})
 	if err != nil {
-		resp.Diagnostics.Append(handleApplicationNotFoundError(ctx, err, &resp.State)...)
+		resp.Diagnostics.Append(handleApplicationNotFoundError(ctx, r.client, err, &resp.State)...)
 		return
 	}
 	if response == nil {
@@ -494,21 +1072,79 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	r.trace(fmt.Sprintf("read application resource %q", appName))
 
 	state.ApplicationName = types.StringValue(appName)
-	state.ModelName = types.StringValue(modelName)
+	// The model is only read from the ID when the application is
+	// imported.
+	if state.ModelName.IsNull() {
+		importedModel, err := importedModelName(r.client, modelName)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model %q, got error: %s", modelName, err))
+			return
+		}
+		state.ModelName = types.StringValue(importedModel)
+	}
 
 	// Use the response to fill in state
 	state.Placement = types.StringValue(response.Placement)
 	state.Principal = types.BoolNull()
 	state.UnitCount = types.Int64Value(int64(response.Units))
 	state.Trust = types.BoolValue(response.Trust)
+	state.K8sService, dErr = newK8sServiceValue(ctx, response)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	state.Resources, state.ResourceHashes, dErr = refreshResources(ctx, state.Resources, state.ResourceHashes, response.Resources)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	// only track the machines if they are used by the plan
+	if !state.Machines.IsNull() {
+		state.Machines, dErr = types.SetValueFrom(ctx, types.StringType, response.Machines)
+		if dErr.HasError() {
+			resp.Diagnostics.Append(dErr...)
+			return
+		}
+	}
 
 	// state requiring transformation
 	dataCharm := nestedCharm{
-		Name:     types.StringValue(response.Name),
-		Channel:  types.StringValue(response.Channel),
-		Revision: types.Int64Value(int64(response.Revision)),
-		Base:     types.StringValue(response.Base),
-		Series:   types.StringValue(response.Series),
+		Name:      types.StringValue(response.Name),
+		Channel:   types.StringValue(response.Channel),
+		Revision:  types.Int64Value(int64(response.Revision)),
+		Base:      types.StringValue(response.Base),
+		Series:    types.StringValue(response.Series),
+		ForceBase: types.BoolValue(false),
+	}
+	var stateCharms []nestedCharm
+	resp.Diagnostics.Append(state.Charm.ElementsAs(ctx, &stateCharms, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.IgnoreRefreshDrift.IsNull() {
+		state.IgnoreRefreshDrift = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if len(stateCharms) == 1 {
+		stateCharm := stateCharms[0]
+		// force_base is not known by juju, keep the value from state.
+		if !stateCharm.ForceBase.IsNull() {
+			dataCharm.ForceBase = stateCharm.ForceBase
+		}
+		if refreshed := !stateCharm.Revision.Equal(dataCharm.Revision) || !stateCharm.Channel.Equal(dataCharm.Channel); refreshed {
+			if state.IgnoreRefreshDrift.ValueBool() {
+				dataCharm.Revision = stateCharm.Revision
+				dataCharm.Channel = stateCharm.Channel
+			} else {
+				resp.Diagnostics.AddWarning("Charm Refreshed",
+					fmt.Sprintf("The charm of application %q was refreshed outside of terraform from %s revision %d "+
+						"to %s revision %d. Set %s to ignore this change.",
+						appName, stateCharm.Channel.ValueString(), stateCharm.Revision.ValueInt64(),
+						response.Channel, response.Revision, IgnoreRefreshDriftKey))
+			}
+		}
 	}
 	charmType := req.State.Schema.GetBlocks()[CharmKey].(schema.ListNestedBlock).NestedObject.Type()
 	state.Charm, dErr = types.ListValueFrom(ctx, charmType, []nestedCharm{dataCharm})
@@ -517,9 +1153,27 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	if state.ConstraintsDrift.IsNull() {
+		state.ConstraintsDrift = types.StringValue(ConstraintsDriftUpdate)
+	}
 	// constraints do not apply to subordinate applications.
 	if response.Principal {
-		state.Constraints = types.StringValue(response.Constraints.String())
+		readConstraints := NewConstraintsValue(response.Constraints)
+		readMap, dErr := constraintsMapFromValue(ctx, state.ConstraintsMap, response.Constraints)
+		if dErr.HasError() {
+			resp.Diagnostics.Append(dErr...)
+			return
+		}
+		keep, dErr := keepStateConstraints(ctx, state.ConstraintsDrift, state.Constraints, readConstraints,
+			state.ConstraintsMap, readMap, resp.Private)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !keep {
+			state.Constraints = readConstraints
+			state.ConstraintsMap = readMap
+		}
 	}
 	exposeType := req.State.Schema.GetBlocks()[ExposeKey].(schema.ListNestedBlock).NestedObject.Type()
 	if response.Expose != nil {
@@ -536,7 +1190,17 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	// we only set changes if there is any difference between
 	// the previous and the current config values
 	configType := req.State.Schema.GetAttributes()[ConfigKey].(schema.MapAttribute).ElementType
-	state.Config, dErr = r.configureConfigData(ctx, configType, state.Config, response.Config)
+	if state.ConfigDriftScope.IsNull() {
+		state.ConfigDriftScope = types.StringValue(ConfigDriftScopeAll)
+	}
+	managedOnly := state.ConfigDriftScope.ValueString() == ConfigDriftScopeManaged
+	respConfig, respSensitiveConfig := splitSensitiveConfig(state.Config, state.SensitiveConfig, response.Config)
+	state.Config, dErr = r.configureConfigData(ctx, configType, state.Config, respConfig, managedOnly)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	state.SensitiveConfig, dErr = r.configureConfigData(ctx, configType, state.SensitiveConfig, respSensitiveConfig, managedOnly)
 	if dErr.HasError() {
 		resp.Diagnostics.Append(dErr...)
 		return
@@ -546,10 +1210,101 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *applicationResource) configureConfigData(ctx context.Context, configType attr.Type, config types.Map, respCfg map[string]juju.ConfigEntry) (types.Map, diag.Diagnostics) {
+// refreshResources updates the tracked resources with the values read
+// from juju. Charmhub revisions are refreshed, and the hashes of uploaded
+// files are replaced by the fingerprints known to juju. The hash of a
+// resource no longer uploaded is removed, so the file is uploaded again.
+func refreshResources(ctx context.Context, resources, hashes types.Map, respResources map[string]juju.ResourceEntry) (types.Map, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if resources.IsNull() {
+		return resources, hashes, diags
+	}
+	resourcesMap := map[string]string{}
+	hashesMap := map[string]string{}
+	diags.Append(resources.ElementsAs(ctx, &resourcesMap, false)...)
+	diags.Append(hashes.ElementsAs(ctx, &hashesMap, false)...)
+	if diags.HasError() {
+		return resources, hashes, diags
+	}
+
+	for name, value := range resourcesMap {
+		entry, found := respResources[name]
+		if _, isFile := juju.ResourceFilename(value); isFile {
+			if found && entry.Uploaded {
+				hashesMap[name] = entry.Fingerprint
+			} else {
+				delete(hashesMap, name)
+			}
+			continue
+		}
+		if found && !entry.Uploaded {
+			resourcesMap[name] = strconv.Itoa(entry.Revision)
+		}
+	}
+
+	newResources, dErr := types.MapValueFrom(ctx, types.StringType, resourcesMap)
+	diags.Append(dErr...)
+	newHashes := types.MapNull(types.StringType)
+	if len(hashesMap) > 0 {
+		newHashes, dErr = types.MapValueFrom(ctx, types.StringType, hashesMap)
+		diags.Append(dErr...)
+	}
+	return newResources, newHashes, diags
+}
+
+// splitSensitiveConfig splits the config entries read from juju between
+// the config and sensitive_config attributes. An entry belongs to
+// sensitive_config if it was previously found there, or if the charm
+// declares it as a secret and it was not previously found in config.
+func splitSensitiveConfig(config, sensitiveConfig types.Map, respCfg map[string]juju.ConfigEntry) (map[string]juju.ConfigEntry, map[string]juju.ConfigEntry) {
+	configResp := make(map[string]juju.ConfigEntry)
+	sensitiveResp := make(map[string]juju.ConfigEntry)
+	configKeys := config.Elements()
+	sensitiveKeys := sensitiveConfig.Elements()
+	for k, v := range respCfg {
+		_, inConfig := configKeys[k]
+		_, inSensitive := sensitiveKeys[k]
+		if inSensitive || (v.Sensitive && !inConfig) {
+			sensitiveResp[k] = v
+		} else {
+			configResp[k] = v
+		}
+	}
+	return configResp, sensitiveResp
+}
+
+// mergeConfig returns the union of the config and sensitive_config
+// attributes. The keys of both maps are validated to be disjoint.
+func mergeConfig(ctx context.Context, config, sensitiveConfig types.Map) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	merged := map[string]string{}
+	sensitive := map[string]string{}
+	diags.Append(config.ElementsAs(ctx, &merged, false)...)
+	diags.Append(sensitiveConfig.ElementsAs(ctx, &sensitive, false)...)
+	for k, v := range sensitive {
+		merged[k] = v
+	}
+	return merged, diags
+}
+
+// sensitiveConfigWarnings warns about config keys which the charm declares
+// as secrets. Their values should be moved to sensitive_config to be masked.
+func sensitiveConfigWarnings(ctx context.Context, config types.Map, respCfg map[string]juju.ConfigEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for k := range config.Elements() {
+		if entry, found := respCfg[k]; found && entry.Sensitive {
+			diags.AddAttributeWarning(path.Root(ConfigKey).AtMapKey(k), "Sensitive Config",
+				fmt.Sprintf("The charm declares the config key %q as a secret, consider moving it to %q.", k, SensitiveConfigKey))
+		}
+	}
+	return diags
+}
+
+func (r *applicationResource) configureConfigData(ctx context.Context, configType attr.Type, config types.Map, respCfg map[string]juju.ConfigEntry, managedOnly bool) (types.Map, diag.Diagnostics) {
 	// We focus on those config entries that are not the default value.
 	// If the value was the same we ignore it. If no changes were made,
-	// jump to the next step.
+	// jump to the next step. Only the entries previously known are
+	// tracked when managedOnly is set.
 	var previousConfig map[string]string
 	diagErr := config.ElementsAs(ctx, &previousConfig, false)
 	if diagErr.HasError() {
@@ -570,7 +1325,7 @@ func (r *applicationResource) configureConfigData(ctx context.Context, configTyp
 				previousConfig[k] = v.String()
 				changes = true
 			}
-		} else if !v.IsDefault {
+		} else if !v.IsDefault && !managedOnly {
 			// Add if the value is not default
 			previousConfig[k] = v.String()
 			changes = true
@@ -603,8 +1358,20 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	r.trace("Proposed update", applicationResourceModelForLogging(ctx, &plan))
 	r.trace("Current state", applicationResourceModelForLogging(ctx, &state))
 
+	release, dErr := r.waitApplyTurn(ctx, plan, false)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+
+	modelName, _, dErr := modelAppNameFromID(state.ID.ValueString())
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	updateApplicationInput := juju.UpdateApplicationInput{
-		ModelName: state.ModelName.ValueString(),
+		ModelName: modelName,
 		AppName:   state.ApplicationName.ValueString(),
 	}
 
@@ -612,7 +1379,16 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddWarning("Unsupported", "unable to update application name")
 	}
 
-	if !plan.UnitCount.Equal(state.UnitCount) {
+	if !plan.Machines.IsNull() {
+		if !plan.Machines.Equal(state.Machines) {
+			var machines []string
+			resp.Diagnostics.Append(plan.Machines.ElementsAs(ctx, &machines, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			updateApplicationInput.Machines = machines
+		}
+	} else if !plan.UnitCount.Equal(state.UnitCount) {
 		updateApplicationInput.Units = intPtr(plan.UnitCount)
 	}
 
@@ -636,6 +1412,7 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		} else if !planCharm.Revision.Equal(stateCharm.Revision) {
 			updateApplicationInput.Revision = intPtr(planCharm.Revision)
 		}
+		updateApplicationInput.ForceBase = planCharm.ForceBase.ValueBool()
 
 		if !planCharm.Series.Equal(stateCharm.Series) || !planCharm.Base.Equal(stateCharm.Base) {
 			// This violates terraform's declarative model. We could implement
@@ -659,11 +1436,11 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		updateApplicationInput.Unexpose = unexpose
 	}
 
-	if !plan.Config.Equal(state.Config) {
-		planConfigMap := map[string]string{}
-		stateConfigMap := map[string]string{}
-		resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &planConfigMap, false)...)
-		resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfigMap, false)...)
+	if !plan.Config.Equal(state.Config) || !plan.SensitiveConfig.Equal(state.SensitiveConfig) {
+		planConfigMap, dErr := mergeConfig(ctx, plan.Config, plan.SensitiveConfig)
+		resp.Diagnostics.Append(dErr...)
+		stateConfigMap, dErr := mergeConfig(ctx, state.Config, state.SensitiveConfig)
+		resp.Diagnostics.Append(dErr...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -680,12 +1457,84 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 				updateApplicationInput.Config[k] = v
 			}
 		}
+		// keys removed from the config map are reset to the
+		// charm default, otherwise they keep their old value.
+		for k := range stateConfigMap {
+			if _, found := planConfigMap[k]; !found {
+				updateApplicationInput.UnsetConfig = append(updateApplicationInput.UnsetConfig, k)
+			}
+		}
+	}
+
+	// Only the keys added to unset_config since the prior state are
+	// reset, the others were reset when they were added.
+	if !plan.UnsetConfig.IsNull() {
+		var unsetKeys, stateUnsetKeys []string
+		resp.Diagnostics.Append(plan.UnsetConfig.ElementsAs(ctx, &unsetKeys, false)...)
+		if !state.UnsetConfig.IsNull() {
+			resp.Diagnostics.Append(state.UnsetConfig.ElementsAs(ctx, &stateUnsetKeys, false)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateUnset := make(map[string]bool, len(stateUnsetKeys))
+		for _, k := range stateUnsetKeys {
+			stateUnset[k] = true
+		}
+		for _, k := range unsetKeys {
+			if !stateUnset[k] {
+				updateApplicationInput.UnsetConfig = append(updateApplicationInput.UnsetConfig, k)
+			}
+		}
+	}
+
+	planResources := map[string]string{}
+	resp.Diagnostics.Append(plan.Resources.ElementsAs(ctx, &planResources, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if updateApplicationInput.Channel != "" || updateApplicationInput.Revision != nil {
+		// refreshing the charm must keep all of the requested resources.
+		if len(planResources) > 0 {
+			updateApplicationInput.Resources = planResources
+		}
+	} else if !plan.Resources.Equal(state.Resources) || !plan.ResourceHashes.Equal(state.ResourceHashes) {
+		stateResources := map[string]string{}
+		planHashes := map[string]string{}
+		stateHashes := map[string]string{}
+		resp.Diagnostics.Append(state.Resources.ElementsAs(ctx, &stateResources, false)...)
+		resp.Diagnostics.Append(plan.ResourceHashes.ElementsAs(ctx, &planHashes, false)...)
+		resp.Diagnostics.Append(state.ResourceHashes.ElementsAs(ctx, &stateHashes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// only update the resources with a new value or new content.
+		for name, value := range planResources {
+			if stateResources[name] != value || planHashes[name] != stateHashes[name] {
+				if updateApplicationInput.Resources == nil {
+					updateApplicationInput.Resources = make(map[string]string)
+				}
+				updateApplicationInput.Resources[name] = value
+			}
+		}
 	}
 
-	if !plan.Constraints.Equal(state.Constraints) {
+	// Like juju set-constraints, the constraints in the plan replace
+	// all of the existing application constraints.
+	if !plan.ConstraintsMap.IsNull() {
+		if !plan.ConstraintsMap.Equal(state.ConstraintsMap) {
+			appConstraints, dErr := constraintsFromMap(ctx, plan.ConstraintsMap)
+			resp.Diagnostics.Append(dErr...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			updateApplicationInput.Constraints = &appConstraints
+		}
+	} else if equal, dErr := plan.Constraints.StringSemanticEquals(ctx, state.Constraints); !equal && !dErr.HasError() {
 		appConstraints, err := constraints.Parse(plan.Constraints.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Conversion", fmt.Sprintf("Unable to parse plan constraints, got error: %s", err))
+			return
 		}
 		updateApplicationInput.Constraints = &appConstraints
 	}
@@ -695,12 +1544,52 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	plan.ID = types.StringValue(newAppID(plan.ModelName.ValueString(), plan.ApplicationName.ValueString()))
+	switch {
+	case updateApplicationInput.Machines != nil:
+		resp.Diagnostics.Append(r.waitForUnits(ctx, &juju.ReadApplicationInput{
+			ModelName: updateApplicationInput.ModelName,
+			AppName:   updateApplicationInput.AppName,
+		}, len(updateApplicationInput.Machines))...)
+	case updateApplicationInput.Units != nil:
+		resp.Diagnostics.Append(r.waitForUnits(ctx, &juju.ReadApplicationInput{
+			ModelName: updateApplicationInput.ModelName,
+			AppName:   updateApplicationInput.AppName,
+		}, *updateApplicationInput.Units)...)
+	}
+
+	// The placement is unknown when the machines have changed,
+	// read it back from juju.
+	if plan.Placement.IsUnknown() {
+		readResp, err := r.client.Applications.ReadApplication(&juju.ReadApplicationInput{
+			ModelName: updateApplicationInput.ModelName,
+			AppName:   updateApplicationInput.AppName,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+			return
+		}
+		plan.Placement = types.StringValue(readResp.Placement)
+	}
+
+	plan.ID = state.ID
 	plan.Principal = types.BoolNull()
 	r.trace("Updated", applicationResourceModelForLogging(ctx, &plan))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// waitForUnits waits for the units of the application to be as created
+// or updated, for the state not to differ from the next refresh. The
+// apply succeeded meanwhile, a failed wait is only a warning.
+func (r *applicationResource) waitForUnits(ctx context.Context, input *juju.ReadApplicationInput, units int) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := r.client.Applications.WaitForUnits(ctx, input, units); err != nil {
+		diags.AddWarning("Units Not Ready",
+			fmt.Sprintf("Unable to wait for the %d units of application %q, the next refresh may differ, got error: %s",
+				units, input.AppName, err))
+	}
+	return diags
+}
+
 // computeExposeDeltas computes the differences between the previously
 // stored expose value and the current one. The valueSet argument is used
 // to indicate whether the value was already set or not in the latest
@@ -778,6 +1667,7 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	var state applicationResourceModel
 	// Read Terraform prior state into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(checkDeletionProtection(ctx, req.State, "application")...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -786,6 +1676,13 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		"ID": state.ID.ValueString(),
 	})
 
+	release, dErr := r.waitApplyTurn(ctx, state, true)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+
 	modelName, appName, dErr := modelAppNameFromID(state.ID.ValueString())
 	if dErr.HasError() {
 		resp.Diagnostics.Append(dErr...)
@@ -800,6 +1697,26 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	r.trace(fmt.Sprintf("deleted application resource %q", state.ID.ValueString()))
 }
 
+// waitApplyTurn waits for the applications of the apply group of the
+// application to be applied before it, and returns the function to call
+// once it is applied.
+func (r *applicationResource) waitApplyTurn(ctx context.Context, app applicationResourceModel, destroy bool) (func(), diag.Diagnostics) {
+	var diags diag.Diagnostics
+	group := app.ApplyGroup.ValueString()
+	if group != "" {
+		r.trace(fmt.Sprintf("waiting for apply group %q", group), map[string]interface{}{
+			"priority": app.ApplyPriority.ValueInt64(),
+			"destroy":  destroy,
+		})
+	}
+	release, err := r.client.ApplyQueue.Enter(ctx, group, app.ApplyPriority.ValueInt64(), destroy)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to wait for apply group %q, got error: %s", group, err))
+		return nil, diags
+	}
+	return release, diags
+}
+
 // ImportState is called when the provider must import the state of a
 // resource instance. This method must return enough state so the Read
 // method can properly refresh the full resource.
@@ -807,10 +1724,73 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 // If setting an attribute with the import identifier, it is recommended
 // to use the ImportStatePassthroughID() call in this method.
 func (r *applicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importModelKeyedID(ctx, r.client, req, resp)
+}
+
+// UpgradeState upgrades the states of the applications deployed with
+// a series, before the charm had a base, to the base of the series,
+// and the IDs keyed on the model name to the model UUID.
+func (r *applicationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// The prior state only lacks the attributes added since, which
+	// are read as null with the current schema.
+	var priorSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &priorSchema)
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &priorSchema.Schema,
+			StateUpgrader: r.upgradeStateV0,
+		},
+		1: {
+			PriorSchema:   &priorSchema.Schema,
+			StateUpgrader: r.upgradeStateV1,
+		},
+	}
+}
+
+func (r *applicationResource) upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state applicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = upgradeModelKeyedID(r.client, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var charms []nestedCharm
+	resp.Diagnostics.Append(state.Charm.ElementsAs(ctx, &charms, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, charm := range charms {
+		charms[i].Base = baseFromSeries(charm.Series, charm.Base)
+	}
+	charmList, dErr := types.ListValueFrom(ctx, state.Charm.ElementType(ctx), charms)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Charm = charmList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *applicationResource) upgradeStateV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state applicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = upgradeModelKeyedID(r.client, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// ID is '<model name>:<app name>'
+// ID is '<model UUID>:<app name>'. The model name is accepted in
+// its place when importing.
 func newAppID(model, app string) string {
 	return fmt.Sprintf("%s:%s", model, app)
 }
@@ -839,14 +1819,17 @@ func (r *applicationResource) trace(msg string, additionalFields ...map[string]i
 
 func applicationResourceModelForLogging(_ context.Context, app *applicationResourceModel) map[string]interface{} {
 	value := map[string]interface{}{
-		"application-name": app.ApplicationName.ValueString(),
-		"charm":            app.Charm.String(),
-		"constraints":      app.Constraints.ValueString(),
-		"model":            app.ModelName.ValueString(),
-		"placement":        app.Placement.ValueString(),
-		"expose":           app.Expose.String(),
-		"trust":            app.Trust.ValueBoolPointer(),
-		"units":            app.UnitCount.ValueInt64(),
+		"application-name":     app.ApplicationName.ValueString(),
+		"charm":                app.Charm.String(),
+		"constraints":          app.Constraints.ValueString(),
+		"model":                app.ModelName.ValueString(),
+		"placement":            app.Placement.ValueString(),
+		"expose":               app.Expose.String(),
+		"ignore-refresh-drift": app.IgnoreRefreshDrift.ValueBoolPointer(),
+		"machines":             app.Machines.String(),
+		"trust":                app.Trust.ValueBoolPointer(),
+		"units":                app.UnitCount.ValueInt64(),
+		"unset-config":         app.UnsetConfig.String(),
 	}
 	return value
 }
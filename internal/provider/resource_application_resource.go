@@ -0,0 +1,301 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &applicationResourceResource{}
+var _ resource.ResourceWithConfigure = &applicationResourceResource{}
+var _ resource.ResourceWithImportState = &applicationResourceResource{}
+
+func NewApplicationResourceResource() resource.Resource {
+	return &applicationResourceResource{}
+}
+
+type applicationResourceResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type applicationResourceResourceModel struct {
+	ModelName       types.String `tfsdk:"model"`
+	ApplicationName types.String `tfsdk:"application"`
+	Name            types.String `tfsdk:"name"`
+	Revision        types.Int64  `tfsdk:"revision"`
+	Fingerprint     types.String `tfsdk:"fingerprint"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *applicationResourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_resource"
+}
+
+func (r *applicationResourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that pins the charmhub revision of a single charm resource of an application, " +
+			"as `juju attach-resource` does, without managing any other attribute of the application. The " +
+			"resource must not also be set in the resources of the juju_application resource. Destroying the " +
+			"resource only removes it from the state, the application keeps the resource revision.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the application.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"application": schema.StringAttribute{
+				Description: "The name of the application.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					applicationNameValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the charm resource, e.g. `oci-image`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The charmhub revision of the resource.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: "The SHA-384 checksum of the content of the resource.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *applicationResourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceApplicationResource)
+}
+
+// ImportState is called when the provider must import the state of a
+// resource instance. The ID is `model_name:application_name:resource_name`.
+func (r *applicationResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *applicationResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "application_resource", "create")
+		return
+	}
+
+	var plan applicationResourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setRevision(plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set resource revision, got error: %s", err))
+		return
+	}
+	plan.ID = types.StringValue(newApplicationResourceID(plan.ModelName.ValueString(), plan.ApplicationName.ValueString(), plan.Name.ValueString()))
+	r.trace(fmt.Sprintf("resource revision set: %q", plan.ID.ValueString()))
+
+	response, err := r.client.Applications.ReadApplicationResource(juju.ReadApplicationResourceInput{
+		ModelName: plan.ModelName.ValueString(),
+		AppName:   plan.ApplicationName.ValueString(),
+		Name:      plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource, got error: %s", err))
+		return
+	}
+	plan.Fingerprint = types.StringValue(response.Fingerprint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read sets the revision of the resource to the one used by the
+// application. The revision of an uploaded resource is set to zero,
+// so the pinned revision is set again.
+func (r *applicationResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "application_resource", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state applicationResourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName, appName, name, err := modelAppAndResourceNameFromID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Malformed ID", err.Error())
+		return
+	}
+	response, err := r.client.Applications.ReadApplicationResource(juju.ReadApplicationResourceInput{
+		ModelName: modelName,
+		AppName:   appName,
+		Name:      name,
+	})
+	if errors.Is(err, errors.NotFound) {
+		// Application or resource manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read resource: %q", state.ID.ValueString()))
+
+	state.ModelName = types.StringValue(modelName)
+	state.ApplicationName = types.StringValue(appName)
+	state.Name = types.StringValue(name)
+	revision := int64(response.Revision)
+	if response.Uploaded {
+		revision = 0
+	}
+	state.Revision = types.Int64Value(revision)
+	state.Fingerprint = types.StringValue(response.Fingerprint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update sets the new revision of the resource.
+func (r *applicationResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "application_resource", "update")
+		return
+	}
+
+	var plan applicationResourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setRevision(plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set resource revision, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("resource revision updated: %q", plan.ID.ValueString()))
+
+	response, err := r.client.Applications.ReadApplicationResource(juju.ReadApplicationResourceInput{
+		ModelName: plan.ModelName.ValueString(),
+		AppName:   plan.ApplicationName.ValueString(),
+		Name:      plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource, got error: %s", err))
+		return
+	}
+	plan.Fingerprint = types.StringValue(response.Fingerprint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state, a charm resource
+// cannot be removed from an application.
+func (r *applicationResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state applicationResourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace(fmt.Sprintf("resource removed from the state: %q", state.ID.ValueString()))
+}
+
+func (r *applicationResourceResource) setRevision(model applicationResourceResourceModel) error {
+	return r.client.Applications.UpdateApplication(&juju.UpdateApplicationInput{
+		ModelName: model.ModelName.ValueString(),
+		AppName:   model.ApplicationName.ValueString(),
+		Resources: map[string]string{
+			model.Name.ValueString(): strconv.FormatInt(model.Revision.ValueInt64(), 10),
+		},
+	})
+}
+
+func (r *applicationResourceResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceApplicationResource, msg, additionalFields...)
+}
+
+func newApplicationResourceID(modelName, appName, name string) string {
+	return fmt.Sprintf("%s:%s:%s", modelName, appName, name)
+}
+
+// Application resources can be imported using the format:
+// `model_name:application_name:resource_name`.
+func modelAppAndResourceNameFromID(value string) (string, string, string, error) {
+	id := strings.Split(value, ":")
+	//If importing with an incorrect ID we need to catch and provide a user-friendly error
+	if len(id) != 3 {
+		return "", "", "", fmt.Errorf("unable to parse model, application and resource names from provided ID: %q", value)
+	}
+	return id[0], id[1], id[2], nil
+}
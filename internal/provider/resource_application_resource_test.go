@@ -0,0 +1,67 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceApplicationResource(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-resource")
+
+	resourceName := "juju_application_resource.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationResource(modelName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", modelName+":test-app:foo-file"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationResource(modelName, 4),
+				Check:  resource.TestCheckResourceAttr(resourceName, "revision", "4"),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationResource(modelName string, revision int) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_application" "test" {
+  model = juju_model.test.name
+  name  = "test-app"
+
+  charm {
+    name = "juju-qa-test"
+  }
+}
+
+resource "juju_application_resource" "test" {
+  model       = juju_model.test.name
+  application = juju_application.test.name
+  name        = "foo-file"
+  revision    = %d
+}`, modelName, revision)
+}
@@ -4,12 +4,20 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
+	"github.com/juju/terraform-provider-juju/internal/juju"
 	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
 )
 
@@ -78,6 +86,84 @@ func TestAcc_ResourceApplication(t *testing.T) {
 	})
 }
 
+// TestAcc_ResourceApplication_UpdateConstraints checks that constraints are
+// compared by value and updated without replacing the application.
+func TestAcc_ResourceApplication_UpdateConstraints(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-constraints")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationConstraints(modelName, "mem=4G cores=1"),
+				Check:  resource.TestCheckResourceAttr("juju_application.this", "constraints", "mem=4G cores=1"),
+			},
+			{
+				Config: testAccResourceApplicationConstraints(modelName, "cores=1 mem=4096M"),
+				Check:  resource.TestCheckResourceAttr("juju_application.this", "constraints", "cores=1 mem=4096M"),
+			},
+			{
+				Config: testAccResourceApplicationConstraints(modelName, "mem=2G"),
+				Check:  resource.TestCheckResourceAttr("juju_application.this", "constraints", "mem=2G"),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_ConstraintsMap(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-constraints")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationConstraintsMap(modelName, 4096, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "constraints_map.mem", "4096"),
+					resource.TestCheckResourceAttr("juju_application.this", "constraints_map.cores", "1"),
+					resource.TestMatchResourceAttr("juju_application.this", "constraints", regexp.MustCompile("cores=1 mem=4096M")),
+				),
+			},
+			{
+				Config: testAccResourceApplicationConstraintsMap(modelName, 2048, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "constraints_map.mem", "2048"),
+					resource.TestMatchResourceAttr("juju_application.this", "constraints", regexp.MustCompile("cores=2 mem=2048M")),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationConstraintsMap(modelName string, mem, cores int) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  units = 0
+  name  = "test-app"
+  charm {
+    name     = "jameinel-ubuntu-lite"
+    revision = 10
+  }
+
+  constraints_map = {
+    mem   = %d
+    cores = %d
+  }
+}
+`, modelName, mem, cores)
+}
+
 func TestAcc_ResourceApplication_Updates(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-application")
 	appName := "jameinel-ubuntu-lite"
@@ -172,6 +258,374 @@ func TestAcc_ResourceApplication_UpdatesRevisionConfig(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceApplication_ConfigDriftScope(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	appName := "github-runner"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationConfigDriftScope(modelName, appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application."+appName, "config_drift_scope", "managed"),
+					resource.TestCheckResourceAttr("juju_application."+appName, "config.%", "1"),
+				),
+			},
+			{
+				// A key set outside of terraform is not tracked.
+				PreConfig: func() { testAccSetApplicationConfig(t, modelName, appName, "path", "canonical/example") },
+				Config:    testAccResourceApplicationConfigDriftScope(modelName, appName),
+				PlanOnly:  true,
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationConfigDriftScope(modelName, appName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" %[2]q {
+  name  = %[2]q
+  model = juju_model.this.name
+
+  charm {
+    name     = %[2]q
+    revision = 96
+    channel  = "latest/edge"
+  }
+
+  config = {
+    runner-storage = "memory"
+  }
+  config_drift_scope = "managed"
+}
+`, modelName, appName)
+}
+
+// testAccSetApplicationConfig changes the config of an application
+// outside of terraform.
+func testAccSetApplicationConfig(t *testing.T, modelName, appName, key, value string) {
+	err := TestClient.Applications.UpdateApplication(&juju.UpdateApplicationInput{
+		ModelName: modelName,
+		AppName:   appName,
+		Config:    map[string]string{key: value},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestAcc_ResourceApplication_UnsetConfig checks that a config key removed
+// from the plan is reset to the charm default value.
+func TestAcc_ResourceApplication_UnsetConfig(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	appName := "github-runner"
+	configParamName := "runner-storage"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationWithRevisionAndConfig(modelName, appName, 96, configParamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application."+appName, "config."+configParamName, configParamName+"-value"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationWithRevisionAndConfig(modelName, appName, 96, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("juju_application."+appName, "config."+configParamName),
+				),
+			},
+			{
+				Config:   testAccResourceApplicationWithRevisionAndConfig(modelName, appName, 96, ""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_Machines(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-machines")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationMachines(modelName, "juju_machine.this0.machine_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "machines.#", "1"),
+					resource.TestCheckResourceAttr("juju_application.this", "units", "1"),
+					resource.TestCheckResourceAttrPair("juju_application.this", "placement", "juju_machine.this0", "machine_id"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationMachines(modelName, "juju_machine.this0.machine_id", "juju_machine.this1.machine_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "machines.#", "2"),
+					resource.TestCheckResourceAttr("juju_application.this", "units", "2"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationMachines(modelName, "juju_machine.this1.machine_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "machines.#", "1"),
+					resource.TestCheckResourceAttr("juju_application.this", "units", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_SensitiveConfig(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	appName := "github-runner"
+	configParamName := "runner-storage"
+	resourceName := "juju_application." + appName
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationWithRevisionAndConfig(modelName, appName, 96, configParamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config."+configParamName, configParamName+"-value"),
+				),
+			},
+			{
+				// move the config key to sensitive_config
+				Config: testAccResourceApplicationSensitiveConfig(modelName, appName, configParamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "config."+configParamName),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_config."+configParamName, configParamName+"-value"),
+				),
+			},
+			{
+				Config:   testAccResourceApplicationSensitiveConfig(modelName, appName, configParamName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_K8sScale(t *testing.T) {
+	if testingCloud != MicroK8sTesting {
+		t.Skip(t.Name() + " only runs with MicroK8s")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-scale")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationUpdates(modelName, 1, false, "machinename"),
+				Check:  resource.TestCheckResourceAttr("juju_application.this", "units", "1"),
+			},
+			{
+				Config: testAccResourceApplicationUpdates(modelName, 3, false, "machinename"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "units", "3"),
+					resource.TestCheckResourceAttrSet("juju_application.this", "kubernetes_service.provider_id"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationUpdates(modelName, 1, false, "machinename"),
+				Check:  resource.TestCheckResourceAttr("juju_application.this", "units", "1"),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_LocalResource(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-resource")
+	resourceFile := filepath.Join(t.TempDir(), "foo-file.txt")
+	writeResourceFile := func(content string) {
+		if err := os.WriteFile(resourceFile, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var firstHash string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeResourceFile("first content") },
+				Config:    testAccResourceApplicationLocalResource(modelName, resourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "resources.foo-file", "file:"+resourceFile),
+					resource.TestCheckResourceAttrWith("juju_application.this", "resource_hashes.foo-file", func(value string) error {
+						firstHash = value
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() { writeResourceFile("second content") },
+				Config:    testAccResourceApplicationLocalResource(modelName, resourceFile),
+				Check: resource.TestCheckResourceAttrWith("juju_application.this", "resource_hashes.foo-file", func(value string) error {
+					if value == firstHash {
+						return fmt.Errorf("expected the resource hash to change from %q", firstHash)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+// TestAcc_ResourceApplication_ForceBase checks that a charm can be deployed
+// on an operating system it does not declare support for.
+func TestAcc_ResourceApplication_ForceBase(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-force-base")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceApplicationForceBase(modelName, false),
+				ExpectError: regexp.MustCompile(`.*ubuntu@20.04.*`),
+			},
+			{
+				Config: testAccResourceApplicationForceBase(modelName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "charm.0.base", "ubuntu@20.04"),
+					resource.TestCheckResourceAttr("juju_application.this", "charm.0.force_base", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestAcc_ResourceApplication_IgnoreRefreshDrift checks that a charm refreshed
+// outside of terraform does not produce a diff when ignore_refresh_drift is set.
+func TestAcc_ResourceApplication_IgnoreRefreshDrift(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-drift")
+	appName := "github-runner"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationIgnoreRefreshDrift(modelName, appName, 88),
+				Check:  resource.TestCheckResourceAttr("juju_application."+appName, "charm.0.revision", "88"),
+			},
+			{
+				PreConfig: func() { testAccRefreshApplicationRevision(t, modelName, appName, 96) },
+				Config:    testAccResourceApplicationIgnoreRefreshDrift(modelName, appName, 88),
+				PlanOnly:  true,
+			},
+		},
+	})
+}
+
+// testAccRefreshApplicationRevision refreshes the charm of an application
+// with the juju client, as an automatic refresh would.
+func testAccRefreshApplicationRevision(t *testing.T, modelName, appName string, revision int) {
+	err := TestClient.Applications.UpdateApplication(&juju.UpdateApplicationInput{
+		ModelName: modelName,
+		AppName:   appName,
+		Revision:  &revision,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAcc_ResourceApplication_RemovedOutsideTerraform(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	appName := "test-app"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationBasic(modelName, appName),
+				Check:  resource.TestCheckResourceAttr("juju_application.this", "name", appName),
+			},
+			{
+				// The application is removed from the state, and
+				// deployed again.
+				PreConfig: func() { testAccDestroyApplication(t, modelName, appName) },
+				Config:    testAccResourceApplicationBasic(modelName, appName),
+				Check:     resource.TestCheckResourceAttr("juju_application.this", "name", appName),
+			},
+		},
+	})
+}
+
+// testAccDestroyApplication removes an application with the juju
+// client, as `juju remove-application` would, and waits until it is
+// gone.
+func testAccDestroyApplication(t *testing.T, modelName, appName string) {
+	err := TestClient.Applications.DestroyApplication(&juju.DestroyApplicationInput{
+		ApplicationName: appName,
+		ModelName:       modelName,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 60; i++ {
+		_, err = TestClient.Applications.ReadApplication(&juju.ReadApplicationInput{
+			ModelName: modelName,
+			AppName:   appName,
+		})
+		if errors.As(err, &juju.ApplicationNotFoundError) {
+			return
+		}
+		time.Sleep(5 * time.Second)
+	}
+	t.Fatalf("application %q not removed, got error: %v", appName, err)
+}
+
+func TestAcc_ResourceApplication_ApplyGroup(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationApplyGroup(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.database", "apply_group", "rollout"),
+					resource.TestCheckNoResourceAttr("juju_application.database", "apply_priority"),
+					resource.TestCheckResourceAttr("juju_application.app", "apply_group", "rollout"),
+					resource.TestCheckResourceAttr("juju_application.app", "apply_priority", "10"),
+				),
+			},
+			{
+				Config:      testAccResourceApplicationApplyPriority(modelName),
+				ExpectError: regexp.MustCompile(`Attribute "apply_group" must be specified`),
+			},
+		},
+	})
+}
+
 func TestAcc_CharmUpdates(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-charmupdates")
 
@@ -270,6 +724,40 @@ func TestAcc_ResourceApplication_UpgradeProvider(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceApplication_ModelUUID(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	var charmName string
+	if testingCloud == LXDCloudTesting {
+		charmName = "juju-qa-test"
+	} else {
+		charmName = "hello-juju"
+	}
+	resourceName := "juju_application.testapp"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name = %q
+}
+
+resource "juju_application" "testapp" {
+  model = juju_model.testmodel.uuid
+  charm {
+    name = %q
+  }
+}`, modelName, charmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "model", "juju_model.testmodel", "uuid"),
+					resource.TestCheckResourceAttr(resourceName, "name", charmName),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceApplicationBasic_Minimal(modelName, charmName string) string {
 	return fmt.Sprintf(`
 		resource "juju_model" "testmodel" {
@@ -359,6 +847,37 @@ resource "juju_application" "{{.AppName}}" {
 		})
 }
 
+func testAccResourceApplicationSensitiveConfig(modelName, appName, configParamName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceApplicationSensitiveConfig",
+		`
+resource "juju_model" "{{.ModelName}}" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_application" "{{.AppName}}" {
+  name  = "{{.AppName}}"
+  model = juju_model.{{.ModelName}}.name
+
+  charm {
+    name     = "{{.AppName}}"
+    revision = 96
+    channel  = "latest/edge"
+  }
+
+  sensitive_config = {
+    {{.ConfigParamName}} = "{{.ConfigParamName}}-value"
+  }
+
+  units = 1
+}
+`, internaltesting.TemplateData{
+			"ModelName":       modelName,
+			"AppName":         appName,
+			"ConfigParamName": configParamName,
+		})
+}
+
 func testAccResourceApplicationUpdates(modelName string, units int, expose bool, hostname string) string {
 	exposeStr := "expose{}"
 	if !expose {
@@ -521,3 +1040,147 @@ resource "juju_application" "subordinate" {
 } 
 `, modelName, constraints)
 }
+
+func testAccResourceApplicationMachines(modelName string, machines ...string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_machine" "this0" {
+  model = juju_model.this.name
+  base  = "ubuntu@22.04"
+}
+
+resource "juju_machine" "this1" {
+  model = juju_model.this.name
+  base  = "ubuntu@22.04"
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  charm {
+    name = "jameinel-ubuntu-lite"
+    base = "ubuntu@22.04"
+  }
+  machines = [%s]
+}
+`, modelName, strings.Join(machines, ", "))
+}
+
+func testAccResourceApplicationLocalResource(modelName, resourceFile string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  charm {
+    name = "juju-qa-test"
+  }
+  resources = {
+    foo-file = "file:%s"
+  }
+}
+`, modelName, resourceFile)
+}
+
+func testAccResourceApplicationForceBase(modelName string, forceBase bool) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  charm {
+    name       = "postgresql"
+    channel    = "14/stable"
+    base       = "ubuntu@20.04"
+    force_base = %t
+  }
+}
+`, modelName, forceBase)
+}
+
+func testAccResourceApplicationIgnoreRefreshDrift(modelName, appName string, revision int) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" %q {
+  model = juju_model.this.name
+  name  = %q
+  charm {
+    name     = %q
+    revision = %d
+  }
+  ignore_refresh_drift = true
+}
+`, modelName, appName, appName, appName, revision)
+}
+
+func testAccResourceApplicationApplyGroup(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "database" {
+  model = juju_model.this.name
+  name  = "database"
+  charm {
+    name = "juju-qa-test"
+  }
+  apply_group = "rollout"
+}
+
+resource "juju_application" "app" {
+  model = juju_model.this.name
+  name  = "app"
+  charm {
+    name = "ubuntu"
+  }
+  apply_group    = "rollout"
+  apply_priority = 10
+}
+`, modelName)
+}
+
+func testAccResourceApplicationApplyPriority(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "app" {
+  model = juju_model.this.name
+  name  = "app"
+  charm {
+    name = "ubuntu"
+  }
+  apply_priority = 10
+}
+`, modelName)
+}
+
+func TestApplicationUpgradeStateV0(t *testing.T) {
+	upgraded := upgradeResourceState(t, "juju_application",
+		`{"id":"8e1c4a5b-3f2d-4c6e-9a7b-0d1e2f3a4b5c:mysql","model":"development","name":"mysql","charm":[{"name":"mysql","series":"focal"}]}`)
+	var charms []tftypes.Value
+	if err := upgraded["charm"].As(&charms); err != nil || len(charms) != 1 {
+		t.Fatalf("expected a single charm, got %v (%v)", charms, err)
+	}
+	var charm map[string]tftypes.Value
+	if err := charms[0].As(&charm); err != nil {
+		t.Fatal(err)
+	}
+	if base := stringAttribute(t, charm["base"]); base != "ubuntu@20.04" {
+		t.Errorf("expected base ubuntu@20.04, got %q", base)
+	}
+}
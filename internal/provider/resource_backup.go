@@ -0,0 +1,213 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &backupResource{}
+var _ resource.ResourceWithConfigure = &backupResource{}
+
+func NewBackupResource() resource.Resource {
+	return &backupResource{}
+}
+
+type backupResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type backupResourceModel struct {
+	Notes        types.String `tfsdk:"notes"`
+	DownloadPath types.String `tfsdk:"download_path"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	Filename     types.String `tfsdk:"filename"`
+	Checksum     types.String `tfsdk:"checksum"`
+	Size         types.Int64  `tfsdk:"size"`
+	Started      types.String `tfsdk:"started"`
+	Finished     types.String `tfsdk:"finished"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *backupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (r *backupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a backup of the controller, as `juju create-backup` does. " +
+			"A new backup is created when any of the attributes changes, e.g. the triggers. The controller " +
+			"does not list backups: destroying the resource only removes it from the state, the backup and " +
+			"its download are kept.",
+		Attributes: map[string]schema.Attribute{
+			"notes": schema.StringAttribute{
+				Description: "Notes stored with the backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"download_path": schema.StringAttribute{
+				Description: "The local path the backup is downloaded to. The backup is kept on the " +
+					"controller when not set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which create a new backup when they change, e.g. a " +
+					"timestamp to schedule backups.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Description: "The path of the backup on the controller, to download it with " +
+					"`juju download-backup`.",
+				Computed: true,
+			},
+			"checksum": schema.StringAttribute{
+				Description: "The checksum of the backup.",
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the backup, in bytes.",
+				Computed:    true,
+			},
+			"started": schema.StringAttribute{
+				Description: "When the backup started, in RFC3339 format.",
+				Computed:    true,
+			},
+			"finished": schema.StringAttribute{
+				Description: "When the backup finished, in RFC3339 format.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the backup.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *backupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceBackup)
+}
+
+func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "backup", "create")
+		return
+	}
+
+	var plan backupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Controller.CreateBackup(juju.CreateBackupInput{
+		Notes:        plan.Notes.ValueString(),
+		DownloadPath: plan.DownloadPath.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup, got error: %s", err))
+		return
+	}
+
+	plan.Filename = types.StringValue(response.Filename)
+	plan.Checksum = types.StringValue(response.Checksum)
+	plan.Size = types.Int64Value(response.Size)
+	plan.Started = types.StringValue(response.Started.Format(time.RFC3339))
+	plan.Finished = types.StringValue(response.Finished.Format(time.RFC3339))
+	plan.ID = types.StringValue(response.ID)
+	r.trace(fmt.Sprintf("backup created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as it is, the controller does not list backups.
+func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil || r.client.Offline {
+		return
+	}
+
+	var state backupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, all of the attributes require the
+// resource to be replaced.
+func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan backupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the backup from the state, the controller
+// cannot remove backups.
+func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state backupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace(fmt.Sprintf("backup removed from the state: %q", state.ID.ValueString()))
+}
+
+func (r *backupResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceBackup, msg, additionalFields...)
+}
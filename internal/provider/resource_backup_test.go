@@ -0,0 +1,53 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceBackup(t *testing.T) {
+	downloadPath := filepath.Join(t.TempDir(), "backup.tar.gz")
+	resourceName := "juju_backup.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBackup(downloadPath, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notes", "tf-test-backup"),
+					resource.TestCheckResourceAttr(resourceName, "download_path", downloadPath),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "filename"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+				),
+			},
+			{
+				// Changing the triggers creates a new backup.
+				Config: testAccResourceBackup(downloadPath, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "second"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceBackup(downloadPath, run string) string {
+	return fmt.Sprintf(`
+resource "juju_backup" "test" {
+  notes         = "tf-test-backup"
+  download_path = %q
+
+  triggers = {
+    run = %q
+  }
+}`, downloadPath, run)
+}
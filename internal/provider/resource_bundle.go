@@ -0,0 +1,313 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &bundleResource{}
+var _ resource.ResourceWithConfigure = &bundleResource{}
+var _ resource.ResourceWithImportState = &bundleResource{}
+
+func NewBundleResource() resource.Resource {
+	return &bundleResource{}
+}
+
+type bundleResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type bundleResourceModel struct {
+	ModelName    types.String `tfsdk:"model"`
+	Bundle       types.String `tfsdk:"bundle"`
+	Channel      types.String `tfsdk:"channel"`
+	Revision     types.Int64  `tfsdk:"revision"`
+	Overlays     types.List   `tfsdk:"overlays"`
+	Applications types.Set    `tfsdk:"applications"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *bundleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle"
+}
+
+func (r *bundleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that deploys a bundle, with overlays, as `juju deploy` does. It lifts bundle " +
+			"based deployments into Terraform: the applications deployed are tracked, and removed when the " +
+			"resource is destroyed. The bundle is deployed again when any of the attributes changes. Local " +
+			"charms, machines and placement directives, offers and saas of bundles are not supported, use " +
+			"the juju_machine, juju_offer and juju_integration resources for them.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model to deploy the bundle in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"bundle": schema.StringAttribute{
+				Description: "The name of a Charmhub bundle, or the path of a local bundle file, directory " +
+					"or archive.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel": schema.StringAttribute{
+				Description: "The channel of a Charmhub bundle, e.g. `latest/stable`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(stringReplaceUnlessImported, replaceUnlessImportedDescription, replaceUnlessImportedDescription),
+				},
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The revision of a Charmhub bundle.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(int64ReplaceUnlessImported, replaceUnlessImportedDescription, replaceUnlessImportedDescription),
+				},
+			},
+			"overlays": schema.ListAttribute{
+				Description: "The paths of local overlay files, applied in order on top of the bundle.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(listReplaceUnlessImported, replaceUnlessImportedDescription, replaceUnlessImportedDescription),
+				},
+			},
+			"applications": schema.SetAttribute{
+				Description: "The applications deployed by the bundle.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *bundleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceBundle)
+}
+
+// ImportState imports the applications a bundle deployed in a model,
+// as the bundle itself is not tracked by the controller. The ID is the
+// model name, the bundle and a comma separated list of applications.
+func (r *bundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Malformed ID",
+			fmt.Sprintf("unable to parse model, bundle and applications from provided ID: %q, "+
+				"expected model:bundle:application1,application2", req.ID))
+		return
+	}
+	applications, dErr := types.SetValueFrom(ctx, types.StringType, strings.Split(parts[2], ","))
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bundle"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("applications"), applications)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s:%s", parts[0], parts[1]))...)
+	resp.Diagnostics.Append(setImported(ctx, resp.Private)...)
+}
+
+// Create deploys the bundle. When the bundle is deployed part way, the
+// applications deployed are kept in the state, and the resource is
+// replaced on the next apply.
+func (r *bundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "bundle", "create")
+		return
+	}
+
+	var plan bundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := juju.DeployBundleInput{
+		ModelName: plan.ModelName.ValueString(),
+		Bundle:    plan.Bundle.ValueString(),
+		Channel:   plan.Channel.ValueString(),
+		Revision:  juju.UnspecifiedRevision,
+	}
+	if !plan.Revision.IsNull() {
+		input.Revision = int(plan.Revision.ValueInt64())
+	}
+	if !plan.Overlays.IsNull() {
+		resp.Diagnostics.Append(plan.Overlays.ElementsAs(ctx, &input.Overlays, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response, err := r.client.Bundles.DeployBundle(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy bundle, got error: %s", err))
+		if response == nil || len(response.Applications) == 0 {
+			return
+		}
+	}
+
+	applications, dErr := types.SetValueFrom(ctx, types.StringType, response.Applications)
+	resp.Diagnostics.Append(dErr...)
+	plan.Applications = applications
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", plan.ModelName.ValueString(), plan.Bundle.ValueString()))
+	r.trace(fmt.Sprintf("bundle deployed: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the applications which are not in the model anymore
+// from the state.
+func (r *bundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "bundle", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state bundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var applications []string
+	resp.Diagnostics.Append(state.Applications.ElementsAs(ctx, &applications, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	response, err := r.client.Bundles.ReadBundle(juju.ReadBundleInput{
+		ModelName:    state.ModelName.ValueString(),
+		Applications: applications,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bundle, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read bundle: %q", state.ID.ValueString()))
+
+	if len(response.Applications) == 0 {
+		// Applications manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	applicationsValue, dErr := types.SetValueFrom(ctx, types.StringType, response.Applications)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Applications = applicationsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is only called once the bundle is imported, to set the
+// attributes which cannot be read back from the controller. All of
+// the attributes require the resource to be replaced otherwise.
+func (r *bundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(clearImported(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the applications deployed by the bundle.
+func (r *bundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "bundle", "delete")
+		return
+	}
+
+	var state bundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var applications []string
+	resp.Diagnostics.Append(state.Applications.ElementsAs(ctx, &applications, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.Bundles.DestroyBundle(juju.DestroyBundleInput{
+		ModelName:    state.ModelName.ValueString(),
+		Applications: applications,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove bundle, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("bundle removed: %q", state.ID.ValueString()))
+}
+
+func (r *bundleResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceBundle, msg, additionalFields...)
+}
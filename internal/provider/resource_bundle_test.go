@@ -0,0 +1,87 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testBundle = `
+applications:
+  dummy-source:
+    charm: juju-qa-dummy-source
+    num_units: 1
+  dummy-sink:
+    charm: juju-qa-dummy-sink
+    num_units: 1
+relations:
+  - ["dummy-source:sink", "dummy-sink:source"]
+`
+
+const testBundleOverlay = `
+applications:
+  dummy-source:
+    options:
+      token: overlaid
+`
+
+func TestAcc_ResourceBundle(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-bundle")
+	dir := t.TempDir()
+	bundlePath := filepath.Join(dir, "bundle.yaml")
+	overlayPath := filepath.Join(dir, "overlay.yaml")
+	if err := os.WriteFile(bundlePath, []byte(testBundle), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(overlayPath, []byte(testBundleOverlay), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resourceName := "juju_bundle.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBundle(modelName, bundlePath, overlayPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:%s", modelName, bundlePath)),
+					resource.TestCheckResourceAttr(resourceName, "applications.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applications.*", "dummy-source"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applications.*", "dummy-sink"),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s:dummy-source,dummy-sink", modelName, bundlePath),
+				ImportStateVerify: true,
+				// The overlays are not tracked by the controller.
+				ImportStateVerifyIgnore: []string{"overlays"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceBundle(modelName, bundlePath, overlayPath string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_bundle" "test" {
+  model    = juju_model.test.name
+  bundle   = %q
+  overlays = [%q]
+}`, modelName, bundlePath, overlayPath)
+}
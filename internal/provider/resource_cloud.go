@@ -0,0 +1,378 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &cloudResource{}
+var _ resource.ResourceWithConfigure = &cloudResource{}
+var _ resource.ResourceWithImportState = &cloudResource{}
+
+func NewCloudResource() resource.Resource {
+	return &cloudResource{}
+}
+
+type cloudResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type cloudResourceModel struct {
+	Name             types.String        `tfsdk:"name"`
+	Type             types.String        `tfsdk:"type"`
+	AuthTypes        types.Set           `tfsdk:"auth_types"`
+	Endpoint         types.String        `tfsdk:"endpoint"`
+	IdentityEndpoint types.String        `tfsdk:"identity_endpoint"`
+	StorageEndpoint  types.String        `tfsdk:"storage_endpoint"`
+	CACertificates   types.List          `tfsdk:"ca_certificates"`
+	Regions          []nestedCloudRegion `tfsdk:"region"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedCloudRegion represents an element of the region ListNestedBlock
+// of the cloud resource.
+type nestedCloudRegion struct {
+	Name             types.String `tfsdk:"name"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	IdentityEndpoint types.String `tfsdk:"identity_endpoint"`
+	StorageEndpoint  types.String `tfsdk:"storage_endpoint"`
+}
+
+func (r *cloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud"
+}
+
+func (r *cloudResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a cloud added to the controller, as `juju add-cloud --controller` " +
+			"does, e.g. a MAAS, OpenStack or vSphere cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the cloud.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the cloud, e.g. `maas`, `openstack` or `vsphere`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_types": schema.SetAttribute{
+				Description: "The authentication types supported by the cloud, e.g. `oauth1` or `userpass`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "The API endpoint of the cloud.",
+				Optional:    true,
+			},
+			"identity_endpoint": schema.StringAttribute{
+				Description: "The identity endpoint of the cloud.",
+				Optional:    true,
+			},
+			"storage_endpoint": schema.StringAttribute{
+				Description: "The storage endpoint of the cloud.",
+				Optional:    true,
+			},
+			"ca_certificates": schema.ListAttribute{
+				Description: "The CA certificates used to connect to the cloud endpoints.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"region": schema.ListNestedBlock{
+				Description: "A region of the cloud. The first region is the default region of the cloud.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the region.",
+							Required:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The API endpoint of the region.",
+							Optional:    true,
+						},
+						"identity_endpoint": schema.StringAttribute{
+							Description: "The identity endpoint of the region.",
+							Optional:    true,
+						},
+						"storage_endpoint": schema.StringAttribute{
+							Description: "The storage endpoint of the region.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceCloud)
+}
+
+// ImportState imports a cloud by its name.
+func (r *cloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *cloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "create")
+		return
+	}
+
+	var plan cloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var authTypes, caCertificates []string
+	resp.Diagnostics.Append(plan.AuthTypes.ElementsAs(ctx, &authTypes, false)...)
+	resp.Diagnostics.Append(plan.CACertificates.ElementsAs(ctx, &caCertificates, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Clouds.CreateCloud(juju.CreateCloudInput{
+		Name:             plan.Name.ValueString(),
+		Type:             plan.Type.ValueString(),
+		AuthTypes:        authTypes,
+		Endpoint:         plan.Endpoint.ValueString(),
+		IdentityEndpoint: plan.IdentityEndpoint.ValueString(),
+		StorageEndpoint:  plan.StorageEndpoint.ValueString(),
+		Regions:          cloudRegionsFromPlan(plan.Regions),
+		CACertificates:   caCertificates,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cloud, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Name.ValueString())
+	r.trace(fmt.Sprintf("cloud created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state cloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Clouds.ReadCloud(juju.ReadCloudInput{
+		Name: state.ID.ValueString(),
+	})
+	if errors.Is(err, errors.NotFound) {
+		// Cloud manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read cloud: %q", state.ID.ValueString()))
+
+	state.Name = types.StringValue(response.Name)
+	state.Type = types.StringValue(response.Type)
+	authTypes, errDiag := types.SetValueFrom(ctx, types.StringType, response.AuthTypes)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.AuthTypes = authTypes
+	state.Endpoint = optionalStringValue(response.Endpoint)
+	state.IdentityEndpoint = optionalStringValue(response.IdentityEndpoint)
+	state.StorageEndpoint = optionalStringValue(response.StorageEndpoint)
+
+	if len(response.CACertificates) > 0 {
+		caCertificates, errDiag := types.ListValueFrom(ctx, types.StringType, response.CACertificates)
+		resp.Diagnostics.Append(errDiag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.CACertificates = caCertificates
+	} else {
+		state.CACertificates = types.ListNull(types.StringType)
+	}
+
+	// Juju adds a default region to clouds defined without regions,
+	// it is not tracked unless configured.
+	regions := response.Regions
+	if len(state.Regions) == 0 && len(regions) == 1 && regions[0] == (juju.CloudRegion{Name: "default"}) {
+		regions = nil
+	}
+	state.Regions = nil
+	for _, region := range regions {
+		state.Regions = append(state.Regions, nestedCloudRegion{
+			Name:             types.StringValue(region.Name),
+			Endpoint:         optionalStringValue(region.Endpoint),
+			IdentityEndpoint: optionalStringValue(region.IdentityEndpoint),
+			StorageEndpoint:  optionalStringValue(region.StorageEndpoint),
+		})
+	}
+
+	state.ID = types.StringValue(response.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *cloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "update")
+		return
+	}
+
+	var plan cloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var authTypes, caCertificates []string
+	resp.Diagnostics.Append(plan.AuthTypes.ElementsAs(ctx, &authTypes, false)...)
+	resp.Diagnostics.Append(plan.CACertificates.ElementsAs(ctx, &caCertificates, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Clouds.UpdateCloud(juju.UpdateCloudInput{
+		Name:             plan.Name.ValueString(),
+		Type:             plan.Type.ValueString(),
+		AuthTypes:        authTypes,
+		Endpoint:         plan.Endpoint.ValueString(),
+		IdentityEndpoint: plan.IdentityEndpoint.ValueString(),
+		StorageEndpoint:  plan.StorageEndpoint.ValueString(),
+		Regions:          cloudRegionsFromPlan(plan.Regions),
+		CACertificates:   caCertificates,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cloud, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("cloud updated: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the cloud from the controller. It fails while
+// models of the controller use the cloud.
+func (r *cloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "delete")
+		return
+	}
+
+	var state cloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Clouds.DestroyCloud(juju.DestroyCloudInput{
+		Name: state.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cloud, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("cloud deleted: %q", state.ID.ValueString()))
+}
+
+func cloudRegionsFromPlan(regions []nestedCloudRegion) []juju.CloudRegion {
+	result := make([]juju.CloudRegion, 0, len(regions))
+	for _, region := range regions {
+		result = append(result, juju.CloudRegion{
+			Name:             region.Name.ValueString(),
+			Endpoint:         region.Endpoint.ValueString(),
+			IdentityEndpoint: region.IdentityEndpoint.ValueString(),
+			StorageEndpoint:  region.StorageEndpoint.ValueString(),
+		})
+	}
+	return result
+}
+
+// optionalStringValue returns a null value for an empty string, as
+// an optional attribute which is not configured.
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func (r *cloudResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceCloud, msg, additionalFields...)
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceCloud(t *testing.T) {
+	cloudName := acctest.RandomWithPrefix("tf-test-cloud")
+
+	resourceName := "juju_cloud.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCloud(cloudName, "https://10.0.0.1:5000/v3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "type", "openstack"),
+					resource.TestCheckResourceAttr(resourceName, "auth_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://10.0.0.1:5000/v3"),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "region.0.name", "RegionOne"),
+				),
+			},
+			{
+				Config: testAccResourceCloud(cloudName, "https://10.0.0.2:5000/v3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://10.0.0.2:5000/v3"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     cloudName,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceCloud(cloudName, endpoint string) string {
+	return fmt.Sprintf(`
+resource "juju_cloud" "this" {
+  name       = %q
+  type       = "openstack"
+  auth_types = ["userpass"]
+  endpoint   = %q
+
+  region {
+    name = "RegionOne"
+  }
+}`, cloudName, endpoint)
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &controllerConfigResource{}
+var _ resource.ResourceWithConfigure = &controllerConfigResource{}
+var _ resource.ResourceWithImportState = &controllerConfigResource{}
+
+// controllerConfigID is the ID of the controller config, there is
+// one per controller.
+const controllerConfigID = "controller"
+
+func NewControllerConfigResource() resource.Resource {
+	return &controllerConfigResource{}
+}
+
+type controllerConfigResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type controllerConfigResourceModel struct {
+	Config types.Map `tfsdk:"config"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *controllerConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller_config"
+}
+
+func (r *controllerConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the config of the controller, as `juju controller-config` " +
+			"does. Only the config keys of the resource are managed, other keys keep their value. The " +
+			"controller cannot reset a key to its default: keys removed from the resource, or the resource " +
+			"being destroyed, leave the values of the controller as they are.",
+		Attributes: map[string]schema.Attribute{
+			"config": schema.MapAttribute{
+				Description: "The controller config keys to set, e.g. `audit-log-capture-args`. Only the keys " +
+					"which can be updated once the controller is bootstrapped are allowed.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf(juju.UpdatableControllerConfigKeys()...)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *controllerConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceControllerConfig)
+}
+
+// ImportState is called when the provider must import the state of a
+// resource instance. All of the config keys which can be updated are
+// read on import.
+func (r *controllerConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != controllerConfigID {
+		resp.Diagnostics.AddError("Malformed ID", fmt.Sprintf("the controller config is imported with the ID %q, got: %q", controllerConfigID, req.ID))
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *controllerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "controller_config", "create")
+		return
+	}
+
+	var plan controllerConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := map[string]string{}
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &config, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.update(config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(controllerConfigID)
+	r.trace("controller config set")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read only reads the config keys of the state, all of the keys which
+// can be updated when the resource is imported.
+func (r *controllerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "controller_config", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state controllerConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Controller.ReadControllerConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controller config, got error: %s", err))
+		return
+	}
+	r.trace("read controller config")
+
+	stateConfig := map[string]string{}
+	if state.Config.IsNull() {
+		for _, key := range juju.UpdatableControllerConfigKeys() {
+			stateConfig[key] = ""
+		}
+	} else {
+		resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	config := map[string]string{}
+	for key, stateValue := range stateConfig {
+		value, ok := response.Config[key]
+		if !ok {
+			continue
+		}
+		configValue, err := modelConfigValueString(stateValue, value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controller config %q, got error: %s", key, err))
+			return
+		}
+		config[key] = configValue
+	}
+	configValue, dErr := types.MapValueFrom(ctx, types.StringType, config)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Config = configValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update sets the config keys added or changed, keys removed from the
+// config keep their value.
+func (r *controllerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "controller_config", "update")
+		return
+	}
+
+	var plan, state controllerConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planConfig := map[string]string{}
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &planConfig, false)...)
+	stateConfig := map[string]string{}
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config := map[string]string{}
+	for key, value := range planConfig {
+		if stateValue, ok := stateConfig[key]; !ok || stateValue != value {
+			config[key] = value
+		}
+	}
+	if len(config) > 0 {
+		resp.Diagnostics.Append(r.update(config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.trace("controller config updated")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state, the controller
+// config keeps its values.
+func (r *controllerConfigResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	r.trace("controller config removed from the state")
+}
+
+func (r *controllerConfigResource) update(config map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := r.client.Controller.UpdateControllerConfig(juju.UpdateControllerConfigInput{
+		Config: config,
+	}); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set controller config, got error: %s", err))
+	}
+	return diags
+}
+
+func (r *controllerConfigResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceControllerConfig, msg, additionalFields...)
+}
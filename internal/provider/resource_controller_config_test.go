@@ -0,0 +1,56 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAcc_ResourceControllerConfig(t *testing.T) {
+	resourceName := "juju_controller_config.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceControllerConfig("true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.audit-log-capture-args", "true"),
+					resource.TestCheckResourceAttr(resourceName, "id", "controller"),
+				),
+			},
+			{
+				Config: testAccResourceControllerConfig("false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.audit-log-capture-args", "false"),
+				),
+			},
+			{
+				ImportState:   true,
+				ImportStateId: "controller",
+				ResourceName:  resourceName,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if value := states[0].Attributes["config.audit-log-capture-args"]; value != "false" {
+						return fmt.Errorf("expected audit-log-capture-args to be false, got %q", value)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceControllerConfig(auditLogCaptureArgs string) string {
+	return fmt.Sprintf(`
+resource "juju_controller_config" "test" {
+  config = {
+    "audit-log-capture-args" = %q
+  }
+}`, auditLogCaptureArgs)
+}
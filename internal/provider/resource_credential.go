@@ -48,6 +48,7 @@ type credentialResourceModel struct {
 	AuthType             types.String `tfsdk:"auth_type"`
 	ClientCredential     types.Bool   `tfsdk:"client_credential"`
 	ControllerCredential types.Bool   `tfsdk:"controller_credential"`
+	Force                types.Bool   `tfsdk:"force"`
 	Name                 types.String `tfsdk:"name"`
 
 	// ID required by the testing framework
@@ -103,6 +104,14 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"force": schema.BoolAttribute{
+				Description: "Update the controller credential even if it is not valid for the models using it. " +
+					"By default, an update of the attributes is validated against the models using the credential, " +
+					"and fails if the credential is not valid for any of them.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"name": schema.StringAttribute{
 				Description: "The name to be assigned to the credential",
 				Required:    true,
@@ -192,6 +201,10 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	if c.client.Offline {
+		return
+	}
+
 	var data credentialResourceModel
 
 	// Read Terraform configuration from the request into the resource model
@@ -234,6 +247,11 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.ClientCredential = types.BoolValue(clientCredential)
 	data.ControllerCredential = types.BoolValue(controllerCredential)
 
+	// force is not stored on the controller
+	if data.Force.IsNull() {
+		data.Force = types.BoolValue(false)
+	}
+
 	// retrieve name & auth_type
 	data.Name = types.StringValue(response.CloudCredential.Label)
 	data.AuthType = types.StringValue(string(response.CloudCredential.AuthType()))
@@ -290,6 +308,7 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Return early if no change
 	// No need to check the name and cloud.name because they can't be updated in-place without recreating the resource
 	// i.e. their change will force recreation of the resource (see the schema)
+	// A change of force alone is only recorded in the state
 	if data.AuthType.Equal(state.AuthType) &&
 		data.ClientCredential.Equal(state.ClientCredential) &&
 		data.ControllerCredential.Equal(state.ControllerCredential) &&
@@ -325,6 +344,7 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		ClientCredential:     newClientCredential,
 		CloudName:            cloudName,
 		ControllerCredential: newControllerCredential,
+		Force:                data.Force.ValueBool(),
 		Name:                 credentialName,
 	})
 	if err != nil {
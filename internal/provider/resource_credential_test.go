@@ -53,6 +53,36 @@ func TestAcc_ResourceCredential(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceCredential_Rotation(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+	authType := "certificate"
+
+	resourceName := "juju_credential.test-credential"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCredentialToken(credentialName, authType, "123abc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.token", "123abc"),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
+				),
+			},
+			{
+				Config: testAccResourceCredentialForce(credentialName, authType, "456def"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.token", "456def"),
+					resource.TestCheckResourceAttr(resourceName, "force", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceCredential_UpgradeProvider(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -116,3 +146,21 @@ resource "juju_credential" "test-credential" {
   }
 }`, credentialName, authType, token)
 }
+
+func testAccResourceCredentialForce(credentialName, authType, token string) string {
+	return fmt.Sprintf(`
+resource "juju_credential" "test-credential" {
+  name = %q
+
+  cloud {
+   name   = "localhost"
+  }
+
+  auth_type = "%s"
+  force     = true
+
+  attributes = {
+	token = "%s"
+  }
+}`, credentialName, authType, token)
+}
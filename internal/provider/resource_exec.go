@@ -0,0 +1,356 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/juju/rpc/params"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &execResource{}
+var _ resource.ResourceWithConfigure = &execResource{}
+
+// defaultExecTimeout is how long the commands are waited for when
+// the timeout is not set, as `juju exec` does.
+const defaultExecTimeout = 5 * time.Minute
+
+func NewExecResource() resource.Resource {
+	return &execResource{}
+}
+
+type execResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type execResourceModel struct {
+	ModelName    types.String `tfsdk:"model"`
+	Command      types.String `tfsdk:"command"`
+	Applications types.Set    `tfsdk:"applications"`
+	Units        types.Set    `tfsdk:"units"`
+	Machines     types.Set    `tfsdk:"machines"`
+	Timeout      types.String `tfsdk:"timeout"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	OperationID  types.String `tfsdk:"operation_id"`
+	Results      types.List   `tfsdk:"results"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+type nestedExecResult struct {
+	Receiver   types.String `tfsdk:"receiver"`
+	Status     types.String `tfsdk:"status"`
+	ReturnCode types.Int64  `tfsdk:"return_code"`
+	Stdout     types.String `tfsdk:"stdout"`
+	Stderr     types.String `tfsdk:"stderr"`
+}
+
+var execResultType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"receiver":    types.StringType,
+		"status":      types.StringType,
+		"return_code": types.Int64Type,
+		"stdout":      types.StringType,
+		"stderr":      types.StringType,
+	},
+}
+
+func (r *execResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exec"
+}
+
+func (r *execResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that runs a command on units or machines of a model, as `juju exec` does. " +
+			"It is an escape hatch for the steps charms do not cover, prefer the actions and config of the " +
+			"charms. The command runs again when any of the attributes changes, e.g. the triggers. The " +
+			"apply fails when the command fails on any of the targets, and the command runs again on the " +
+			"next apply. Destroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model to run the command in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"command": schema.StringAttribute{
+				Description: "The command to run, with the hook tools of the charms available on units.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"applications": schema.SetAttribute{
+				Description: "The applications to run the command on all units of.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(applicationNameValidator()),
+					setvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("units"),
+						path.MatchRoot("machines"),
+					}...),
+				},
+			},
+			"units": schema.SetAttribute{
+				Description: "The units to run the command on, e.g. `postgresql/0` or `postgresql/leader`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"machines": schema.SetAttribute{
+				Description: "The machines to run the command on, not supported by kubernetes models.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long the command is waited for, e.g. `10m`. Defaults to 5m.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringIsDurationValidator{},
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which run the command again when they change, e.g. the " +
+					"revision of a charm the command completes the setup of.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation_id": schema.StringAttribute{
+				Description: "The ID of the operation of the command, to show it with `juju show-operation`.",
+				Computed:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The results of the command on each of the units and machines.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"receiver": schema.StringAttribute{
+							Description: "The unit or machine the command ran on.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the command, e.g. completed.",
+							Computed:    true,
+						},
+						"return_code": schema.Int64Attribute{
+							Description: "The return code of the command.",
+							Computed:    true,
+						},
+						"stdout": schema.StringAttribute{
+							Description: "The standard output of the command.",
+							Computed:    true,
+						},
+						"stderr": schema.StringAttribute{
+							Description: "The standard error of the command.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *execResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceExec)
+}
+
+func (r *execResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "exec", "create")
+		return
+	}
+
+	var plan execResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := juju.ExecInput{
+		ModelName: plan.ModelName.ValueString(),
+		Commands:  plan.Command.ValueString(),
+		Timeout:   defaultExecTimeout,
+	}
+	if !plan.Timeout.IsNull() {
+		timeout, err := time.ParseDuration(plan.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse timeout, got error: %s", err))
+			return
+		}
+		input.Timeout = timeout
+	}
+	if !plan.Applications.IsNull() {
+		resp.Diagnostics.Append(plan.Applications.ElementsAs(ctx, &input.Applications, false)...)
+	}
+	if !plan.Units.IsNull() {
+		resp.Diagnostics.Append(plan.Units.ElementsAs(ctx, &input.Units, false)...)
+	}
+	if !plan.Machines.IsNull() {
+		resp.Diagnostics.Append(plan.Machines.ElementsAs(ctx, &input.Machines, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Exec.Exec(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run command, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("command run: %q", response.OperationID))
+
+	var failures []string
+	for _, result := range response.Results {
+		if result.Status == params.ActionCompleted && result.ReturnCode == 0 {
+			continue
+		}
+		output := result.Stderr
+		if output == "" {
+			output = result.Message
+		}
+		failures = append(failures, fmt.Sprintf("%s (%s, return code %d): %s",
+			result.Receiver, result.Status, result.ReturnCode, output))
+	}
+	if len(failures) > 0 {
+		resp.Diagnostics.AddError("Command Failed", fmt.Sprintf("The command of operation %s failed on:\n%s",
+			response.OperationID, strings.Join(failures, "\n")))
+		return
+	}
+
+	results, dErr := execResultsValue(ctx, response.Results)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.OperationID = types.StringValue(response.OperationID)
+	plan.Results = results
+	plan.ID = types.StringValue(response.OperationID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as it is, the results of the command do not
+// change once it ran.
+func (r *execResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil || r.client.Offline {
+		return
+	}
+
+	var state execResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, all of the attributes require the
+// resource to be replaced.
+func (r *execResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan execResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the command from the state, what the command
+// did is kept.
+func (r *execResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state execResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace(fmt.Sprintf("command removed from the state: %q", state.ID.ValueString()))
+}
+
+func (r *execResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceExec, msg, additionalFields...)
+}
+
+func execResultsValue(ctx context.Context, results []juju.ExecResult) (types.List, diag.Diagnostics) {
+	nested := make([]nestedExecResult, 0, len(results))
+	for _, result := range results {
+		nested = append(nested, nestedExecResult{
+			Receiver:   types.StringValue(result.Receiver),
+			Status:     types.StringValue(result.Status),
+			ReturnCode: types.Int64Value(int64(result.ReturnCode)),
+			Stdout:     types.StringValue(result.Stdout),
+			Stderr:     types.StringValue(result.Stderr),
+		})
+	}
+	return types.ListValueFrom(ctx, execResultType, nested)
+}
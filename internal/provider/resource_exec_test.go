@@ -0,0 +1,73 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceExec(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-exec")
+
+	resourceName := "juju_exec.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceExec(modelName, "echo hello", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "operation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "operation_id"),
+					resource.TestCheckResourceAttr(resourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.return_code", "0"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.stdout", "hello\n"),
+				),
+			},
+			{
+				// The command runs again when the triggers change.
+				Config: testAccResourceExec(modelName, "echo hello", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.stdout", "hello\n"),
+				),
+			},
+			{
+				Config:      testAccResourceExec(modelName, "exit 3", "2"),
+				ExpectError: regexp.MustCompile("return code 3"),
+			},
+		},
+	})
+}
+
+func testAccResourceExec(modelName, command, run string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_machine" "test" {
+  model            = juju_model.test.name
+  wait_for_started = true
+}
+
+resource "juju_exec" "test" {
+  model    = juju_model.test.name
+  command  = %q
+  machines = [juju_machine.test.machine_id]
+
+  triggers = {
+    run = %q
+  }
+}`, modelName, command, run)
+}
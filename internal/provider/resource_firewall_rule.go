@@ -0,0 +1,288 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &firewallRuleResource{}
+var _ resource.ResourceWithConfigure = &firewallRuleResource{}
+var _ resource.ResourceWithImportState = &firewallRuleResource{}
+
+func NewFirewallRuleResource() resource.Resource {
+	return &firewallRuleResource{}
+}
+
+type firewallRuleResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type firewallRuleResourceModel struct {
+	ModelName types.String `tfsdk:"model"`
+	Service   types.String `tfsdk:"service"`
+	Allowlist types.Set    `tfsdk:"allowlist"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *firewallRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rule"
+}
+
+func (r *firewallRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a firewall rule of a model, as `juju set-firewall-rule` does. " +
+			"The rule is held by the ssh-allow or saas-ingress-allow config of the model, which are not to be " +
+			"set in the config of the juju_model as well. Destroying the rule allows any address again.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model the rule applies to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The well known service the rule applies to: `ssh`, or `juju-application-offer` " +
+					"for the ingress of consumers of the offers of the model.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("ssh", "juju-application-offer"),
+				},
+			},
+			"allowlist": schema.SetAttribute{
+				Description: "The CIDRs allowed to access the service, e.g. 192.168.1.0/24.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringIsCIDRValidator{}),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *firewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceFirewallRule)
+}
+
+// ImportState is called when the provider must import the state of a
+// resource instance. The ID holds the model and the service of the
+// rule, read back by Read.
+func (r *firewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "firewall_rule", "create")
+		return
+	}
+
+	var plan firewallRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(newFirewallRuleID(plan.ModelName.ValueString(), plan.Service.ValueString()))
+	r.trace(fmt.Sprintf("firewall rule created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "firewall_rule", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state firewallRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName, service := modelAndServiceFromFirewallRuleID(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.FirewallRules.ReadFirewallRule(juju.ReadFirewallRuleInput{
+		ModelName: modelName,
+		Service:   service,
+	})
+	if errors.Is(err, errors.NotFound) {
+		// Model manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rule, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read firewall rule: %q", state.ID.ValueString()))
+
+	allowlist, dErr := types.SetValueFrom(ctx, types.StringType, response.Allowlist)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ModelName = types.StringValue(modelName)
+	state.Service = types.StringValue(service)
+	state.Allowlist = allowlist
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the allowlist of the rule, the model and the service
+// require the resource to be replaced.
+func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "firewall_rule", "update")
+		return
+	}
+
+	var plan firewallRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace(fmt.Sprintf("firewall rule updated: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resets the allowlist of the service to the default of the
+// model, which allows any address.
+func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "firewall_rule", "delete")
+		return
+	}
+
+	var state firewallRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.FirewallRules.DestroyFirewallRule(juju.DestroyFirewallRuleInput{
+		ModelName: state.ModelName.ValueString(),
+		Service:   state.Service.ValueString(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall rule, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("firewall rule deleted: %q", state.ID.ValueString()))
+}
+
+// set sets the allowlist of the service of the model from the plan.
+func (r *firewallRuleResource) set(ctx context.Context, plan firewallRuleResourceModel, diags *diag.Diagnostics) {
+	var allowlist []string
+	diags.Append(plan.Allowlist.ElementsAs(ctx, &allowlist, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	if err := r.client.FirewallRules.SetFirewallRule(juju.SetFirewallRuleInput{
+		ModelName: plan.ModelName.ValueString(),
+		Service:   plan.Service.ValueString(),
+		Allowlist: allowlist,
+	}); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set firewall rule, got error: %s", err))
+	}
+}
+
+func (r *firewallRuleResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceFirewallRule, msg, additionalFields...)
+}
+
+func newFirewallRuleID(modelName, service string) string {
+	return fmt.Sprintf("%s:%s", modelName, service)
+}
+
+// Firewall rules can be imported using the format: `model_name:service`.
+func modelAndServiceFromFirewallRuleID(value string, diags *diag.Diagnostics) (string, string) {
+	id := strings.Split(value, ":")
+	//If importing with an incorrect ID we need to catch and provide a user-friendly error
+	if len(id) != 2 {
+		diags.AddError("Malformed ID", fmt.Sprintf("unable to parse model name and service from provided ID: %q", value))
+		return "", ""
+	}
+	return id[0], id[1]
+}
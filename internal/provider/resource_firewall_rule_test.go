@@ -0,0 +1,64 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceFirewallRule(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-firewall-rule")
+
+	resourceName := "juju_firewall_rule.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceFirewallRule(modelName, `"192.168.1.0/24", "10.0.0.0/8"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model", modelName),
+					resource.TestCheckResourceAttr(resourceName, "service", "ssh"),
+					resource.TestCheckResourceAttr(resourceName, "allowlist.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowlist.*", "192.168.1.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowlist.*", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:ssh", modelName)),
+				),
+			},
+			{
+				Config: testAccResourceFirewallRule(modelName, `"10.0.0.0/8"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowlist.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowlist.*", "10.0.0.0/8"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:ssh", modelName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceFirewallRule(modelName, allowlist string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_firewall_rule" "test" {
+  model     = juju_model.test.name
+  service   = "ssh"
+  allowlist = [%s]
+}`, modelName, allowlist)
+}
@@ -7,13 +7,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -24,11 +28,21 @@ import (
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
+// defaultJoinedTimeout is how long an integration is waited for to be
+// joined when joined_timeout is not set.
+const defaultJoinedTimeout = 30 * time.Minute
+
+// integrationRemovalTimeout is how long an integration is waited for
+// to be removed when it is destroyed.
+const integrationRemovalTimeout = 10 * time.Minute
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &integrationResource{}
 var _ resource.ResourceWithConfigure = &integrationResource{}
 var _ resource.ResourceWithImportState = &integrationResource{}
 var _ resource.ResourceWithValidateConfig = &integrationResource{}
+var _ resource.ResourceWithModifyPlan = &integrationResource{}
+var _ resource.ResourceWithUpgradeState = &integrationResource{}
 
 func NewIntegrationResource() resource.Resource {
 	return &integrationResource{}
@@ -42,9 +56,15 @@ type integrationResource struct {
 }
 
 type integrationResourceModel struct {
-	ModelName   types.String `tfsdk:"model"`
-	Via         types.String `tfsdk:"via"`
-	Application types.Set    `tfsdk:"application"`
+	ModelName           types.String `tfsdk:"model"`
+	Via                 types.String `tfsdk:"via"`
+	OfferConsumeDetails types.String `tfsdk:"offer_consume_details"`
+	// WaitForJoined and JoinedTimeout are only used when the
+	// integration is created.
+	WaitForJoined types.Bool   `tfsdk:"wait_for_joined"`
+	JoinedTimeout types.String `tfsdk:"joined_timeout"`
+	Force         types.Bool   `tfsdk:"force"`
+	Application   types.Set    `tfsdk:"application"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -55,11 +75,40 @@ type nestedApplication struct {
 	Name     types.String `tfsdk:"name"`
 	Endpoint types.String `tfsdk:"endpoint"`
 	OfferURL types.String `tfsdk:"offer_url"`
+	SAASName types.String `tfsdk:"saas_name"`
 }
 
 func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importModelKeyedID(ctx, r.client, req, resp)
+}
+
+// UpgradeState upgrades the states of the integrations with an ID keyed
+// on the model name to the model UUID.
+func (r *integrationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// The prior state only lacks the attributes added since, which
+	// are read as null with the current schema.
+	var priorSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &priorSchema)
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &priorSchema.Schema,
+			StateUpgrader: r.upgradeStateV0,
+		},
+	}
+}
+
+func (r *integrationResource) upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state integrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = upgradeModelKeyedID(r.client, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *integrationResource) Configure(ctx context.Context, req resource.ConfigureRequest,
@@ -94,15 +143,126 @@ func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.V
 
 	var apps []nestedApplication
 	configData.Application.ElementsAs(ctx, &apps, false)
+	hasOfferURL := false
 	for _, app := range apps {
+		if !app.OfferURL.IsNull() {
+			hasOfferURL = true
+		}
 		if app.Name.IsNull() && app.OfferURL.IsNull() {
 			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "one and only one of \"name\" or \"offer_url\" fields must be provided.")
 		} else if !app.OfferURL.IsNull() && !app.Name.IsNull() {
 			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "the \"offer_url\" and \"name\" fields are mutually exclusive.")
 		} else if !app.OfferURL.IsNull() && !app.Endpoint.IsNull() {
 			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "the \"endpoint\" field can not be specified with the \"offer_url\" field.")
+		} else if app.OfferURL.IsNull() && !app.SAASName.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "the \"saas_name\" field can only be specified with the \"offer_url\" field.")
+		}
+	}
+	if !configData.OfferConsumeDetails.IsNull() && !hasOfferURL {
+		resp.Diagnostics.AddAttributeError(path.Root("offer_consume_details"), "Attribute Error", "\"offer_consume_details\" requires an application with the \"offer_url\" field.")
+	}
+}
+
+// ModifyPlan is called when the provider has an opportunity to modify
+// the plan. The endpoints of the applications already deployed are
+// validated against the metadata of their charms, so an unknown
+// endpoint or incompatible endpoints are reported at plan time rather
+// than mid-apply.
+func (r *integrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The plan is null when the resource is being destroyed, and
+	// the client is not configured when the provider config is
+	// not known yet. The endpoints cannot be checked offline.
+	if req.Plan.Raw.IsNull() || r.client == nil || r.client.Offline {
+		return
+	}
+
+	var plan integrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ModelName.IsUnknown() || plan.Application.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state integrationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || plan.Application.Equal(state.Application) {
+			return
+		}
+	}
+
+	var apps []nestedApplication
+	resp.Diagnostics.Append(plan.Application.ElementsAs(ctx, &apps, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var appNames []string
+	for _, app := range apps {
+		if app.Name.IsUnknown() || app.Endpoint.IsUnknown() {
+			return
+		}
+		if !app.Name.IsNull() {
+			appNames = append(appNames, app.Name.ValueString())
 		}
 	}
+	if len(appNames) == 0 {
+		return
+	}
+
+	appEndpoints, err := r.client.Integrations.ReadApplicationEndpoints(juju.ReadApplicationEndpointsInput{
+		ModelName: plan.ModelName.ValueString(),
+		AppNames:  appNames,
+	})
+	if err != nil {
+		// The model may not exist yet, the endpoints are then
+		// validated when the integration is created.
+		r.trace(fmt.Sprintf("unable to read the endpoints of %q, got error: %s", appNames, err))
+		return
+	}
+	resp.Diagnostics.Append(validateIntegrationEndpoints(apps, appEndpoints)...)
+}
+
+// validateIntegrationEndpoints checks the endpoints of the applications
+// exist on their charms and, when both are known, that they can be
+// integrated. Applications without charm endpoints, i.e. not deployed
+// yet or consumed from an offer, are not validated.
+func validateIntegrationEndpoints(apps []nestedApplication, appEndpoints map[string]map[string]juju.CharmEndpoint) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var endpoints []juju.CharmEndpoint
+	for _, app := range apps {
+		name, endpointName := app.Name.ValueString(), app.Endpoint.ValueString()
+		charmEndpoints, ok := appEndpoints[name]
+		if !ok || endpointName == "" {
+			continue
+		}
+		endpoint, ok := charmEndpoints[endpointName]
+		if !ok {
+			available := make([]string, 0, len(charmEndpoints))
+			for charmEndpointName := range charmEndpoints {
+				available = append(available, charmEndpointName)
+			}
+			sort.Strings(available)
+			diags.AddAttributeError(path.Root("application"), "Invalid Endpoint",
+				fmt.Sprintf("application %q has no endpoint %q, its endpoints are: %s", name, endpointName, strings.Join(available, ", ")))
+			continue
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	if diags.HasError() || len(endpoints) != 2 {
+		return diags
+	}
+
+	a, b := endpoints[0], endpoints[1]
+	switch {
+	case a.Role == "peer" || b.Role == "peer":
+		diags.AddAttributeError(path.Root("application"), "Invalid Endpoint",
+			"peer endpoints can not be integrated with another application")
+	case a.Interface != b.Interface:
+		diags.AddAttributeError(path.Root("application"), "Incompatible Endpoints",
+			fmt.Sprintf("endpoint %q has interface %q and endpoint %q has interface %q", a.Name, a.Interface, b.Name, b.Interface))
+	case a.Role == b.Role:
+		diags.AddAttributeError(path.Root("application"), "Incompatible Endpoints",
+			fmt.Sprintf("endpoints %q and %q are both %ss, one provider and one requirer are needed", a.Name, b.Name, a.Role))
+	}
+	return diags
 }
 
 func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -111,15 +271,71 @@ func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataR
 
 func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 keys the ID on the model UUID.
+		Version:     1,
 		Description: "A resource that represents a Juju Integration.",
 		Attributes: map[string]schema.Attribute{
 			"model": schema.StringAttribute{
-				Description: "The name of the model to operate in.",
+				Description: "The name or UUID of the model to operate in. Changing it recreates the integration.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
 			},
 			"via": schema.StringAttribute{
-				Description: "A comma separated list of CIDRs for outbound traffic.",
+				Description: "A comma separated list of CIDRs for outbound traffic of a cross-model " +
+					"integration, as `juju integrate --via` does, e.g. the egress subnets of a model behind " +
+					"NAT. Changing it recreates the integration.",
+				Optional: true,
+				Validators: []validator.String{
+					stringIsCIDRListValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_consume_details": schema.StringAttribute{
+				Description: "The consume details of the offer of the `offer_url` application, read with " +
+					"the juju_offer_consume_details data source of the provider connected to the controller " +
+					"of the offer. It allows to consume offers of another controller. The details are only " +
+					"used when the offer is consumed, changes to them are ignored.",
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					consumeDetailsPlanModifier{},
+				},
+			},
+			"wait_for_joined": schema.BoolAttribute{
+				Description: "Wait for the integration to be joined, and the units of its applications to be " +
+					"idle, when the integration is created. Resources depending on the integration, e.g. on the " +
+					"credentials a database grants, are then only created once the relation hooks have " +
+					"completed on both sides. The units of an application consumed from an offer are not " +
+					"waited for. The apply fails when a unit goes into error, or is blocked once the timeout " +
+					"is exceeded, with the status message of the unit, the hook which failed and its last log lines.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"joined_timeout": schema.StringAttribute{
+				Description: "How long the integration is waited for to be joined, e.g. `10m`. Defaults to 30m.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringIsDurationValidator{},
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("wait_for_joined"),
+					}...),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description: "Remove the integration even if its relation hooks fail, as " +
+					"`juju remove-relation --force` does, e.g. to break an integration stuck while " +
+					"departing. The integration is always waited for to be removed when it is destroyed.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -140,19 +356,34 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 						"name": schema.StringAttribute{
 							Description: "The name of the application.",
 							Optional:    true,
+							Validators: []validator.String{
+								applicationNameValidator(),
+							},
 						},
 						"endpoint": schema.StringAttribute{
 							Description: "The endpoint name.",
 							Optional:    true,
 							Computed:    true,
+							Validators: []validator.String{
+								endpointNameValidator(),
+							},
 						},
 						"offer_url": schema.StringAttribute{
-							Description: "The URL of a remote application.",
-							Optional:    true,
+							Description: "The URL of a remote application. The offer is consumed by the " +
+								"provider, or an existing SAAS of the model consuming it is used.",
+							Optional: true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
+						"saas_name": schema.StringAttribute{
+							Description: "The name the offer of `offer_url` is consumed as in the model, as " +
+								"`juju consume <offer> <alias>` does. Defaults to the offer name. It must be set " +
+								"when an application of the model has the offer name. The consumed offer is " +
+								"removed with the last integration using it.",
+							Optional: true,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -175,6 +406,13 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 	modelName := plan.ModelName.ValueString()
+	// The ID is keyed on the model UUID, read before the integration
+	// is created.
+	modelUUID, err := modelUUIDForID(r.client, modelName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the UUID of model %q, got error: %s", modelName, err))
+		return
+	}
 
 	var apps []nestedApplication
 	resp.Diagnostics.Append(plan.Application.ElementsAs(ctx, &apps, false)...)
@@ -191,8 +429,10 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	var offerResponse = &juju.ConsumeRemoteOfferResponse{}
 	if offerURL != nil {
 		offerResponse, err = r.client.Offers.ConsumeRemoteOffer(&juju.ConsumeRemoteOfferInput{
-			ModelName: modelName,
-			OfferURL:  *offerURL,
+			ModelName:      modelName,
+			OfferURL:       *offerURL,
+			SAASName:       offerSAASName(apps),
+			ConsumeDetails: plan.OfferConsumeDetails.ValueString(),
 		})
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to consume remote offer, got error: %s", err))
@@ -228,10 +468,34 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	plan.Application = parsedApps
 
-	id := newIDForIntegrationResource(modelName, response.Applications)
+	id := newIDForIntegrationResource(modelUUID, response.Applications)
 	plan.ID = types.StringValue(id)
 
 	r.trace(fmt.Sprintf("integration resource created: %q", id))
+
+	if plan.WaitForJoined.ValueBool() {
+		joinedTimeout := defaultJoinedTimeout
+		if !plan.JoinedTimeout.IsNull() {
+			joinedTimeout, err = time.ParseDuration(plan.JoinedTimeout.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("Unable to parse joined timeout, got error: %s", err))
+			}
+		}
+		if !resp.Diagnostics.HasError() {
+			joinedEndpoints := make([]string, 0, len(response.Applications))
+			for _, app := range response.Applications {
+				joinedEndpoints = append(joinedEndpoints, fmt.Sprintf("%s:%s", app.Name, app.Endpoint))
+			}
+			if err := r.client.Integrations.WaitForIntegrationJoined(ctx, juju.WaitForIntegrationJoinedInput{
+				ModelName: modelName,
+				Endpoints: joinedEndpoints,
+			}, joinedTimeout); err != nil {
+				// The integration is created, it is saved in the
+				// state so it can be destroyed.
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for integration %q, got error: %s", id, err))
+			}
+		}
+	}
 	// Write the state plan into the Response.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -243,6 +507,10 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	if r.client.Offline {
+		return
+	}
+
 	var state integrationResourceModel
 
 	// Read Terraform configuration from the request into the model
@@ -263,16 +531,32 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 			endpointA,
 			endpointB,
 		},
+		CachedStatus: true,
 	}
 
 	response, err := r.client.Integrations.ReadIntegration(integration)
 	if err != nil {
-		resp.Diagnostics.Append(handleIntegrationNotFoundError(ctx, err, &resp.State)...)
+		resp.Diagnostics.Append(handleIntegrationNotFoundError(ctx, r.client, err, &resp.State)...)
 		return
 	}
 	r.trace(fmt.Sprintf("found integration: %v", integration))
 
-	state.ModelName = types.StringValue(modelName)
+	// The model is only read from the ID when the integration is
+	// imported.
+	if state.ModelName.IsNull() {
+		importedModel, err := importedModelName(r.client, modelName)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model %q, got error: %s", modelName, err))
+			return
+		}
+		state.ModelName = types.StringValue(importedModel)
+	}
+	if state.WaitForJoined.IsNull() {
+		state.WaitForJoined = types.BoolValue(false)
+	}
+	if state.Force.IsNull() {
+		state.Force = types.BoolValue(false)
+	}
 
 	applications := parseApplications(response.Applications)
 	appType := req.State.Schema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
@@ -303,23 +587,40 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	modelName := plan.ModelName.ValueString()
+	// The ID holds the model UUID, the integration is replaced when
+	// its model changes.
+	modelName, _, _, dErr := modelNameAndEndpointsFromID(state.ID.ValueString())
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var oldEndpoints, endpoints []string
 	var oldOfferURL, offerURL *string
+	var oldApps, newApps []nestedApplication
 	var err error
 
+	// The attributes used when the integration is created or
+	// destroyed, e.g. force, are updated in the state only.
+	resp.Diagnostics.Append(state.Application.ElementsAs(ctx, &oldApps, false)...)
+	resp.Diagnostics.Append(plan.Application.ElementsAs(ctx, &newApps, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !integrationApplicationsChanged(newApps, oldApps) {
+		plan.Application = state.Application
+		plan.ID = state.ID
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	if !plan.Application.Equal(state.Application) {
-		var oldApps []nestedApplication
-		state.Application.ElementsAs(ctx, &oldApps, false)
 		oldEndpoints, oldOfferURL, _, err = parseEndpoints(oldApps)
 		if err != nil {
 			resp.Diagnostics.AddError("Provider Error", err.Error())
 			return
 		}
 
-		var newApps []nestedApplication
-		plan.Application.ElementsAs(ctx, &newApps, false)
 		endpoints, offerURL, _, err = parseEndpoints(newApps)
 		if err != nil {
 			resp.Diagnostics.AddError("Provider Error", err.Error())
@@ -335,6 +636,7 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 			errs := r.client.Offers.RemoveRemoteOffer(&juju.RemoveRemoteOfferInput{
 				ModelName: modelName,
 				OfferURL:  *oldOfferURL,
+				SAASName:  offerSAASName(oldApps),
 			})
 			if len(errs) > 0 {
 				for _, v := range errs {
@@ -345,9 +647,18 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 			r.trace(fmt.Sprintf("removed offer on Juju: %q", *oldOfferURL))
 		}
 		if offerURL != nil {
+			// The plan keeps the details of the state, the offer is
+			// consumed with the details of the config.
+			var consumeDetails types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("offer_consume_details"), &consumeDetails)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
 			offerResponse, err = r.client.Offers.ConsumeRemoteOffer(&juju.ConsumeRemoteOfferInput{
-				ModelName: modelName,
-				OfferURL:  *offerURL,
+				ModelName:      modelName,
+				OfferURL:       *offerURL,
+				SAASName:       offerSAASName(newApps),
+				ConsumeDetails: consumeDetails.ValueString(),
 			})
 			if err != nil {
 				resp.Diagnostics.AddError("Client Error", err.Error())
@@ -401,33 +712,63 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	modelName := state.ModelName.ValueString()
+	modelName, _, _, dErr := modelNameAndEndpointsFromID(state.ID.ValueString())
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var apps []nestedApplication
 	state.Application.ElementsAs(ctx, &apps, false)
-	endpoints, _, _, err := parseEndpoints(apps)
+	endpoints, offerURL, appNames, err := parseEndpoints(apps)
 	if err != nil {
 		resp.Diagnostics.AddError("Provider Error", err.Error())
 		return
 	}
 
-	// Remove the integration
-	err = r.client.Integrations.DestroyIntegration(&juju.IntegrationInput{
+	// The consumed offer is one of the endpoints of the integration.
+	if saasName := offerSAASName(apps); saasName != "" {
+		endpoints = append(endpoints, saasName)
+	}
+
+	// Remove the integration, and wait for its units to leave the
+	// relation, so the applications can be destroyed right after.
+	err = r.client.Integrations.DestroyIntegration(ctx, &juju.DestroyIntegrationInput{
 		ModelName: modelName,
 		Endpoints: endpoints,
+		Force:     state.Force.ValueBool(),
+		Timeout:   integrationRemovalTimeout,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove integration, got error: %s. "+
+			"Set force to true to remove an integration stuck while departing.", err))
 		return
 	}
 	r.trace(fmt.Sprintf("Deleted integration resource: %q", state.ID.ValueString()))
+
+	// Remove the consumed offer, unless other integrations use it.
+	if offerURL != nil && len(appNames) == 1 {
+		errs := r.client.Offers.RemoveRemoteOffer(&juju.RemoveRemoteOfferInput{
+			ModelName:          modelName,
+			OfferURL:           *offerURL,
+			SAASName:           offerSAASName(apps),
+			RemovedApplication: appNames[0],
+		})
+		// The integration is removed, the resource is removed from
+		// the state whether the consumed offer is removed or not.
+		for _, v := range errs {
+			resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to remove consumed offer %q, got error: %s", *offerURL, v))
+		}
+		if len(errs) == 0 {
+			r.trace(fmt.Sprintf("removed consumed offer of %q", *offerURL))
+		}
+	}
 }
 
-func handleIntegrationNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
-	if errors.As(err, &juju.NoIntegrationFoundError) {
+func handleIntegrationNotFoundError(ctx context.Context, client *juju.Client, err error, st *tfsdk.State) diag.Diagnostics {
+	if errors.As(err, &juju.NoIntegrationFoundError) || isModelNotFound(err) {
 		// Integration manually removed
-		st.RemoveResource(ctx)
-		return diag.Diagnostics{}
+		return removeMissingResource(ctx, client, st, "integration", err)
 	}
 	var diags diag.Diagnostics
 	diags.AddError("Client Error", err.Error())
@@ -468,6 +809,26 @@ func modelNameAndEndpointsFromID(ID string) (string, string, string, diag.Diagno
 
 // This function can be used to parse the terraform data into usable juju endpoints
 // it also does some sanity checks on inputs and returns user friendly errors
+// consumeDetailsPlanModifier keeps the offer consume details of the
+// state. The details hold a macaroon, which is new each time they are
+// read, and are only used when the offer is consumed.
+type consumeDetailsPlanModifier struct{}
+
+func (m consumeDetailsPlanModifier) Description(_ context.Context) string {
+	return "Changes to the offer consume details are ignored once the offer is consumed."
+}
+
+func (m consumeDetailsPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m consumeDetailsPlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
 func parseEndpoints(apps []nestedApplication) (endpoints []string, offer *string, appNames []string, err error) {
 	for _, app := range apps {
 		name := app.Name.ValueString()
@@ -499,6 +860,43 @@ func parseEndpoints(apps []nestedApplication) (endpoints []string, offer *string
 	return endpoints, offer, appNames, nil
 }
 
+// integrationApplicationsChanged returns whether the planned
+// applications differ from the applications of the state. Computed
+// attributes which are unknown in the plan are not compared.
+func integrationApplicationsChanged(planApps, stateApps []nestedApplication) bool {
+	if len(planApps) != len(stateApps) {
+		return true
+	}
+	knownEqual := func(plan, state types.String) bool {
+		return plan.IsUnknown() || plan.IsNull() || plan.Equal(state)
+	}
+	for _, planApp := range planApps {
+		found := false
+		for _, stateApp := range stateApps {
+			if planApp.Name.Equal(stateApp.Name) && planApp.OfferURL.Equal(stateApp.OfferURL) &&
+				knownEqual(planApp.Endpoint, stateApp.Endpoint) && knownEqual(planApp.SAASName, stateApp.SAASName) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+	return false
+}
+
+// offerSAASName returns the saas_name of the application consuming an
+// offer, empty when it is not known.
+func offerSAASName(apps []nestedApplication) string {
+	for _, app := range apps {
+		if !app.OfferURL.IsNull() {
+			return app.SAASName.ValueString()
+		}
+	}
+	return ""
+}
+
 func parseApplications(apps []juju.Application) []nestedApplication {
 	applications := make([]nestedApplication, 2)
 
@@ -507,6 +905,7 @@ func parseApplications(apps []juju.Application) []nestedApplication {
 
 		if app.OfferURL != nil {
 			a.OfferURL = types.StringValue(*app.OfferURL)
+			a.SAASName = types.StringValue(app.Name)
 		} else {
 			a.Endpoint = types.StringValue(app.Endpoint)
 			a.Name = types.StringValue(app.Name)
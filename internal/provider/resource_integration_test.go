@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/config"
@@ -28,7 +29,7 @@ func TestAcc_ResourceIntegration(t *testing.T) {
 				Config: testAccResourceIntegration(modelName, "base = \"ubuntu@22.04\"", "base = \"ubuntu@22.04\""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.this", "model", modelName),
-					resource.TestCheckResourceAttr("juju_integration.this", "id", fmt.Sprintf("%v:%v:%v", modelName, "one:source", "two:sink")),
+					testAccCheckModelUUIDAttr("juju_integration.this", "id", "juju_model.this", "one:source:two:sink"),
 					resource.TestCheckResourceAttr("juju_integration.this", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.this", "application.*", map[string]string{"name": "one", "endpoint": "source"}),
 				),
@@ -42,7 +43,7 @@ func TestAcc_ResourceIntegration(t *testing.T) {
 				Config: testAccResourceIntegration(modelName, "base = \"ubuntu@22.04\"", "base = \"ubuntu@22.04\""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.this", "model", modelName),
-					resource.TestCheckResourceAttr("juju_integration.this", "id", fmt.Sprintf("%v:%v:%v", modelName, "one:source", "two:sink")),
+					testAccCheckModelUUIDAttr("juju_integration.this", "id", "juju_model.this", "one:source:two:sink"),
 					resource.TestCheckResourceAttr("juju_integration.this", "application.#", "2"),
 				),
 			},
@@ -50,6 +51,154 @@ func TestAcc_ResourceIntegration(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceIntegrationWaitForJoined(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-integration")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationWaitForJoined(modelName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.this", "wait_for_joined", "true"),
+					resource.TestCheckResourceAttr("juju_integration.this", "force", "false"),
+					testAccCheckModelUUIDAttr("juju_integration.this", "id", "juju_model.this", "one:source:two:sink"),
+				),
+			},
+			{
+				// force is only used when the integration is
+				// destroyed, it is updated in place.
+				Config: testAccResourceIntegrationWaitForJoined(modelName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.this", "force", "true"),
+					testAccCheckModelUUIDAttr("juju_integration.this", "id", "juju_model.this", "one:source:two:sink"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceIntegrationWaitForJoined(modelName string, force bool) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "one" {
+	model = juju_model.this.name
+	name  = "one"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_application" "two" {
+	model = juju_model.this.name
+	name  = "two"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_integration" "this" {
+	model           = juju_model.this.name
+	wait_for_joined = true
+	joined_timeout  = "20m"
+	force           = %t
+
+	application {
+		name     = juju_application.one.name
+		endpoint = "source"
+	}
+
+	application {
+		name     = juju_application.two.name
+		endpoint = "sink"
+	}
+}
+`, modelName, force)
+}
+
+func TestAcc_ResourceIntegrationInvalidEndpoints(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-integration")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationEndpoints(modelName, "source", "sink"),
+				Check:  testAccCheckModelUUIDAttr("juju_integration.this", "id", "juju_model.this", "one:source:two:sink"),
+			},
+			{
+				// The applications are deployed, their endpoints
+				// are validated at plan time.
+				Config:      testAccResourceIntegrationEndpoints(modelName, "source", "unknown"),
+				ExpectError: regexp.MustCompile(`application "two" has no endpoint "unknown"`),
+			},
+			{
+				Config:      testAccResourceIntegrationEndpoints(modelName, "source", "juju-info"),
+				ExpectError: regexp.MustCompile(`Incompatible Endpoints`),
+			},
+		},
+	})
+}
+
+func testAccResourceIntegrationEndpoints(modelName, endpointOne, endpointTwo string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "one" {
+	model = juju_model.this.name
+	name  = "one"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_application" "two" {
+	model = juju_model.this.name
+	name  = "two"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_integration" "this" {
+	model = juju_model.this.name
+
+	application {
+		name     = juju_application.one.name
+		endpoint = %q
+	}
+
+	application {
+		name     = juju_application.two.name
+		endpoint = %q
+	}
+}
+`, modelName, endpointOne, endpointTwo)
+}
+
 func TestAcc_ResourceIntegrationWithViaCIDRs(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -63,16 +212,26 @@ func TestAcc_ResourceIntegrationWithViaCIDRs(t *testing.T) {
 		ProtoV6ProviderFactories: frameworkProviderFactories,
 		CheckDestroy:             testAccCheckIntegrationDestroy,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceIntegrationWithVia(srcModelName, "base = \"ubuntu@22.04\"", dstModelName, "base = \"ubuntu@22.04\"", "127.0.0.1/32,not-a-cidr"),
+				ExpectError: regexp.MustCompile(`"not-a-cidr" is not a CIDR`),
+			},
 			{
 				Config: testAccResourceIntegrationWithVia(srcModelName, "base = \"ubuntu@22.04\"", dstModelName, "base = \"ubuntu@22.04\"", via),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.a", "model", srcModelName),
-					resource.TestCheckResourceAttr("juju_integration.a", "id", fmt.Sprintf("%v:%v:%v", srcModelName, "a:source", "b:sink")),
+					testAccCheckModelUUIDAttr("juju_integration.a", "id", "juju_model.a", "a:source:b:sink"),
 					resource.TestCheckResourceAttr("juju_integration.a", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.a", "application.*", map[string]string{"name": "a", "endpoint": "source"}),
 					resource.TestCheckResourceAttr("juju_integration.a", "via", via),
 				),
 			},
+			{
+				// The egress subnets are set when integrating, the
+				// integration is recreated when they change.
+				Config: testAccResourceIntegrationWithVia(srcModelName, "base = \"ubuntu@22.04\"", dstModelName, "base = \"ubuntu@22.04\"", "127.0.0.2/32"),
+				Check:  resource.TestCheckResourceAttr("juju_integration.a", "via", "127.0.0.2/32"),
+			},
 		},
 	})
 }
@@ -97,7 +256,7 @@ func TestAcc_ResourceIntegration_UpgradeProvider(t *testing.T) {
 				Config: testAccResourceIntegration(modelName, "series = \"jammy\"", "series = \"jammy\""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.this", "model", modelName),
-					resource.TestCheckResourceAttr("juju_integration.this", "id", fmt.Sprintf("%v:%v:%v", modelName, "one:source", "two:sink")),
+					testAccCheckModelUUIDAttr("juju_integration.this", "id", "juju_model.this", "one:source:two:sink"),
 					resource.TestCheckResourceAttr("juju_integration.this", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.this", "application.*", map[string]string{"name": "one", "endpoint": "source"}),
 				),
@@ -212,6 +371,269 @@ resource "juju_integration" "a" {
 `, srcModelName, aOS, dstModelName, bOS, viaCIDRs)
 }
 
+func TestAcc_ResourceIntegrationWithOfferConsumeDetails(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	srcModelName := acctest.RandomWithPrefix("tf-test-integration")
+	dstModelName := acctest.RandomWithPrefix("tf-test-integration-dst")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationWithOfferConsumeDetails(srcModelName, dstModelName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelUUIDAttr("juju_integration.a", "id", "juju_model.a", "a:source:b:sink"),
+					resource.TestCheckResourceAttrSet("data.juju_offer_consume_details.b", "consume_details"),
+					resource.TestCheckResourceAttrSet("juju_integration.a", "offer_consume_details"),
+				),
+			},
+			{
+				// The macaroon of the details is new on each read,
+				// the integration must not change.
+				Config:   testAccResourceIntegrationWithOfferConsumeDetails(srcModelName, dstModelName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testAccResourceIntegrationWithOfferConsumeDetails consumes the offer
+// with details read by the data source, as with an offer of another
+// controller.
+func testAccResourceIntegrationWithOfferConsumeDetails(srcModelName, dstModelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "a" {
+	name = %q
+}
+
+resource "juju_application" "a" {
+	model = juju_model.a.name
+	name  = "a"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_model" "b" {
+	name = %q
+}
+
+resource "juju_application" "b" {
+	model = juju_model.b.name
+	name  = "b"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "b" {
+	model            = juju_model.b.name
+	application_name = juju_application.b.name
+	endpoint         = "sink"
+}
+
+data "juju_offer_consume_details" "b" {
+	offer_url = juju_offer.b.url
+}
+
+resource "juju_integration" "a" {
+	model                 = juju_model.a.name
+	offer_consume_details = data.juju_offer_consume_details.b.consume_details
+
+	application {
+		name     = juju_application.a.name
+		endpoint = "source"
+	}
+
+	application {
+		offer_url = juju_offer.b.url
+	}
+}
+`, srcModelName, dstModelName)
+}
+
+func TestAcc_ResourceIntegrationWithSAASName(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	srcModelName := acctest.RandomWithPrefix("tf-test-integration")
+	dstModelName := acctest.RandomWithPrefix("tf-test-integration-dst")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationWithSAASName(srcModelName, dstModelName, "alias-b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelUUIDAttr("juju_integration.a", "id", "juju_model.a", "a:source:alias-b:sink"),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.a", "application.*", map[string]string{"saas_name": "alias-b"}),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      "juju_integration.a",
+			},
+		},
+	})
+}
+
+func testAccResourceIntegrationWithSAASName(srcModelName, dstModelName, saasName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "a" {
+	name = %q
+}
+
+resource "juju_application" "a" {
+	model = juju_model.a.name
+	name  = "a"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_model" "b" {
+	name = %q
+}
+
+resource "juju_application" "b" {
+	model = juju_model.b.name
+	name  = "b"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "b" {
+	model            = juju_model.b.name
+	application_name = juju_application.b.name
+	endpoint         = "sink"
+}
+
+resource "juju_integration" "a" {
+	model = juju_model.a.name
+
+	application {
+		name     = juju_application.a.name
+		endpoint = "source"
+	}
+
+	application {
+		offer_url = juju_offer.b.url
+		saas_name = %q
+	}
+}
+`, srcModelName, dstModelName, saasName)
+}
+
+func TestAcc_ResourceIntegrationWithSAASNameClash(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	srcModelName := acctest.RandomWithPrefix("tf-test-integration")
+	dstModelName := acctest.RandomWithPrefix("tf-test-integration-dst")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The offer name is the name of a local application.
+				Config:      testAccResourceIntegrationWithSAASNameClash(srcModelName, dstModelName, ""),
+				ExpectError: regexp.MustCompile(`an application named "b" exists in the model`),
+			},
+			{
+				Config: testAccResourceIntegrationWithSAASNameClash(srcModelName, dstModelName, "remote-b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelUUIDAttr("juju_integration.a", "id", "juju_model.a", "a:source:remote-b:sink"),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.a", "application.*", map[string]string{"saas_name": "remote-b"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceIntegrationWithSAASNameClash(srcModelName, dstModelName, saasName string) string {
+	saas := ""
+	if saasName != "" {
+		saas = fmt.Sprintf("saas_name = %q", saasName)
+	}
+	return fmt.Sprintf(`
+resource "juju_model" "a" {
+	name = %q
+}
+
+resource "juju_application" "a" {
+	model = juju_model.a.name
+	name  = "a"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_application" "local_b" {
+	model = juju_model.a.name
+	name  = "b"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_model" "b" {
+	name = %q
+}
+
+resource "juju_application" "b" {
+	model = juju_model.b.name
+	name  = "b"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "b" {
+	model            = juju_model.b.name
+	application_name = juju_application.b.name
+	endpoint         = "sink"
+}
+
+resource "juju_integration" "a" {
+	model = juju_model.a.name
+
+	application {
+		name     = juju_application.a.name
+		endpoint = "source"
+	}
+
+	application {
+		offer_url = juju_offer.b.url
+		%s
+	}
+}
+`, srcModelName, dstModelName, saas)
+}
+
 func TestAcc_ResourceIntegrationWithMultipleConsumers(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -232,11 +654,11 @@ func TestAcc_ResourceIntegrationWithMultipleConsumers(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.b1.0", "model", dstModelName),
-					resource.TestCheckResourceAttr("juju_integration.b1.0", "id", fmt.Sprintf("%v:%v:%v", dstModelName, "a:db-admin", "b1:backend-db-admin")),
+					testAccCheckModelUUIDAttr("juju_integration.b1.0", "id", "juju_model.b", "a:db-admin:b1:backend-db-admin"),
 					resource.TestCheckResourceAttr("juju_integration.b1.0", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b1.0", "application.*", map[string]string{"name": "b1", "endpoint": "backend-db-admin"}),
 					resource.TestCheckResourceAttr("juju_integration.b2.0", "model", dstModelName),
-					resource.TestCheckResourceAttr("juju_integration.b2.0", "id", fmt.Sprintf("%v:%v:%v", dstModelName, "a:db-admin", "b2:backend-db-admin")),
+					testAccCheckModelUUIDAttr("juju_integration.b2.0", "id", "juju_model.b", "a:db-admin:b2:backend-db-admin"),
 					resource.TestCheckResourceAttr("juju_integration.b2.0", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b2.0", "application.*", map[string]string{"name": "b2", "endpoint": "backend-db-admin"}),
 				),
@@ -249,7 +671,7 @@ func TestAcc_ResourceIntegrationWithMultipleConsumers(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_integration.b1.0", "model", dstModelName),
-					resource.TestCheckResourceAttr("juju_integration.b1.0", "id", fmt.Sprintf("%v:%v:%v", dstModelName, "a:db-admin", "b1:backend-db-admin")),
+					testAccCheckModelUUIDAttr("juju_integration.b1.0", "id", "juju_model.b", "a:db-admin:b1:backend-db-admin"),
 					resource.TestCheckResourceAttr("juju_integration.b1.0", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b1.0", "application.*", map[string]string{"name": "b1", "endpoint": "backend-db-admin"}),
 				),
@@ -0,0 +1,314 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &jaasAccessRelationResource{}
+var _ resource.ResourceWithConfigure = &jaasAccessRelationResource{}
+var _ resource.ResourceWithImportState = &jaasAccessRelationResource{}
+
+func NewJAASAccessRelationResource() resource.Resource {
+	return &jaasAccessRelationResource{}
+}
+
+type jaasAccessRelationResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type jaasAccessRelationResourceModel struct {
+	TargetObject types.String `tfsdk:"target_object"`
+	Relation     types.String `tfsdk:"relation"`
+	Objects      types.Set    `tfsdk:"objects"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *jaasAccessRelationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_access_relation"
+}
+
+func (r *jaasAccessRelationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the relation of objects, e.g. users, to a target object of " +
+			"JAAS, e.g. a model, as the relationship tuples of JAAS do. Only the objects of the resource are " +
+			"managed, objects related to the target object out of band are kept. It requires the provider to " +
+			"be connected to JAAS.",
+		Attributes: map[string]schema.Attribute{
+			"target_object": schema.StringAttribute{
+				Description: "The object the relation gives access to, e.g. `model-<owner>/<name>`, " +
+					"`controller-<name>`, `group-<name>` or `role-<name>`.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"relation": schema.StringAttribute{
+				Description: "The relation of the objects to the target object, e.g. `member` of a group, " +
+					"`assignee` of a role, or `administrator`, `writer` and `reader` of a model.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"objects": schema.SetAttribute{
+				Description: "The objects related to the target object, e.g. `user-<name>`, " +
+					"`serviceaccount-<client-id>` or `group-<name>#member` for the members of a group.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jaasAccessRelationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASAccess)
+}
+
+// ImportState is called when the provider must import the state of a
+// resource instance. The ID holds the target object and the relation,
+// all of the objects of the relation are read on import.
+func (r *jaasAccessRelationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jaasAccessRelationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "jaas_access_relation", "create")
+		return
+	}
+
+	var plan jaasAccessRelationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objects []string
+	resp.Diagnostics.Append(plan.Objects.ElementsAs(ctx, &objects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.JAAS.AddRelations(juju.JAASRelationsInput{
+		TargetObject: plan.TargetObject.ValueString(),
+		Relation:     plan.Relation.ValueString(),
+		Objects:      objects,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add relations, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(newJAASAccessRelationID(plan.TargetObject.ValueString(), plan.Relation.ValueString()))
+	r.trace(fmt.Sprintf("relations added: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read only reads the objects of the state, all of the objects of the
+// relation when the resource is imported.
+func (r *jaasAccessRelationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "jaas_access_relation", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state jaasAccessRelationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetObject, relation := targetObjectAndRelationFromJAASAccessRelationID(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.JAAS.ReadRelations(juju.ReadJAASRelationsInput{
+		TargetObject: targetObject,
+		Relation:     relation,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relations, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read relations: %q", state.ID.ValueString()))
+
+	objects := response.Objects
+	if !state.Objects.IsNull() {
+		var stateObjects []string
+		resp.Diagnostics.Append(state.Objects.ElementsAs(ctx, &stateObjects, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The objects of the state which are no longer related.
+		removed := getMissingUsers(stateObjects, response.Objects)
+		objects = getMissingUsers(stateObjects, removed)
+	}
+	if len(objects) == 0 {
+		// Relations manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	objectsValue, dErr := types.SetValueFrom(ctx, types.StringType, objects)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.TargetObject = types.StringValue(targetObject)
+	state.Relation = types.StringValue(relation)
+	state.Objects = objectsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update removes the relation of the objects removed from the resource,
+// and adds the relation of the objects added to it.
+func (r *jaasAccessRelationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "jaas_access_relation", "update")
+		return
+	}
+
+	var plan, state jaasAccessRelationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planObjects, stateObjects []string
+	resp.Diagnostics.Append(plan.Objects.ElementsAs(ctx, &planObjects, false)...)
+	resp.Diagnostics.Append(state.Objects.ElementsAs(ctx, &stateObjects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.JAAS.RemoveRelations(juju.JAASRelationsInput{
+		TargetObject: plan.TargetObject.ValueString(),
+		Relation:     plan.Relation.ValueString(),
+		Objects:      getMissingUsers(stateObjects, planObjects),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove relations, got error: %s", err))
+		return
+	}
+	if err := r.client.JAAS.AddRelations(juju.JAASRelationsInput{
+		TargetObject: plan.TargetObject.ValueString(),
+		Relation:     plan.Relation.ValueString(),
+		Objects:      getMissingUsers(planObjects, stateObjects),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add relations, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("relations updated: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jaasAccessRelationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "jaas_access_relation", "delete")
+		return
+	}
+
+	var state jaasAccessRelationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var objects []string
+	resp.Diagnostics.Append(state.Objects.ElementsAs(ctx, &objects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.JAAS.RemoveRelations(juju.JAASRelationsInput{
+		TargetObject: state.TargetObject.ValueString(),
+		Relation:     state.Relation.ValueString(),
+		Objects:      objects,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove relations, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("relations removed: %q", state.ID.ValueString()))
+}
+
+func (r *jaasAccessRelationResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceJAASAccess, msg, additionalFields...)
+}
+
+func newJAASAccessRelationID(targetObject, relation string) string {
+	return fmt.Sprintf("%s:%s", targetObject, relation)
+}
+
+// Relations can be imported using the format: `target_object:relation`.
+func targetObjectAndRelationFromJAASAccessRelationID(value string, diags *diag.Diagnostics) (string, string) {
+	targetObject, relation, ok := cutLast(value, ":")
+	//If importing with an incorrect ID we need to catch and provide a user-friendly error
+	if !ok || targetObject == "" || relation == "" {
+		diags.AddError("Malformed ID", fmt.Sprintf("unable to parse target object and relation from provided ID: %q", value))
+		return "", ""
+	}
+	return targetObject, relation
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceJAASAccessRelation(t *testing.T) {
+	skipIfNotJAAS(t)
+	groupName := acctest.RandomWithPrefix("tf-test-group")
+
+	resourceName := "juju_jaas_access_relation.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJAASAccessRelation(groupName, `"user-alice@canonical.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target_object", "group-"+groupName),
+					resource.TestCheckResourceAttr(resourceName, "relation", "member"),
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "objects.*", "user-alice@canonical.com"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("group-%s:member", groupName)),
+				),
+			},
+			{
+				Config: testAccResourceJAASAccessRelation(groupName, `"user-bob@canonical.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "objects.*", "user-bob@canonical.com"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("group-%s:member", groupName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceJAASAccessRelation(groupName, objects string) string {
+	return fmt.Sprintf(`
+resource "juju_jaas_group" "test" {
+  name = %q
+}
+
+resource "juju_jaas_access_relation" "test" {
+  target_object = "group-${juju_jaas_group.test.name}"
+  relation      = "member"
+  objects       = [%s]
+}`, groupName, objects)
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceJAASGroup(t *testing.T) {
+	skipIfNotJAAS(t)
+	name := acctest.RandomWithPrefix("tf-test-group")
+	newName := acctest.RandomWithPrefix("tf-test-group")
+
+	resourceName := "juju_jaas_group.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJAASGroup(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "uuid"),
+				),
+			},
+			{
+				// The group is renamed in place.
+				Config: testAccResourceJAASGroup(newName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceJAASGroup(name string) string {
+	return fmt.Sprintf(`
+resource "juju_jaas_group" "test" {
+  name = %q
+}`, name)
+}
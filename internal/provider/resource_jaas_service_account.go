@@ -0,0 +1,379 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &jaasServiceAccountResource{}
+var _ resource.ResourceWithConfigure = &jaasServiceAccountResource{}
+var _ resource.ResourceWithImportState = &jaasServiceAccountResource{}
+
+func NewJAASServiceAccountResource() resource.Resource {
+	return &jaasServiceAccountResource{}
+}
+
+type jaasServiceAccountResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type jaasServiceAccountResourceModel struct {
+	ClientID         types.String `tfsdk:"client_id"`
+	CloudCredentials types.List   `tfsdk:"cloud_credential"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+var jaasCloudCredentialType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"cloud":      types.StringType,
+	"name":       types.StringType,
+	"auth_type":  types.StringType,
+	"attributes": types.MapType{ElemType: types.StringType},
+}}
+
+type jaasCloudCredentialModel struct {
+	Cloud      types.String `tfsdk:"cloud"`
+	Name       types.String `tfsdk:"name"`
+	AuthType   types.String `tfsdk:"auth_type"`
+	Attributes types.Map    `tfsdk:"attributes"`
+}
+
+func (r *jaasServiceAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_service_account"
+}
+
+func (r *jaasServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a service account of JAAS, the identity of automation " +
+			"logging in with client credentials, and its cloud credentials. The service account must be " +
+			"registered with the identity provider of JAAS first, the user of the provider becomes its " +
+			"administrator. Access is given to the service account with juju_jaas_access_relation " +
+			"resources, e.g. with the object `serviceaccount-<client_id>`. JAAS cannot remove service " +
+			"accounts, nor their cloud credentials: destroying the resource, or removing a cloud " +
+			"credential, only removes them from the state. It requires the provider to be connected to JAAS.",
+		Blocks: map[string]schema.Block{
+			"cloud_credential": schema.ListNestedBlock{
+				Description: "A cloud credential of the service account, used by the models it creates.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud": schema.StringAttribute{
+							Description: "The name of the cloud of the credential.",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the credential.",
+							Required:    true,
+						},
+						"auth_type": schema.StringAttribute{
+							Description: "The authorization type of the credential.",
+							Required:    true,
+						},
+						"attributes": schema.MapAttribute{
+							Description: "The attributes of the credential, accordingly to the cloud.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "The client ID of the service account, as registered with the identity provider.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jaasServiceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASServiceAccount)
+}
+
+// ImportState is called when the provider must import the state of a
+// resource instance. The ID is the client ID of the service account,
+// all of its cloud credentials are read on import, without their
+// attributes.
+func (r *jaasServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jaasServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "jaas_service_account", "create")
+		return
+	}
+
+	var plan jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, dErr := jaasCloudCredentials(ctx, plan.CloudCredentials)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.JAAS.CreateServiceAccount(juju.CreateJAASServiceAccountInput{
+		ClientID:    plan.ClientID.ValueString(),
+		Credentials: credentials,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service account, got error: %s", err))
+		return
+	}
+
+	plan.ID = plan.ClientID
+	r.trace(fmt.Sprintf("service account created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read only reads the cloud credentials of the state, the attributes of
+// the credentials are kept as they are.
+func (r *jaasServiceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "jaas_service_account", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All of the credentials are read on import.
+	importing := state.CloudCredentials.IsNull()
+	var stateCredentials []jaasCloudCredentialModel
+	if !importing {
+		resp.Diagnostics.Append(state.CloudCredentials.ElementsAs(ctx, &stateCredentials, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	input := juju.ReadJAASServiceAccountInput{ClientID: state.ID.ValueString()}
+	for _, credential := range stateCredentials {
+		input.Credentials = append(input.Credentials, juju.JAASCloudCredential{
+			Cloud: credential.Cloud.ValueString(),
+			Name:  credential.Name.ValueString(),
+		})
+	}
+	response, err := r.client.JAAS.ReadServiceAccount(input)
+	if errors.Is(err, errors.NotFound) {
+		// Service account manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service account, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read service account: %q", state.ID.ValueString()))
+
+	// Keep the order, and the attributes, of the credentials of the
+	// state.
+	credentials := []jaasCloudCredentialModel{}
+	for _, found := range response.Credentials {
+		credential := jaasCloudCredentialModel{
+			Cloud:      types.StringValue(found.Cloud),
+			Name:       types.StringValue(found.Name),
+			AuthType:   types.StringValue(found.AuthType),
+			Attributes: types.MapNull(types.StringType),
+		}
+		for _, stateCredential := range stateCredentials {
+			if stateCredential.Cloud.ValueString() == found.Cloud && stateCredential.Name.ValueString() == found.Name {
+				credential.Attributes = stateCredential.Attributes
+				break
+			}
+		}
+		credentials = append(credentials, credential)
+	}
+	if !importing {
+		credentials = orderJAASCloudCredentials(credentials, stateCredentials)
+	}
+	credentialsValue, dErr := types.ListValueFrom(ctx, jaasCloudCredentialType, credentials)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ClientID = state.ID
+	state.CloudCredentials = credentialsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update adds or updates the cloud credentials which changed, removed
+// credentials are kept by JAAS.
+func (r *jaasServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "jaas_service_account", "update")
+		return
+	}
+
+	var plan, state jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planCredentials, stateCredentials []jaasCloudCredentialModel
+	resp.Diagnostics.Append(plan.CloudCredentials.ElementsAs(ctx, &planCredentials, false)...)
+	if !state.CloudCredentials.IsNull() {
+		resp.Diagnostics.Append(state.CloudCredentials.ElementsAs(ctx, &stateCredentials, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var changed []jaasCloudCredentialModel
+	for _, planCredential := range planCredentials {
+		unchanged := false
+		for _, stateCredential := range stateCredentials {
+			if planCredential.Cloud.Equal(stateCredential.Cloud) && planCredential.Name.Equal(stateCredential.Name) &&
+				planCredential.AuthType.Equal(stateCredential.AuthType) && planCredential.Attributes.Equal(stateCredential.Attributes) {
+				unchanged = true
+				break
+			}
+		}
+		if !unchanged {
+			changed = append(changed, planCredential)
+		}
+	}
+	credentials, dErr := jaasCloudCredentialModels(ctx, changed)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.JAAS.UpdateServiceAccountCredentials(juju.UpdateJAASServiceAccountCredentialsInput{
+		ClientID:    state.ID.ValueString(),
+		Credentials: credentials,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service account credentials, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("service account updated: %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the service account from the state, JAAS cannot
+// remove service accounts.
+func (r *jaasServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace(fmt.Sprintf("service account removed from the state: %q", state.ID.ValueString()))
+}
+
+func (r *jaasServiceAccountResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceJAASServiceAccount, msg, additionalFields...)
+}
+
+func jaasCloudCredentials(ctx context.Context, value types.List) ([]juju.JAASCloudCredential, diag.Diagnostics) {
+	var models []jaasCloudCredentialModel
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	diags := value.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return jaasCloudCredentialModels(ctx, models)
+}
+
+func jaasCloudCredentialModels(ctx context.Context, models []jaasCloudCredentialModel) ([]juju.JAASCloudCredential, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	credentials := make([]juju.JAASCloudCredential, 0, len(models))
+	for _, model := range models {
+		attributes := map[string]string{}
+		if !model.Attributes.IsNull() {
+			diags.Append(model.Attributes.ElementsAs(ctx, &attributes, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+		credentials = append(credentials, juju.JAASCloudCredential{
+			Cloud:      model.Cloud.ValueString(),
+			Name:       model.Name.ValueString(),
+			AuthType:   model.AuthType.ValueString(),
+			Attributes: attributes,
+		})
+	}
+	return credentials, diags
+}
+
+// orderJAASCloudCredentials returns the credentials read in the order of
+// the credentials of the state, credentials not in the state are
+// dropped.
+func orderJAASCloudCredentials(read, state []jaasCloudCredentialModel) []jaasCloudCredentialModel {
+	ordered := make([]jaasCloudCredentialModel, 0, len(read))
+	for _, stateCredential := range state {
+		for _, credential := range read {
+			if credential.Cloud.Equal(stateCredential.Cloud) && credential.Name.Equal(stateCredential.Name) {
+				ordered = append(ordered, credential)
+				break
+			}
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestJAASServiceAccountEnvKey is the client ID of a service account
+// registered with the identity provider of JAAS.
+const TestJAASServiceAccountEnvKey string = "JAAS_SERVICE_ACCOUNT_CLIENT_ID"
+
+func TestAcc_ResourceJAASServiceAccount(t *testing.T) {
+	skipIfNotJAAS(t)
+	clientID := os.Getenv(TestJAASServiceAccountEnvKey)
+	if clientID == "" {
+		t.Skipf("%s requires a service account, set %s", t.Name(), TestJAASServiceAccountEnvKey)
+	}
+	credentialName := acctest.RandomWithPrefix("tf-test-credential")
+
+	resourceName := "juju_jaas_service_account.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJAASServiceAccount(clientID, credentialName, "test-user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "client_id", clientID),
+					resource.TestCheckResourceAttr(resourceName, "id", clientID),
+					resource.TestCheckResourceAttr(resourceName, "cloud_credential.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cloud_credential.0.name", credentialName),
+				),
+			},
+			{
+				// The credential is updated in place.
+				Config: testAccResourceJAASServiceAccount(clientID, credentialName, "other-user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cloud_credential.0.attributes.username", "other-user"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"cloud_credential.0.attributes"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceJAASServiceAccount(clientID, credentialName, username string) string {
+	return fmt.Sprintf(`
+resource "juju_jaas_service_account" "test" {
+  client_id = %q
+
+  cloud_credential {
+    cloud     = "localhost"
+    name      = %q
+    auth_type = "userpass"
+    attributes = {
+      username = %q
+      password = "password"
+    }
+  }
+}`, clientID, credentialName, username)
+}
@@ -0,0 +1,285 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &kubernetesCloudResource{}
+var _ resource.ResourceWithConfigure = &kubernetesCloudResource{}
+var _ resource.ResourceWithImportState = &kubernetesCloudResource{}
+
+func NewKubernetesCloudResource() resource.Resource {
+	return &kubernetesCloudResource{}
+}
+
+type kubernetesCloudResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type kubernetesCloudResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	KubeConfig     types.String `tfsdk:"kubeconfig"`
+	KubeConfigPath types.String `tfsdk:"kubeconfig_path"`
+	Context        types.String `tfsdk:"context"`
+	StorageClass   types.String `tfsdk:"storage_class"`
+	Credential     types.String `tfsdk:"credential"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *kubernetesCloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_cloud"
+}
+
+func (r *kubernetesCloudResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a Kubernetes cloud added to the controller from a kubeconfig, " +
+			"as `juju add-k8s --controller` does. The credentials of the kubeconfig context are added to the " +
+			"controller as a credential of the cloud, so models can be created on the cloud right away.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the cloud.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kubeconfig": schema.StringAttribute{
+				Description: "The content of the kubeconfig holding the cluster and its credentials.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("kubeconfig_path"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(stringReplaceUnlessImported, replaceUnlessImportedDescription, replaceUnlessImportedDescription),
+				},
+			},
+			"kubeconfig_path": schema.StringAttribute{
+				Description: "The path of the kubeconfig holding the cluster and its credentials.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(stringReplaceUnlessImported, replaceUnlessImportedDescription, replaceUnlessImportedDescription),
+				},
+			},
+			"context": schema.StringAttribute{
+				Description: "The kubeconfig context of the cluster. Defaults to the current context of the kubeconfig.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(stringReplaceUnlessImported, replaceUnlessImportedDescription, replaceUnlessImportedDescription),
+				},
+			},
+			"storage_class": schema.StringAttribute{
+				Description: "The storage class of the cluster used to provision the storage of workloads.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(stringReplaceUnlessImported, replaceUnlessImportedDescription, replaceUnlessImportedDescription),
+				},
+			},
+			"credential": schema.StringAttribute{
+				Description: "The name of the credential added for the cloud, to be used as the credential of a juju_model.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The name of the cloud once added, to be used as the cloud of a juju_model: it is " +
+					"not known until the cloud is added, so the cloud of the model is not validated beforehand.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *kubernetesCloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceKubernetesCloud)
+}
+
+// ImportState imports a kubernetes cloud by its name, and the name of
+// its credential when it differs from the name of the cloud. The
+// kubeconfig cannot be read back from the controller, it is set in
+// place on the next apply.
+func (r *kubernetesCloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, credential, _ := strings.Cut(req.ID, ":")
+	if name == "" {
+		resp.Diagnostics.AddError("Malformed ID",
+			fmt.Sprintf("unable to parse cloud and credential name from provided ID: %q", req.ID))
+		return
+	}
+	if credential == "" {
+		credential = name
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credential"), credential)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(setImported(ctx, resp.Private)...)
+}
+
+func (r *kubernetesCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "kubernetes_cloud", "create")
+		return
+	}
+
+	var plan kubernetesCloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubeConfig := plan.KubeConfig.ValueString()
+	if !plan.KubeConfigPath.IsNull() {
+		content, err := os.ReadFile(plan.KubeConfigPath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read kubeconfig, got error: %s", err))
+			return
+		}
+		kubeConfig = string(content)
+	}
+
+	response, err := r.client.Clouds.CreateKubernetesCloud(juju.CreateKubernetesCloudInput{
+		Name:         plan.Name.ValueString(),
+		KubeConfig:   kubeConfig,
+		Context:      plan.Context.ValueString(),
+		StorageClass: plan.StorageClass.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create kubernetes cloud, got error: %s", err))
+		return
+	}
+
+	plan.Credential = types.StringValue(response.CredentialName)
+	plan.ID = types.StringValue(plan.Name.ValueString())
+	r.trace(fmt.Sprintf("kubernetes cloud created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read only checks the cloud still exists, the kubeconfig cannot be
+// read back from the controller.
+func (r *kubernetesCloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "kubernetes_cloud", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state kubernetesCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Clouds.ReadCloud(juju.ReadCloudInput{
+		Name: state.ID.ValueString(),
+	})
+	if errors.Is(err, errors.NotFound) {
+		// Cloud manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read kubernetes cloud, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read kubernetes cloud: %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is only called once the cloud is imported, to set the
+// attributes which cannot be read back from the controller. All of
+// the attributes require the resource to be replaced otherwise.
+func (r *kubernetesCloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan kubernetesCloudResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(clearImported(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the credential and the cloud from the controller.
+// It fails while models of the controller use the cloud.
+func (r *kubernetesCloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "kubernetes_cloud", "delete")
+		return
+	}
+
+	var state kubernetesCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Clouds.DestroyKubernetesCloud(juju.DestroyKubernetesCloudInput{
+		Name:           state.ID.ValueString(),
+		CredentialName: state.Credential.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete kubernetes cloud, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("kubernetes cloud deleted: %q", state.ID.ValueString()))
+}
+
+func (r *kubernetesCloudResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceKubernetesCloud, msg, additionalFields...)
+}
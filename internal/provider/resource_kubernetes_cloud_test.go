@@ -0,0 +1,85 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceKubernetesCloud(t *testing.T) {
+	if testingCloud != MicroK8sTesting {
+		t.Skip(t.Name() + " only runs with MicroK8s")
+	}
+	kubeConfigPath := os.Getenv("KUBECONFIG")
+	if kubeConfigPath == "" {
+		t.Skip(t.Name() + " requires KUBECONFIG to be set")
+	}
+	cloudName := acctest.RandomWithPrefix("tf-test-k8s-cloud")
+	modelName := acctest.RandomWithPrefix("tf-test-k8s-cloud")
+
+	resourceName := "juju_kubernetes_cloud.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceKubernetesCloud(cloudName, modelName, kubeConfigPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "credential", cloudName),
+					resource.TestCheckResourceAttr("juju_model.this", "cloud.0.name", cloudName),
+					resource.TestCheckResourceAttr("juju_model.this", "credential", cloudName),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateId:     cloudName,
+				ImportStateVerify: true,
+				// The kubeconfig cannot be read back from the controller.
+				ImportStateVerifyIgnore: []string{"kubeconfig_path"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceKubernetesCloud_NoKubeConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "juju_kubernetes_cloud" "this" {
+  name = "tf-test-k8s-cloud"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccResourceKubernetesCloud(cloudName, modelName, kubeConfigPath string) string {
+	return fmt.Sprintf(`
+resource "juju_kubernetes_cloud" "this" {
+  name            = %q
+  kubeconfig_path = %q
+}
+
+resource "juju_model" "this" {
+  name       = %q
+  credential = juju_kubernetes_cloud.this.credential
+
+  cloud {
+    name = juju_kubernetes_cloud.this.id
+  }
+}`, cloudName, kubeConfigPath, modelName)
+}
@@ -7,18 +7,26 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/names/v4"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -27,6 +35,8 @@ import (
 var _ resource.Resource = &machineResource{}
 var _ resource.ResourceWithConfigure = &machineResource{}
 var _ resource.ResourceWithImportState = &machineResource{}
+var _ resource.ResourceWithModifyPlan = &machineResource{}
+var _ resource.ResourceWithUpgradeState = &machineResource{}
 
 func NewMachineResource() resource.Resource {
 	return &machineResource{}
@@ -40,16 +50,30 @@ type machineResource struct {
 }
 
 type machineResourceModel struct {
-	Name           types.String `tfsdk:"name"`
-	ModelName      types.String `tfsdk:"model"`
-	Constraints    types.String `tfsdk:"constraints"`
-	Disks          types.String `tfsdk:"disks"`
-	Base           types.String `tfsdk:"base"`
-	Series         types.String `tfsdk:"series"`
-	MachineID      types.String `tfsdk:"machine_id"`
-	SSHAddress     types.String `tfsdk:"ssh_address"`
-	PublicKeyFile  types.String `tfsdk:"public_key_file"`
-	PrivateKeyFile types.String `tfsdk:"private_key_file"`
+	Name           types.String     `tfsdk:"name"`
+	ModelName      types.String     `tfsdk:"model"`
+	Constraints    ConstraintsValue `tfsdk:"constraints"`
+	ConstraintsMap types.Object     `tfsdk:"constraints_map"`
+	Disks          types.String     `tfsdk:"disks"`
+	Base           types.String     `tfsdk:"base"`
+	Series         types.String     `tfsdk:"series"`
+	MachineID      types.String     `tfsdk:"machine_id"`
+	SSHAddress     types.String     `tfsdk:"ssh_address"`
+	PublicKeyFile  types.String     `tfsdk:"public_key_file"`
+	PrivateKeyFile types.String     `tfsdk:"private_key_file"`
+	ContainerType  types.String     `tfsdk:"container_type"`
+	ParentMachine  types.String     `tfsdk:"parent_machine"`
+	Annotations    types.Map        `tfsdk:"annotations"`
+	// ProvisioningTimeout, WaitForStarted and StartTimeout are only
+	// used when the machine is created.
+	ProvisioningTimeout types.String `tfsdk:"provisioning_timeout"`
+	WaitForStarted      types.Bool   `tfsdk:"wait_for_started"`
+	StartTimeout        types.String `tfsdk:"start_timeout"`
+	Status              types.String `tfsdk:"status"`
+	InstanceId          types.String `tfsdk:"instance_id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	IPAddresses         types.List   `tfsdk:"ip_addresses"`
+	Hardware            types.Map    `tfsdk:"hardware"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -92,10 +116,28 @@ const (
 	SSHAddressKey     = "ssh_address"
 	PrivateKeyFileKey = "private_key_file"
 	PublicKeyFileKey  = "public_key_file"
+
+	ContainerTypeKey       = "container_type"
+	ParentMachineKey       = "parent_machine"
+	AnnotationsKey         = "annotations"
+	ProvisioningTimeoutKey = "provisioning_timeout"
+	WaitForStartedKey      = "wait_for_started"
+	StartTimeoutKey        = "start_timeout"
 )
 
+// defaultStartTimeout is how long a machine is waited for to start
+// when start_timeout is not set.
+const defaultStartTimeout = 30 * time.Minute
+
 func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	constraintsMap := constraintsMapAttribute("Machine constraints, as an object rather than a string. It "+
+		"conflicts with `constraints`. Memory and disk sizes are in MiB.",
+		objectplanmodifier.RequiresReplaceIfConfigured())
+	constraintsMap.Validators = append(constraintsMap.Validators, objectvalidator.ConflictsWith(path.MatchRoot(SSHAddressKey)))
 	resp.Schema = schema.Schema{
+		// Version 1 sets the base of the machine from its series,
+		// version 2 keys the ID on the model UUID.
+		Version:     2,
 		Description: "A resource that represents a Juju machine deployment. Refer to the juju add-machine CLI command for more information and limitations.",
 		Attributes: map[string]schema.Attribute{
 			NameKey: schema.StringAttribute{
@@ -107,15 +149,20 @@ func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest,
 				},
 			},
 			ModelKey: schema.StringAttribute{
-				Description: "The Juju model in which to add a new machine.",
+				Description: "The Juju model, by name or UUID, in which to add a new machine.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
 			},
 			ConstraintsKey: schema.StringAttribute{
-				Description: "Machine constraints that overwrite those available from 'juju get-model-constraints' and provider's defaults.",
-				Optional:    true,
+				Description: "Machine constraints that overwrite those available from 'juju get-model-constraints' and provider's defaults. " +
+					"Constraints are compared by value, the order and units used do not matter.",
+				CustomType: ConstraintsType{},
+				Optional:   true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
@@ -125,6 +172,7 @@ func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest,
 					}...),
 				},
 			},
+			"constraints_map": constraintsMap,
 			DisksKey: schema.StringAttribute{
 				Description: "Storage constraints for disks to attach to the machine(s).",
 				Optional:    true,
@@ -138,11 +186,16 @@ func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest,
 				},
 			},
 			BaseKey: schema.StringAttribute{
-				Description: "The operating system to install on the new machine(s). E.g. ubuntu@22.04.",
-				Optional:    true,
-				Computed:    true,
+				Description: "The operating system to install on the new machine(s). E.g. ubuntu@22.04. " +
+					"Changing the version of the base upgrades the machine in place, as `juju upgrade-machine` " +
+					"does: the operating system of the machine is expected to be upgraded beforehand. " +
+					"Changing the operating system replaces the machine.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.RequiresReplaceIf(baseOSChanged,
+						"The machine is replaced when the operating system of its base changes.",
+						"The machine is replaced when the operating system of its base changes."),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
@@ -220,6 +273,107 @@ func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest,
 					}...),
 				},
 			},
+			ContainerTypeKey: schema.StringAttribute{
+				Description: "The type of container to create the machine in, e.g. lxd. The container is " +
+					"created on a new machine unless parent_machine is set, machine_id is the id of the container.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("lxd", "kvm"),
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot(SSHAddressKey),
+					}...),
+				},
+			},
+			ParentMachineKey: schema.StringAttribute{
+				Description: "The id of the existing machine to create the container on, " +
+					"e.g. `juju_machine.host.machine_id`. Requires container_type.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot(ContainerTypeKey),
+					}...),
+				},
+			},
+			ProvisioningTimeoutKey: schema.StringAttribute{
+				Description: "How long manual provisioning over ssh is retried while it fails, e.g. `10m` " +
+					"while the machine is booting and not reachable yet. Provisioning is attempted once if unset.",
+				Optional: true,
+				Validators: []validator.String{
+					stringIsDurationValidator{},
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot(SSHAddressKey),
+					}...),
+				},
+			},
+			AnnotationsKey: schema.MapAttribute{
+				Description: "Annotations of the machine, e.g. the owning team or a ticket reference.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			WaitForStartedKey: schema.BoolAttribute{
+				Description: "Wait for the machine agent to be started when the machine is created, so the " +
+					"outputs of the machine, e.g. hostname and ip_addresses, are known.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			StartTimeoutKey: schema.StringAttribute{
+				Description: "How long the machine is waited for to start, e.g. `10m`. Defaults to 30m.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringIsDurationValidator{},
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot(WaitForStartedKey),
+					}...),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the machine agent, e.g. pending or started.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The id of the instance of the machine in the cloud.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Description: "The hostname of the machine.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_addresses": schema.ListAttribute{
+				Description: "The IP addresses of the machine.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hardware": schema.MapAttribute{
+				Description: "The hardware characteristics of the machine, e.g. arch, cores and mem.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -250,15 +404,54 @@ func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	var provisioningTimeout time.Duration
+	if !data.ProvisioningTimeout.IsNull() {
+		var err error
+		provisioningTimeout, err = time.ParseDuration(data.ProvisioningTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("Unable to parse provisioning timeout, got error: %s", err))
+			return
+		}
+	}
+
+	var annotations map[string]string
+	resp.Diagnostics.Append(data.Annotations.ElementsAs(ctx, &annotations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machineConstraints := data.Constraints.ValueString()
+	if !data.ConstraintsMap.IsNull() {
+		parsed, dErr := constraintsFromMap(ctx, data.ConstraintsMap)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		machineConstraints = parsed.String()
+	}
+
+	// The ID is keyed on the model UUID, read before the machine is
+	// added.
+	modelUUID, err := modelUUIDForID(r.client, data.ModelName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the UUID of model %q, got error: %s", data.ModelName.ValueString(), err))
+		return
+	}
+
 	response, err := r.client.Machines.CreateMachine(ctx, &juju.CreateMachineInput{
-		Constraints:    data.Constraints.ValueString(),
+		Constraints:    machineConstraints,
 		ModelName:      data.ModelName.ValueString(),
 		Disks:          data.Disks.ValueString(),
 		Base:           data.Base.ValueString(),
 		Series:         data.Series.ValueString(),
+		ContainerType:  data.ContainerType.ValueString(),
+		ParentID:       data.ParentMachine.ValueString(),
 		SSHAddress:     data.SSHAddress.ValueString(),
 		PublicKeyFile:  data.PublicKeyFile.ValueString(),
 		PrivateKeyFile: data.PrivateKeyFile.ValueString(),
+
+		ProvisionTimeout: provisioningTimeout,
+		Annotations:      annotations,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create machine, got error: %s", err))
@@ -271,11 +464,34 @@ func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest
 		machineName = fmt.Sprintf("machine-%s", response.ID)
 	}
 
-	id := newMachineID(data.ModelName.ValueString(), response.ID, machineName)
+	readInput := juju.ReadMachineInput{ModelName: data.ModelName.ValueString(), ID: response.ID}
+	var readResponse juju.ReadMachineResponse
+	if data.WaitForStarted.ValueBool() {
+		startTimeout := defaultStartTimeout
+		if !data.StartTimeout.IsNull() {
+			startTimeout, err = time.ParseDuration(data.StartTimeout.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("Unable to parse start timeout, got error: %s", err))
+				return
+			}
+		}
+		readResponse, err = r.client.Machines.WaitForMachineStarted(ctx, readInput, startTimeout)
+	} else {
+		readResponse, err = r.client.Machines.ReadMachine(readInput)
+	}
+	if err != nil {
+		// The machine is created, it is saved in the state so it can
+		// be destroyed.
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for machine %q, got error: %s", response.ID, err))
+	}
+	resp.Diagnostics.Append(setMachineStatus(ctx, &data, readResponse)...)
+
+	id := newMachineID(modelUUID, response.ID, machineName)
 	data.ID = types.StringValue(id)
 	data.MachineID = types.StringValue(response.ID)
 	data.Base = types.StringValue(response.Base)
 	data.Series = types.StringValue(response.Series)
+	data.ContainerType, data.ParentMachine = containerTypeAndParentFromMachineID(response.ID)
 	data.Name = types.StringValue(machineName)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -284,11 +500,10 @@ func IsMachineNotFound(err error) bool {
 	return strings.Contains(err.Error(), "no status returned for machine")
 }
 
-func handleMachineNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
-	if IsMachineNotFound(err) {
+func handleMachineNotFoundError(ctx context.Context, client *juju.Client, err error, st *tfsdk.State) diag.Diagnostics {
+	if IsMachineNotFound(err) || isModelNotFound(err) {
 		// Machine manually removed
-		st.RemoveResource(ctx)
-		return diag.Diagnostics{}
+		return removeMissingResource(ctx, client, st, "machine", err)
 	}
 	var diags diag.Diagnostics
 	diags.AddError("Not Found", err.Error())
@@ -310,6 +525,10 @@ func (r *machineResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	if r.client.Offline {
+		return
+	}
+
 	var data machineResourceModel
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -323,22 +542,62 @@ func (r *machineResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	response, err := r.client.Machines.ReadMachine(juju.ReadMachineInput{
-		ModelName: modelName,
-		ID:        machineID,
+		ModelName:    modelName,
+		ID:           machineID,
+		CachedStatus: true,
 	})
 	if err != nil {
-		resp.Diagnostics.Append(handleMachineNotFoundError(ctx, err, &resp.State)...)
+		resp.Diagnostics.Append(handleMachineNotFoundError(ctx, r.client, err, &resp.State)...)
 		return
 	}
 	r.trace(fmt.Sprintf("read machine resource %q", machineID))
 
 	data.Name = types.StringValue(machineName)
-	data.ModelName = types.StringValue(modelName)
+	// The model is only read from the ID when the machine is imported.
+	if data.ModelName.IsNull() {
+		importedModel, err := importedModelName(r.client, modelName)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model %q, got error: %s", modelName, err))
+			return
+		}
+		data.ModelName = types.StringValue(importedModel)
+	}
 	data.MachineID = types.StringValue(machineID)
 	data.Series = types.StringValue(response.Series)
 	data.Base = types.StringValue(response.Base)
-	if response.Constraints != "" {
-		data.Constraints = types.StringValue(response.Constraints)
+	data.ContainerType, data.ParentMachine = containerTypeAndParentFromMachineID(machineID)
+	if data.WaitForStarted.IsNull() {
+		data.WaitForStarted = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(setMachineStatus(ctx, &data, response)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.ConstraintsMap.IsNull() {
+		// The constraints are only set as constraints_map.
+		if parsed, err := constraints.Parse(response.Constraints); err == nil {
+			var dErr diag.Diagnostics
+			data.ConstraintsMap, dErr = constraintsMapFromValue(ctx, data.ConstraintsMap, parsed)
+			resp.Diagnostics.Append(dErr...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	} else if response.Constraints != "" {
+		data.Constraints = ConstraintsValue{StringValue: types.StringValue(response.Constraints)}
+		// Use the same representation as applications and models,
+		// keys unknown to the provider are kept as returned.
+		if parsed, err := constraints.Parse(response.Constraints); err == nil {
+			data.Constraints = NewConstraintsValue(parsed)
+		}
+	}
+	if len(response.Annotations) > 0 || !data.Annotations.IsNull() {
+		annotations, dErr := types.MapValueFrom(ctx, types.StringType, response.Annotations)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Annotations = annotations
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -358,19 +617,63 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 	// TODO hml 28-Jul-2023
 	// Delete the machine resource if it no longer exists in juju.
 
-	// Only the name can be updated it is terraform data and
-	// not saved in juju.
-	if plan.Name.Equal(state.Name) {
+	modelName, _, _ := modelMachineIDAndName(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.Name = plan.Name
-	id := newMachineID(plan.ModelName.ValueString(), plan.MachineID.ValueString(), plan.Name.ValueString())
-	state.ID = types.StringValue(id)
+	input := juju.UpdateMachineInput{
+		ModelName: modelName,
+		ID:        plan.MachineID.ValueString(),
+	}
+	// The base is only upgraded when configured, the operating
+	// system cannot change as it requires the machine to be replaced.
+	if !plan.Base.IsUnknown() && !plan.Base.Equal(state.Base) {
+		input.Base = plan.Base.ValueString()
+	}
+	// Removed annotations are set to an empty value.
+	if !plan.Annotations.Equal(state.Annotations) {
+		resp.Diagnostics.Append(plan.Annotations.ElementsAs(ctx, &input.Annotations, false)...)
+		oldAnnotations := map[string]string{}
+		resp.Diagnostics.Append(state.Annotations.ElementsAs(ctx, &oldAnnotations, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if input.Annotations == nil {
+			input.Annotations = make(map[string]string)
+		}
+		for k := range oldAnnotations {
+			if _, ok := input.Annotations[k]; !ok {
+				input.Annotations[k] = ""
+			}
+		}
+	}
+	if input.Base != "" || len(input.Annotations) > 0 {
+		if err := r.client.Machines.UpdateMachine(ctx, input); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update machine %q, got error: %s", input.ID, err))
+			return
+		}
+	}
+	if input.Base != "" {
+		response, err := r.client.Machines.ReadMachine(juju.ReadMachineInput{
+			ModelName: input.ModelName,
+			ID:        input.ID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read machine %q, got error: %s", input.ID, err))
+			return
+		}
+		plan.Base = types.StringValue(response.Base)
+		plan.Series = types.StringValue(response.Series)
+	}
+
+	// The name is terraform data and not saved in juju.
+	id := newMachineID(modelName, plan.MachineID.ValueString(), plan.Name.ValueString())
+	plan.ID = types.StringValue(id)
 
 	r.trace(fmt.Sprintf("update machine resource %q", plan.MachineID.ValueString()))
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -420,7 +723,54 @@ func (r *machineResource) Delete(ctx context.Context, req resource.DeleteRequest
 // If setting an attribute with the import identifier, it is recommended
 // to use the ImportStatePassthroughID() call in this method.
 func (r *machineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importModelKeyedID(ctx, r.client, req, resp)
+}
+
+// UpgradeState upgrades the states of the machines added with a
+// series, before the machine had a base, to the base of the series,
+// and the IDs keyed on the model name to the model UUID.
+func (r *machineResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// The prior state only lacks the attributes added since, which
+	// are read as null with the current schema.
+	var priorSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &priorSchema)
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &priorSchema.Schema,
+			StateUpgrader: r.upgradeStateV0,
+		},
+		1: {
+			PriorSchema:   &priorSchema.Schema,
+			StateUpgrader: r.upgradeStateV1,
+		},
+	}
+}
+
+func (r *machineResource) upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state machineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Base = baseFromSeries(state.Series, state.Base)
+	state.ID = upgradeModelKeyedID(r.client, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *machineResource) upgradeStateV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state machineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = upgradeModelKeyedID(r.client, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *machineResource) trace(msg string, additionalFields ...map[string]interface{}) {
@@ -434,11 +784,71 @@ func (r *machineResource) trace(msg string, additionalFields ...map[string]inter
 	tflog.SubsystemTrace(r.subCtx, LogResourceMachine, msg, additionalFields...)
 }
 
+// ModifyPlan is called when the provider has an opportunity to modify
+// the plan. The series of a machine is not known until the machine is
+// upgraded when its base changes.
+func (r *machineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The plan is null when the resource is being destroyed, the
+	// state is null when the resource is being created.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planBase, stateBase types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(BaseKey), &planBase)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(BaseKey), &stateBase)...)
+	if resp.Diagnostics.HasError() || planBase.IsUnknown() || planBase.Equal(stateBase) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(SeriesKey), types.StringUnknown())...)
+}
+
+// baseOSChanged requires the machine to be replaced when the operating
+// system of its configured base changes, only the version of the base
+// can be upgraded in place.
+func baseOSChanged(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.StateValue.IsNull() {
+		return
+	}
+	planOS, _, _ := strings.Cut(req.ConfigValue.ValueString(), "@")
+	stateOS, _, _ := strings.Cut(req.StateValue.ValueString(), "@")
+	resp.RequiresReplace = planOS != stateOS
+}
+
+// setMachineStatus sets the outputs of the machine from its status.
+func setMachineStatus(ctx context.Context, data *machineResourceModel, response juju.ReadMachineResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ipAddresses, dErr := types.ListValueFrom(ctx, types.StringType, response.IPAddresses)
+	diags.Append(dErr...)
+	hardware, dErr := types.MapValueFrom(ctx, types.StringType, response.Hardware)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Status = types.StringValue(response.Status)
+	data.InstanceId = types.StringValue(response.InstanceId)
+	data.Hostname = types.StringValue(response.Hostname)
+	data.IPAddresses = ipAddresses
+	data.Hardware = hardware
+	return diags
+}
+
+// containerTypeAndParentFromMachineID returns the container type and the
+// parent machine of a container id, e.g. 0/lxd/1, null for machines.
+func containerTypeAndParentFromMachineID(machineID string) (types.String, types.String) {
+	if !names.IsContainerMachine(machineID) {
+		return types.StringNull(), types.StringNull()
+	}
+	tag := names.NewMachineTag(machineID)
+	return types.StringValue(tag.ContainerType()), types.StringValue(tag.Parent().Id())
+}
+
 func newMachineID(model, machine_id, machine_name string) string {
 	return fmt.Sprintf("%s:%s:%s", model, machine_id, machine_name)
 }
 
 // Machines can be imported using the format: `model_name:machine_id:machine_name`.
+// The model is given by its UUID in the IDs of the machine resources.
 func modelMachineIDAndName(value string, diags *diag.Diagnostics) (string, string, string) {
 	id := strings.Split(value, ":")
 	//If importing with an incorrect ID we need to catch and provide a user-friendly error
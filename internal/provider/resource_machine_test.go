@@ -4,9 +4,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -38,6 +42,99 @@ func TestAcc_ResourceMachine(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceMachine_Container(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-machine")
+	resourceName := "juju_machine.container"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMachineContainer(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_machine.host", "machine_id", "0"),
+					resource.TestCheckNoResourceAttr("juju_machine.host", "container_type"),
+					resource.TestCheckResourceAttr(resourceName, "machine_id", "0/lxd/0"),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "lxd"),
+					resource.TestCheckResourceAttr(resourceName, "parent_machine", "0"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceMachine_WaitForStarted(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-machine")
+	resourceName := "juju_machine.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMachine(modelName, "wait_for_started = true\n\tstart_timeout = \"20m\""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "started"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "hostname"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_addresses.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "hardware.arch"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"wait_for_started", "start_timeout"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceMachine_Annotations(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-machine")
+	resourceName := "juju_machine.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMachine(modelName, "annotations = {\n\t\tteam = \"platform\"\n\t\tticket = \"OPS-1\"\n\t}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "annotations.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.team", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.ticket", "OPS-1"),
+				),
+			},
+			{
+				Config: testAccResourceMachine(modelName, "annotations = {\n\t\tteam = \"observability\"\n\t}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.team", "observability"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceMachine_Minimal(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -76,6 +173,44 @@ resource "juju_machine" "testmachine" {
 `, modelName)
 }
 
+func TestAcc_ResourceMachine_Constraints(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-machine")
+	resourceName := "juju_machine.testmachine"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMachineConstraints(modelName, "mem=2G root-disk=8G"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "constraints", "mem=2G root-disk=8G"),
+				),
+			},
+			{
+				// The same constraints in other units produce no diff.
+				Config:   testAccResourceMachineConstraints(modelName, "root-disk=8192M mem=2048M"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceMachineConstraints(modelName, constraints string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_machine" "testmachine" {
+	model       = juju_model.this.name
+	constraints = %q
+}
+`, modelName, constraints)
+}
+
 func TestAcc_ResourceMachine_UpgradeProvider(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -145,18 +280,39 @@ func TestAcc_ResourceMachine_AddMachine_Edge(t *testing.T) {
 					resource.TestCheckResourceAttr("juju_machine.this_machine", "model", modelName),
 					resource.TestCheckResourceAttr("juju_machine.this_machine", "name", "manually_provisioned_machine"),
 					resource.TestCheckResourceAttr("juju_machine.this_machine", "machine_id", "0"),
+					resource.TestCheckResourceAttr("juju_machine.this_machine", "provisioning_timeout", "5m"),
 				),
 			},
 			{
 				ImportStateVerify:       true,
 				ImportState:             true,
-				ImportStateVerifyIgnore: []string{"ssh_address", "public_key_file", "private_key_file"},
+				ImportStateVerifyIgnore: []string{"ssh_address", "public_key_file", "private_key_file", "provisioning_timeout"},
 				ResourceName:            "juju_machine.this_machine",
 			},
 		},
 	})
 }
 
+func testAccResourceMachineContainer(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_machine" "host" {
+  model = juju_model.this.name
+  name  = "host"
+}
+
+resource "juju_machine" "container" {
+  model          = juju_model.this.name
+  name           = "container"
+  container_type = "lxd"
+  parent_machine = juju_machine.host.machine_id
+}
+`, modelName)
+}
+
 func testAccResourceMachineAddMachine(modelName string, IP string, pubKeyPath string, privKeyPath string) string {
 	return fmt.Sprintf(`
 resource "juju_model" "this_model" {
@@ -170,6 +326,85 @@ resource "juju_machine" "this_machine" {
 	ssh_address = "ubuntu@%v"
     public_key_file = %q
     private_key_file = %q
+    provisioning_timeout = "5m"
 }
 `, modelName, IP, pubKeyPath, privKeyPath)
 }
+
+// upgradeResourceStateResponse upgrades a state of the given version of
+// the given resource type, without a configured provider.
+func upgradeResourceStateResponse(t *testing.T, typeName string, version int64, rawState string) (tfprotov6.ProviderServer, *tfprotov6.UpgradeResourceStateResponse) {
+	server, err := providerserver.NewProtocol6WithError(NewJujuProvider("dev"))()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.UpgradeResourceState(context.Background(), &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, resp
+}
+
+// upgradeResourceState upgrades a version 0 state of the given resource
+// type, returning the attributes of the upgraded state.
+func upgradeResourceState(t *testing.T, typeName, rawState string) map[string]tftypes.Value {
+	ctx := context.Background()
+	server, resp := upgradeResourceStateResponse(t, typeName, 0, rawState)
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas[typeName].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var upgraded map[string]tftypes.Value
+	if err := value.As(&upgraded); err != nil {
+		t.Fatal(err)
+	}
+	return upgraded
+}
+
+// stringAttribute returns the value of a string attribute, empty when
+// it is null.
+func stringAttribute(t *testing.T, value tftypes.Value) string {
+	var s *string
+	if err := value.As(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func TestMachineUpgradeStateV0(t *testing.T) {
+	upgraded := upgradeResourceState(t, "juju_machine",
+		`{"id":"8e1c4a5b-3f2d-4c6e-9a7b-0d1e2f3a4b5c:0:machine","model":"development","name":"machine","series":"jammy"}`)
+	if base := stringAttribute(t, upgraded["base"]); base != "ubuntu@22.04" {
+		t.Errorf("expected base ubuntu@22.04, got %q", base)
+	}
+	if series := stringAttribute(t, upgraded["series"]); series != "jammy" {
+		t.Errorf("expected series jammy, got %q", series)
+	}
+}
+
+func TestUpgradeStateModelKeyedIDNeedsController(t *testing.T) {
+	for typeName, rawState := range map[string]string{
+		"juju_application": `{"id":"development:mysql","model":"development","charm":[{"name":"mysql"}]}`,
+		"juju_machine":     `{"id":"development:0:machine","model":"development","name":"machine"}`,
+		"juju_integration": `{"id":"development:one:source:two:sink","model":"development"}`,
+	} {
+		_, resp := upgradeResourceStateResponse(t, typeName, 0, rawState)
+		if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary != "State Upgrade Error" {
+			t.Errorf("%s: expected a state upgrade error, got %v", typeName, resp.Diagnostics)
+		}
+	}
+}
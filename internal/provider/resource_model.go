@@ -8,13 +8,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/loggo"
 	"github.com/juju/names/v4"
 	"github.com/juju/utils/v3"
 
@@ -33,6 +41,8 @@ import (
 var _ resource.Resource = &modelResource{}
 var _ resource.ResourceWithConfigure = &modelResource{}
 var _ resource.ResourceWithImportState = &modelResource{}
+var _ resource.ResourceWithValidateConfig = &modelResource{}
+var _ resource.ResourceWithModifyPlan = &modelResource{}
 
 func NewModelResource() resource.Resource {
 	return &modelResource{}
@@ -46,12 +56,30 @@ type modelResource struct {
 }
 
 type modelResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Cloud       types.List   `tfsdk:"cloud"`
-	Config      types.Map    `tfsdk:"config"`
-	Constraints types.String `tfsdk:"constraints"`
-	Credential  types.String `tfsdk:"credential"`
-	Type        types.String `tfsdk:"type"`
+	Name             types.String     `tfsdk:"name"`
+	AgentVersion     types.String     `tfsdk:"agent_version"`
+	Annotations      types.Map        `tfsdk:"annotations"`
+	Cloud            types.List       `tfsdk:"cloud"`
+	Config           types.Map        `tfsdk:"config"`
+	Constraints      ConstraintsValue `tfsdk:"constraints"`
+	ConstraintsMap   types.Object     `tfsdk:"constraints_map"`
+	ConstraintsDrift types.String     `tfsdk:"constraints_drift"`
+	Credential       types.String     `tfsdk:"credential"`
+	DisabledCommands types.Map        `tfsdk:"disabled_commands"`
+	Life             types.String     `tfsdk:"life"`
+	LoggingConfig    types.Map        `tfsdk:"logging_config"`
+	Owner            types.String     `tfsdk:"owner"`
+	SecretBackend    types.String     `tfsdk:"secret_backend"`
+	SLALevel         types.String     `tfsdk:"sla_level"`
+	Status           types.String     `tfsdk:"status"`
+	Type             types.String     `tfsdk:"type"`
+	UUID             types.String     `tfsdk:"uuid"`
+	// DeletionProtection, DestroyStorage, Force and DestroyTimeout
+	// are only used when the model is destroyed.
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	DestroyStorage     types.Bool   `tfsdk:"destroy_storage"`
+	DestroyTimeout     types.String `tfsdk:"destroy_timeout"`
+	Force              types.Bool   `tfsdk:"force"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -72,26 +100,159 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					modelNameValidator(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "The name of the user owning the model, the user running terraform if not set. " +
+					"Creating a model owned by another user requires controller superuser access, as " +
+					"`juju add-model --owner` does. Dependent resources refer to such a model by its " +
+					"qualified name, e.g. `bob/development`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					userNameValidator(),
+				},
+			},
+			"annotations": schema.MapAttribute{
+				Description: "Annotations of the model, e.g. the owning team or a ticket reference. " +
+					"Annotations set outside of terraform are reported as drift.",
+				Optional:    true,
+				ElementType: types.StringType,
 			},
 			"config": schema.MapAttribute{
-				Description: "Override default model configuration",
+				Description: "Override default model configuration. Values are compared with the model configuration " +
+					"by type, e.g. `true` and `True` are equal for a boolean key. Changes made outside of terraform are " +
+					"reported as drift, and keys removed from the map are reset to their default value.",
 				Optional:    true,
 				ElementType: types.StringType,
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"constraints": schema.StringAttribute{
-				Description: "Constraints imposed to this model",
+			"logging_config": schema.MapAttribute{
+				Description: "The log level of the logging modules of the model, e.g. `{ \"<root>\" = \"WARNING\", " +
+					"unit = \"DEBUG\" }`. Levels are one of `TRACE`, `DEBUG`, `INFO`, `WARNING`, `ERROR` or " +
+					"`CRITICAL`. The map manages the whole `logging-config` model config key, which must not be " +
+					"set in `config` as well.",
 				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(loggingModuleRegexp,
+						"must be <root>, a dotted module name, e.g. juju.worker, or a #label")),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(loggingLevels...)),
+				},
+			},
+			"constraints": schema.StringAttribute{
+				Description: "Constraints imposed to this model, used as defaults by all of its applications and " +
+					"machines, as `juju set-model-constraints` does. Constraints are compared by value, the order " +
+					"and units used do not matter.",
+				CustomType: ConstraintsType{},
+				Optional:   true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"constraints_map": constraintsMapAttribute("Constraints imposed to this model, as an object rather " +
+				"than a string. It conflicts with `constraints`. Memory and disk sizes are in MiB."),
+			"constraints_drift": constraintsDriftAttribute(),
 			"credential": schema.StringAttribute{
-				Description: "Credential used to add the model",
+				Description: "Credential used to add the model. Changing the credential of an existing model " +
+					"updates it in place, as `juju set-credential` does, the credential must be of the cloud " +
+					"of the model.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disabled_commands": schema.MapAttribute{
+				Description: "Commands disabled on the model, mapped to the message given when they are " +
+					"attempted, as `juju disable-command` does. Valid commands are `destroy-model`, `remove-object` " +
+					"and `all`. Disabled commands guard the model against removals made by terraform as well, " +
+					"they must be enabled again, i.e. removed from the map, before such changes are applied.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(juju.DisabledCommands()...)),
+				},
+			},
+			DeletionProtectionKey: deletionProtectionAttribute("model"),
+			"destroy_storage": schema.BoolAttribute{
+				Description: "Destroy the storage of the model when it is destroyed. If false, the storage " +
+					"is released from the model instead, so persistent volumes are kept.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"force": schema.BoolAttribute{
+				Description: "Force the destruction of the model, ignoring any errors.",
 				Optional:    true,
 				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"destroy_timeout": schema.StringAttribute{
+				Description: "The time to wait for each step of a forced destruction of the model, e.g. 30m. " +
+					"Defaults to 30m.",
+				Optional: true,
+				Validators: []validator.String{
+					stringIsDurationValidator{},
+				},
+			},
+			"secret_backend": schema.StringAttribute{
+				Description: "The name of the secret backend used by the model, e.g. `internal` or the name of " +
+					"a Vault backend added to the controller. The backend must exist on the controller.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sla_level": schema.StringAttribute{
+				Description: "The support level of the model, one of `unsupported`, `essential`, `standard` or " +
+					"`advanced`, as `juju sla` does. Budgets are not supported by juju 3 controllers.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("unsupported", "essential", "standard", "advanced"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_version": schema.StringAttribute{
+				Description: "The version of the juju agents of the model, e.g. 3.4.0. Setting a version " +
+					"upgrades the model to it, as `juju upgrade-model --agent-version` does, and waits for " +
+					"all agents of the model to be upgraded. Models cannot be downgraded.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the model. Dependent resources can refer to the model by its UUID " +
+					"rather than its name.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"life": schema.StringAttribute{
+				Description: "The life of the model, e.g. alive or dying.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the model, e.g. available or busy.",
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -166,6 +327,78 @@ func (r *modelResource) ImportState(ctx context.Context, req resource.ImportStat
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *modelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData modelResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configData.Config.IsUnknown() {
+		return
+	}
+	configMap := map[string]types.String{}
+	resp.Diagnostics.Append(configData.Config.ElementsAs(ctx, &configMap, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, found := configMap[juju.SecretBackendConfigKey]; found && !configData.SecretBackend.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_backend"), "Attribute Error",
+			fmt.Sprintf("the config key %q can not be set in both \"config\" and \"secret_backend\".", juju.SecretBackendConfigKey))
+	}
+	if _, found := configMap[LoggingConfigKey]; found && !configData.LoggingConfig.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("logging_config"), "Attribute Error",
+			fmt.Sprintf("the config key %q can not be set in both \"config\" and \"logging_config\".", LoggingConfigKey))
+	}
+}
+
+// ModifyPlan is called when the provider has an opportunity to modify
+// the plan. The cloud and region of a new model, or of a model to be
+// replaced, are validated against the clouds known to the controller
+// so a typo is reported at plan time rather than mid-apply. The plan
+// fails on constraints drift when constraints_drift is "error".
+func (r *modelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The plan is null when the resource is being destroyed, and
+	// the client is not configured when the provider config is
+	// not known yet. The cloud region cannot be checked offline.
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(checkDeletionProtection(ctx, req.State, "model")...)
+		return
+	}
+	resp.Diagnostics.Append(checkConstraintsDrift(ctx, req)...)
+	if resp.Diagnostics.HasError() || r.client == nil || r.client.Offline {
+		return
+	}
+
+	var planCloud, stateCloud types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cloud"), &planCloud)...)
+	if resp.Diagnostics.HasError() || planCloud.IsUnknown() || planCloud.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cloud"), &stateCloud)...)
+		if resp.Diagnostics.HasError() || planCloud.Equal(stateCloud) {
+			return
+		}
+	}
+
+	var clouds []nestedCloud
+	resp.Diagnostics.Append(planCloud.ElementsAs(ctx, &clouds, false)...)
+	if resp.Diagnostics.HasError() || len(clouds) == 0 {
+		return
+	}
+	if clouds[0].Name.IsUnknown() {
+		return
+	}
+
+	// An unknown region is computed from the cloud.
+	if err := r.client.Models.ValidateCloudRegion(clouds[0].Name.ValueString(), clouds[0].Region.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cloud"), "Invalid Cloud", err.Error())
+	}
+}
+
 func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Prevent panic if the provider has not been configured.
 	if r.client == nil {
@@ -193,12 +426,40 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !plan.LoggingConfig.IsNull() {
+		var loggingConfig map[string]string
+		resp.Diagnostics.Append(plan.LoggingConfig.ElementsAs(ctx, &loggingConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if config == nil {
+			config = make(map[string]string)
+		}
+		config[LoggingConfigKey] = loggingConfigString(loggingConfig)
+	}
+	var annotations map[string]string
+	resp.Diagnostics.Append(plan.Annotations.ElementsAs(ctx, &annotations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var disabledCommands map[string]string
+	resp.Diagnostics.Append(plan.DisabledCommands.ElementsAs(ctx, &disabledCommands, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	credential := plan.Credential.ValueString()
 	readConstraints := plan.Constraints.ValueString()
 
 	parsedConstraints := constraints.Value{}
 	var err error
-	if readConstraints != "" {
+	if !plan.ConstraintsMap.IsNull() {
+		var dErr diag.Diagnostics
+		parsedConstraints, dErr = constraintsFromMap(ctx, plan.ConstraintsMap)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if readConstraints != "" {
 		// TODO (cderici): this may be moved into internal/model so
 		// resource_model can avoid importing juju/core/constraints
 		parsedConstraints, err = constraints.Parse(readConstraints)
@@ -216,12 +477,17 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	response, err := r.client.Models.CreateModel(juju.CreateModelInput{
-		Name:        modelName,
-		CloudName:   cloudNameInput,
-		CloudRegion: cloudRegionInput,
-		Config:      config,
-		Constraints: parsedConstraints,
-		Credential:  credential,
+		Name:             modelName,
+		Owner:            plan.Owner.ValueString(),
+		CloudName:        cloudNameInput,
+		CloudRegion:      cloudRegionInput,
+		Config:           config,
+		Constraints:      parsedConstraints,
+		Credential:       credential,
+		Annotations:      annotations,
+		SecretBackend:    plan.SecretBackend.ValueString(),
+		DisabledCommands: disabledCommands,
+		SLALevel:         plan.SLALevel.ValueString(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create model, got error: %s", err))
@@ -245,7 +511,27 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	plan.Credential = types.StringValue(response.CloudCredentialName)
+	plan.Owner = types.StringValue(response.Owner)
+	plan.SLALevel = types.StringValue(response.SLALevel)
+	if plan.SecretBackend.IsUnknown() {
+		plan.SecretBackend = types.StringValue(response.SecretBackend)
+	}
 	plan.Type = types.StringValue(response.Type)
+	if !plan.AgentVersion.IsUnknown() && plan.AgentVersion.ValueString() != response.AgentVersion {
+		err = r.client.Models.UpgradeModel(ctx, juju.UpgradeModelInput{
+			Name:         response.UUID,
+			AgentVersion: plan.AgentVersion.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upgrade model, got error: %s", err))
+			return
+		}
+	} else {
+		plan.AgentVersion = types.StringValue(response.AgentVersion)
+	}
+	plan.Life = types.StringValue(response.Life)
+	plan.Status = types.StringValue(response.Status)
+	plan.UUID = types.StringValue(response.UUID)
 	plan.ID = types.StringValue(response.UUID)
 
 	r.trace(fmt.Sprintf("model resource created: %q", modelName))
@@ -261,6 +547,10 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	if r.client.Offline {
+		return
+	}
+
 	var state modelResourceModel
 
 	// Read Terraform configuration from the request into the model
@@ -269,23 +559,16 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Find the model name. If the Id is a UUID, this is
-	// not an Import followed by a Read. If the Id string
-	// is not a UUID, find the model name in the Id, rather
-	// than Name as we're doing a Read after Import. Either
-	// way, we need the model name.
-	var modelName string
-	var imported bool
-	if utils.IsValidUUIDString(state.ID.ValueString()) {
-		modelName = state.Name.ValueString()
-	} else {
-		imported = true
-		modelName = state.ID.ValueString()
-	}
+	// Find the model. If the Id is a UUID, this is not an
+	// Import followed by a Read, and the model is found by
+	// its UUID so it is not lost if renamed. If the Id string
+	// is not a UUID, it is the model name given to the Import.
+	modelName := state.ID.ValueString()
+	imported := !utils.IsValidUUIDString(modelName)
 
 	response, err := r.client.Models.ReadModel(modelName)
 	if err != nil {
-		resp.Diagnostics.Append(handleModelNotFoundError(ctx, err, &resp.State)...)
+		resp.Diagnostics.Append(handleModelNotFoundError(ctx, r.client, err, &resp.State)...)
 		return
 	}
 	r.trace(fmt.Sprintf("found model: %v", modelName))
@@ -316,8 +599,41 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Constraints
+	if state.ConstraintsDrift.IsNull() {
+		state.ConstraintsDrift = types.StringValue(ConstraintsDriftUpdate)
+	}
+	readConstraints := state.Constraints
 	if (imported && response.ModelConstraints.String() != "") || !state.Constraints.IsNull() {
-		state.Constraints = types.StringValue(response.ModelConstraints.String())
+		readConstraints = NewConstraintsValue(response.ModelConstraints)
+	}
+	readConstraintsMap, constraintsDiags := constraintsMapFromValue(ctx, state.ConstraintsMap, response.ModelConstraints)
+	resp.Diagnostics.Append(constraintsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keep, constraintsDiags := keepStateConstraints(ctx, state.ConstraintsDrift, state.Constraints, readConstraints,
+		state.ConstraintsMap, readConstraintsMap, resp.Private)
+	resp.Diagnostics.Append(constraintsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !keep {
+		state.Constraints = readConstraints
+		state.ConstraintsMap = readConstraintsMap
+	}
+
+	// Annotations
+	if len(response.ModelAnnotations) > 0 || !state.Annotations.IsNull() {
+		stateAnnotations := make(map[string]string, len(response.ModelAnnotations))
+		for k, v := range response.ModelAnnotations {
+			stateAnnotations[k] = v
+		}
+		newStateAnnotations, errDiag := types.MapValueFrom(ctx, types.StringType, stateAnnotations)
+		resp.Diagnostics.Append(errDiag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Annotations = newStateAnnotations
 	}
 
 	// Config
@@ -332,24 +648,20 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			return
 		}
 
-		for k := range stateConfig {
-			if value, exists := response.ModelConfig[k]; exists {
-				var serialised string
-				switch value.(type) {
-				// TODO: review for other possible types
-				case bool:
-					b, err := json.Marshal(value)
-					if err != nil {
-						resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("Unable to cast config value, got error: %s", err))
-						return
-					}
-					serialised = string(b)
-				default:
-					serialised = value.(string)
-				}
-
-				stateConfig[k] = serialised
+		for k, stateValue := range stateConfig {
+			value, exists := response.ModelConfig[k]
+			if !exists {
+				// The key is no longer set on the model, remove it
+				// from state so the difference is planned.
+				delete(stateConfig, k)
+				continue
+			}
+			serialised, err := modelConfigValueString(stateValue, value)
+			if err != nil {
+				resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("Unable to cast config value, got error: %s", err))
+				return
 			}
+			stateConfig[k] = serialised
 		}
 
 		configType := req.State.Schema.GetAttributes()["config"].(schema.MapAttribute).ElementType
@@ -362,10 +674,67 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.Config = newStateConfig
 	}
 
-	// Name, Type, Credential, and Id.
-	state.Name = types.StringValue(modelName)
+	// Destroy options are not known by juju, use the defaults
+	// if they are not in state, e.g. after import.
+	if state.DestroyStorage.IsNull() {
+		state.DestroyStorage = types.BoolValue(true)
+	}
+	if state.Force.IsNull() {
+		state.Force = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
+	// Logging config
+	if !state.LoggingConfig.IsNull() {
+		value, _ := response.ModelConfig[LoggingConfigKey].(string)
+		loggingConfig, err := parseLoggingConfig(value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse logging config of model, got error: %s", err))
+			return
+		}
+		newStateLoggingConfig, errDiag := types.MapValueFrom(ctx, types.StringType, loggingConfig)
+		resp.Diagnostics.Append(errDiag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.LoggingConfig = newStateLoggingConfig
+	}
+
+	// SLA level
+	state.SLALevel = types.StringValue("unsupported")
+	if response.ModelInfo.SLA != nil && response.ModelInfo.SLA.Level != "" {
+		state.SLALevel = types.StringValue(response.ModelInfo.SLA.Level)
+	}
+
+	// Disabled commands
+	if len(response.DisabledCommands) > 0 || !state.DisabledCommands.IsNull() {
+		newStateDisabledCommands, errDiag := types.MapValueFrom(ctx, types.StringType, response.DisabledCommands)
+		resp.Diagnostics.Append(errDiag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.DisabledCommands = newStateDisabledCommands
+	}
+
+	// Secret backend
+	if secretBackend, ok := response.ModelConfig[juju.SecretBackendConfigKey].(string); ok {
+		state.SecretBackend = types.StringValue(secretBackend)
+	}
+
+	// Name, Owner, Type, Credential, and Id.
+	state.Name = types.StringValue(response.ModelInfo.Name)
+	state.Owner = types.StringValue(strings.TrimPrefix(response.ModelInfo.OwnerTag, juju.PrefixUser))
 	state.Type = types.StringValue(response.ModelInfo.Type)
+	state.Life = types.StringValue(string(response.ModelInfo.Life))
+	state.Status = types.StringValue(string(response.ModelInfo.Status.Status))
+	state.AgentVersion = types.StringValue("")
+	if response.ModelInfo.AgentVersion != nil {
+		state.AgentVersion = types.StringValue(response.ModelInfo.AgentVersion.String())
+	}
 	state.Credential = types.StringValue(credential)
+	state.UUID = types.StringValue(response.ModelInfo.UUID)
 	state.ID = types.StringValue(response.ModelInfo.UUID)
 
 	r.trace(fmt.Sprintf("Read model resource for: %v", modelName))
@@ -416,13 +785,49 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		configMap = newConfigMap
 	}
 
+	// Check the logging config, it is unset when removed.
+	if !plan.LoggingConfig.Equal(state.LoggingConfig) {
+		noChange = false
+		if configMap == nil {
+			configMap = make(map[string]string)
+		}
+		if plan.LoggingConfig.IsNull() {
+			unsetConfigKeys = append(unsetConfigKeys, LoggingConfigKey)
+		} else {
+			var loggingConfig map[string]string
+			resp.Diagnostics.Append(plan.LoggingConfig.ElementsAs(ctx, &loggingConfig, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			configMap[LoggingConfigKey] = loggingConfigString(loggingConfig)
+		}
+	}
+
 	// Check the constraints
 	newConstraints, err := constraints.Parse(state.Constraints.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse constraints for model, got error: %s", err))
 		return
 	}
-	if !plan.Constraints.Equal(state.Constraints) {
+	if !state.ConstraintsMap.IsNull() {
+		var dErr diag.Diagnostics
+		newConstraints, dErr = constraintsFromMap(ctx, state.ConstraintsMap)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !plan.ConstraintsMap.IsNull() {
+		if !plan.ConstraintsMap.Equal(state.ConstraintsMap) {
+			noChange = false
+			var dErr diag.Diagnostics
+			newConstraints, dErr = constraintsFromMap(ctx, plan.ConstraintsMap)
+			resp.Diagnostics.Append(dErr...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	} else if equal, dErr := plan.Constraints.StringSemanticEquals(ctx, state.Constraints); (!equal && !dErr.HasError()) || !state.ConstraintsMap.IsNull() {
 		noChange = false
 		newConstraints, err = constraints.Parse(plan.Constraints.ValueString())
 		if err != nil {
@@ -431,6 +836,67 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	// Check the annotations, removed annotations are set to an
+	// empty value.
+	var annotations map[string]string
+	if !plan.Annotations.Equal(state.Annotations) {
+		noChange = false
+		resp.Diagnostics.Append(plan.Annotations.ElementsAs(ctx, &annotations, false)...)
+		oldAnnotations := map[string]string{}
+		resp.Diagnostics.Append(state.Annotations.ElementsAs(ctx, &oldAnnotations, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		for k := range oldAnnotations {
+			if _, ok := annotations[k]; !ok {
+				annotations[k] = ""
+			}
+		}
+	}
+
+	// Check the disabled commands, commands removed from the
+	// map are enabled again.
+	var disableCommands map[string]string
+	var enableCommands []string
+	if !plan.DisabledCommands.Equal(state.DisabledCommands) {
+		noChange = false
+		newDisabledCommands := map[string]string{}
+		resp.Diagnostics.Append(plan.DisabledCommands.ElementsAs(ctx, &newDisabledCommands, false)...)
+		oldDisabledCommands := map[string]string{}
+		resp.Diagnostics.Append(state.DisabledCommands.ElementsAs(ctx, &oldDisabledCommands, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		disableCommands = make(map[string]string)
+		for command, message := range newDisabledCommands {
+			if oldMessage, ok := oldDisabledCommands[command]; !ok || oldMessage != message {
+				disableCommands[command] = message
+			}
+		}
+		for command := range oldDisabledCommands {
+			if _, ok := newDisabledCommands[command]; !ok {
+				enableCommands = append(enableCommands, command)
+			}
+		}
+	}
+
+	// Check the secret backend
+	secretBackend := ""
+	if !plan.SecretBackend.Equal(state.SecretBackend) {
+		noChange = false
+		secretBackend = plan.SecretBackend.ValueString()
+	}
+
+	// Check the SLA level
+	slaLevel := ""
+	if !plan.SLALevel.Equal(state.SLALevel) {
+		noChange = false
+		slaLevel = plan.SLALevel.ValueString()
+	}
+
 	// Check the credential
 	credentialUpdate := ""
 	if !plan.Credential.Equal(state.Credential) {
@@ -438,7 +904,28 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		credentialUpdate = plan.Credential.ValueString()
 	}
 
+	// Upgrade the model before any other change, the upgrade
+	// is not undone if a later change fails.
+	if !plan.AgentVersion.IsUnknown() && !plan.AgentVersion.Equal(state.AgentVersion) {
+		err = r.client.Models.UpgradeModel(ctx, juju.UpgradeModelInput{
+			Name:         state.ID.ValueString(),
+			AgentVersion: plan.AgentVersion.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upgrade model, got error: %s", err))
+			return
+		}
+	}
+
 	if noChange {
+		// The constraints drift policy only applies on read, and
+		// the destroy options on destroy.
+		state.ConstraintsDrift = plan.ConstraintsDrift
+		state.DeletionProtection = plan.DeletionProtection
+		state.DestroyStorage = plan.DestroyStorage
+		state.DestroyTimeout = plan.DestroyTimeout
+		state.Force = plan.Force
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 		return
 	}
 
@@ -455,12 +942,17 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	err = r.client.Models.UpdateModel(juju.UpdateModelInput{
-		Name:        plan.Name.ValueString(),
-		CloudName:   cloudNameInput,
-		Config:      configMap,
-		Unset:       unsetConfigKeys,
-		Constraints: &newConstraints,
-		Credential:  credentialUpdate,
+		Name:            state.ID.ValueString(),
+		CloudName:       cloudNameInput,
+		Config:          configMap,
+		Unset:           unsetConfigKeys,
+		Constraints:     &newConstraints,
+		Credential:      credentialUpdate,
+		Annotations:     annotations,
+		SecretBackend:   secretBackend,
+		DisableCommands: disableCommands,
+		EnableCommands:  enableCommands,
+		SLALevel:        slaLevel,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update model, got error: %s", err))
@@ -482,12 +974,26 @@ func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	// Read Terraform configuration from the request into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(checkDeletionProtection(ctx, req.State, "model")...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var timeout *time.Duration
+	if !state.DestroyTimeout.IsNull() {
+		parsed, err := time.ParseDuration(state.DestroyTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse destroy timeout, got error: %s", err))
+			return
+		}
+		timeout = &parsed
+	}
+
 	err := r.client.Models.DestroyModel(juju.DestroyModelInput{
-		UUID: state.ID.ValueString(),
+		UUID:           state.ID.ValueString(),
+		DestroyStorage: state.DestroyStorage.ValueBool(),
+		Force:          state.Force.ValueBool(),
+		Timeout:        timeout,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete model, got error: %s", err))
@@ -496,11 +1002,87 @@ func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	r.trace(fmt.Sprintf("model deleted : %q", state.Name.ValueString()))
 }
 
-func handleModelNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
+// modelConfigValueString returns the string representation of a model
+// config value read from juju. If the value read is equal to the value
+// in state once typed, e.g. "True" and true, the state value is kept to
+// avoid reporting a difference.
+func modelConfigValueString(stateValue string, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		if b, err := strconv.ParseBool(stateValue); err == nil && b == v {
+			return stateValue, nil
+		}
+		return strconv.FormatBool(v), nil
+	case float64:
+		if f, err := strconv.ParseFloat(stateValue, 64); err == nil && f == v {
+			return stateValue, nil
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		if i, err := strconv.Atoi(stateValue); err == nil && i == v {
+			return stateValue, nil
+		}
+		return strconv.Itoa(v), nil
+	case nil:
+		return "", nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
+
+// LoggingConfigKey is the model config key holding the log level
+// of the logging modules of the model.
+const LoggingConfigKey = "logging-config"
+
+// loggingLevels are the log levels of a logging module.
+var loggingLevels = []string{"TRACE", "DEBUG", "INFO", "WARNING", "ERROR", "CRITICAL"}
+
+// loggingModuleRegexp matches the root logging module, a dotted
+// logging module name or a logging label.
+var loggingModuleRegexp = regexp.MustCompile(`^(<root>|#[a-z0-9-]+|[a-z0-9_-]+(\.[a-z0-9_-]+)*)$`)
+
+// loggingConfigString returns the logging-config model config value
+// of the given log levels, e.g. <root>=WARNING;unit=DEBUG.
+func loggingConfigString(modules map[string]string) string {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	entries := make([]string, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, fmt.Sprintf("%s=%s", name, modules[name]))
+	}
+	return strings.Join(entries, ";")
+}
+
+// parseLoggingConfig returns the log levels of the logging modules
+// of a logging-config model config value.
+func parseLoggingConfig(value string) (map[string]string, error) {
+	config, err := loggo.ParseConfigString(value)
+	if err != nil {
+		return nil, err
+	}
+	modules := make(map[string]string, len(config))
+	for name, level := range config {
+		if name == "" {
+			name = "<root>"
+		}
+		modules[name] = level.String()
+	}
+	return modules, nil
+}
+
+func handleModelNotFoundError(ctx context.Context, client *juju.Client, err error, st *tfsdk.State) diag.Diagnostics {
 	if errors.As(err, &juju.ModelNotFoundError) {
 		// Model manually removed
-		st.RemoveResource(ctx)
-		return diag.Diagnostics{}
+		return removeMissingResource(ctx, client, st, "model", err)
 	}
 
 	var diags diag.Diagnostics
@@ -0,0 +1,344 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &modelDefaultsResource{}
+var _ resource.ResourceWithConfigure = &modelDefaultsResource{}
+var _ resource.ResourceWithImportState = &modelDefaultsResource{}
+
+func NewModelDefaultsResource() resource.Resource {
+	return &modelDefaultsResource{}
+}
+
+type modelDefaultsResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type modelDefaultsResourceModel struct {
+	Cloud  types.String `tfsdk:"cloud"`
+	Region types.String `tfsdk:"region"`
+	Config types.Map    `tfsdk:"config"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *modelDefaultsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_defaults"
+}
+
+func (r *modelDefaultsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the model defaults of a cloud, or of a region of a cloud. " +
+			"Models created afterwards inherit these defaults, unless overridden by the model config.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud the model defaults apply to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The region of the cloud the model defaults apply to. If not set, " +
+					"the model defaults apply to all of the regions of the cloud.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "The model config defaults, e.g. `apt-mirror` or `default-base`. Keys removed " +
+					"from the map are reset to the juju default.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *modelDefaultsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceModelDefaults)
+}
+
+// ImportState reads the cloud and region from an ID of the form
+// <cloud> or <cloud>:<region>.
+func (r *modelDefaultsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cloud, region, _ := strings.Cut(req.ID, ":")
+	if cloud == "" {
+		resp.Diagnostics.AddError(
+			"ImportState Failure",
+			fmt.Sprintf("Malformed model defaults ID %q, expected <cloud> or <cloud>:<region>", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cloud"), cloud)...)
+	if region != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *modelDefaultsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_defaults", "create")
+		return
+	}
+
+	var plan modelDefaultsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config map[string]string
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &config, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ModelDefaults.SetModelDefaults(juju.SetModelDefaultsInput{
+		CloudName:   plan.Cloud.ValueString(),
+		CloudRegion: plan.Region.ValueString(),
+		Config:      config,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set model defaults, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(newModelDefaultsID(plan.Cloud.ValueString(), plan.Region.ValueString()))
+	r.trace(fmt.Sprintf("model defaults created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *modelDefaultsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_defaults", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state modelDefaultsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.ModelDefaults.ReadModelDefaults(juju.ReadModelDefaultsInput{
+		CloudName:   state.Cloud.ValueString(),
+		CloudRegion: state.Region.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model defaults, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read model defaults: %q", state.ID.ValueString()))
+
+	stateConfig := map[string]string{}
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newConfig := make(map[string]string)
+	if state.Config.IsNull() {
+		// After import, track all of the defaults set.
+		for k, value := range response.Config {
+			serialised, err := modelConfigValueString("", value)
+			if err != nil {
+				resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("Unable to cast config value, got error: %s", err))
+				return
+			}
+			newConfig[k] = serialised
+		}
+	} else {
+		// Keys no longer set are left out, so the difference is planned.
+		for k, stateValue := range stateConfig {
+			value, exists := response.Config[k]
+			if !exists {
+				continue
+			}
+			serialised, err := modelConfigValueString(stateValue, value)
+			if err != nil {
+				resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("Unable to cast config value, got error: %s", err))
+				return
+			}
+			newConfig[k] = serialised
+		}
+	}
+
+	if len(newConfig) == 0 {
+		// None of the defaults are set anymore.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newStateConfig, errDiag := types.MapValueFrom(ctx, types.StringType, newConfig)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Config = newStateConfig
+	state.ID = types.StringValue(newModelDefaultsID(state.Cloud.ValueString(), state.Region.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *modelDefaultsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_defaults", "update")
+		return
+	}
+
+	var plan, state modelDefaultsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planConfig := map[string]string{}
+	stateConfig := map[string]string{}
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &planConfig, false)...)
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changedConfig := make(map[string]string)
+	for k, v := range planConfig {
+		if stateValue, ok := stateConfig[k]; !ok || stateValue != v {
+			changedConfig[k] = v
+		}
+	}
+	var unsetKeys []string
+	for k := range stateConfig {
+		if _, ok := planConfig[k]; !ok {
+			unsetKeys = append(unsetKeys, k)
+		}
+	}
+
+	err := r.client.ModelDefaults.SetModelDefaults(juju.SetModelDefaultsInput{
+		CloudName:   plan.Cloud.ValueString(),
+		CloudRegion: plan.Region.ValueString(),
+		Config:      changedConfig,
+		Unset:       unsetKeys,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update model defaults, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("model defaults updated: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resets the model defaults managed by the resource to their
+// juju default.
+func (r *modelDefaultsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_defaults", "delete")
+		return
+	}
+
+	var state modelDefaultsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateConfig := map[string]string{}
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys := make([]string, 0, len(stateConfig))
+	for k := range stateConfig {
+		keys = append(keys, k)
+	}
+
+	err := r.client.ModelDefaults.UnsetModelDefaults(juju.UnsetModelDefaultsInput{
+		CloudName:   state.Cloud.ValueString(),
+		CloudRegion: state.Region.ValueString(),
+		Keys:        keys,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unset model defaults, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("model defaults deleted: %q", state.ID.ValueString()))
+}
+
+func (r *modelDefaultsResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceModelDefaults, msg, additionalFields...)
+}
+
+// newModelDefaultsID returns the ID of model defaults, of the form
+// <cloud> or <cloud>:<region>.
+func newModelDefaultsID(cloud, region string) string {
+	if region == "" {
+		return cloud
+	}
+	return fmt.Sprintf("%s:%s", cloud, region)
+}
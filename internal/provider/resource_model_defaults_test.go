@@ -0,0 +1,56 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceModelDefaults(t *testing.T) {
+	cloudName := testingCloud.CloudName()
+
+	resourceName := "juju_model_defaults.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelDefaults(cloudName, `update-status-hook-interval = "7m"
+    logging-config = "<root>=DEBUG"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "config.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "config.update-status-hook-interval", "7m"),
+				),
+			},
+			{
+				Config: testAccResourceModelDefaults(cloudName, `update-status-hook-interval = "8m"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.update-status-hook-interval", "8m"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     cloudName,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceModelDefaults(cloudName, config string) string {
+	return fmt.Sprintf(`
+resource "juju_model_defaults" "this" {
+  cloud = %q
+
+  config = {
+    %s
+  }
+}`, cloudName, config)
+}
@@ -0,0 +1,243 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &modelMigrationResource{}
+var _ resource.ResourceWithConfigure = &modelMigrationResource{}
+
+func NewModelMigrationResource() resource.Resource {
+	return &modelMigrationResource{}
+}
+
+type modelMigrationResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type modelMigrationResourceModel struct {
+	ModelName             types.String `tfsdk:"model"`
+	ModelUUID             types.String `tfsdk:"model_uuid"`
+	TargetAddresses       types.List   `tfsdk:"target_addresses"`
+	TargetCACertificate   types.String `tfsdk:"target_ca_certificate"`
+	TargetControllerAlias types.String `tfsdk:"target_controller_alias"`
+	TargetControllerUUID  types.String `tfsdk:"target_controller_uuid"`
+	TargetPassword        types.String `tfsdk:"target_password"`
+	TargetUsername        types.String `tfsdk:"target_username"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *modelMigrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_migration"
+}
+
+func (r *modelMigrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the migration of a model to another controller, as `juju migrate` " +
+			"does. The model is migrated when the resource is created, and is no longer managed by this provider " +
+			"afterwards: resources of the model must be removed from the configuration, and managed by a provider " +
+			"configured for the target controller. Destroying the resource does not migrate the model back.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model to migrate.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"model_uuid": schema.StringAttribute{
+				Description: "The UUID of the migrated model, unchanged by the migration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_addresses": schema.ListAttribute{
+				Description: "The API addresses of the target controller, e.g. `10.0.0.1:17070`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_ca_certificate": schema.StringAttribute{
+				Description: "The CA certificate of the target controller.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_controller_alias": schema.StringAttribute{
+				Description: "The name of the target controller.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_controller_uuid": schema.StringAttribute{
+				Description: "The UUID of the target controller.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_password": schema.StringAttribute{
+				Description: "The password of the user authenticating with the target controller.",
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_username": schema.StringAttribute{
+				Description: "The user authenticating with the target controller, it must be a superuser of " +
+					"the target controller.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the migration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *modelMigrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceModelMigration)
+}
+
+// Create migrates the model and waits for it to leave the controller.
+func (r *modelMigrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_migration", "create")
+		return
+	}
+
+	var plan modelMigrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var addresses []string
+	resp.Diagnostics.Append(plan.TargetAddresses.ElementsAs(ctx, &addresses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Models.MigrateModel(ctx, juju.MigrateModelInput{
+		Name:                  plan.ModelName.ValueString(),
+		TargetControllerUUID:  plan.TargetControllerUUID.ValueString(),
+		TargetControllerAlias: plan.TargetControllerAlias.ValueString(),
+		TargetAddresses:       addresses,
+		TargetCACert:          plan.TargetCACertificate.ValueString(),
+		TargetUser:            plan.TargetUsername.ValueString(),
+		TargetPassword:        plan.TargetPassword.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to migrate model, got error: %s", err))
+		return
+	}
+
+	plan.ModelUUID = types.StringValue(response.UUID)
+	plan.ID = types.StringValue(response.MigrationID)
+	r.trace(fmt.Sprintf("model migrated: %q", plan.ModelName.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is, the migrated model is no longer
+// known to the controller of the provider.
+func (r *modelMigrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil || r.client.Offline {
+		return
+	}
+
+	var state modelMigrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, all of the attributes require the
+// resource to be replaced.
+func (r *modelMigrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan modelMigrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state only, a migration
+// cannot be undone.
+func (r *modelMigrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state modelMigrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace(fmt.Sprintf("model migration removed from state: %q", state.ID.ValueString()))
+}
+
+func (r *modelMigrationResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceModelMigration, msg, additionalFields...)
+}
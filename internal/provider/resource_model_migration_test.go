@@ -0,0 +1,43 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceModelMigration_InvalidTarget(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model-migration")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceModelMigration(modelName, "not-a-controller-uuid"),
+				ExpectError: regexp.MustCompile(`controller UUID not valid`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelMigration(modelName, controllerUUID string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_model_migration" "this" {
+  model                  = juju_model.this.name
+  target_controller_uuid = %q
+  target_addresses       = ["10.0.0.1:17070"]
+  target_username        = "admin"
+  target_password        = "password"
+}`, modelName, controllerUUID)
+}
@@ -5,12 +5,15 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/juju/juju/api/client/modelconfig"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -38,6 +41,13 @@ func TestAcc_ResourceModel(t *testing.T) {
 				),
 			},
 			{
+				Config: testAccConstraintsModel(modelName, testingCloud.CloudName(), "mem=1G cores=1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "constraints", "mem=1G cores=1"),
+				),
+			},
+			{
+				// Constraints equal by value do not change the model.
 				Config: testAccConstraintsModel(modelName, testingCloud.CloudName(), "cores=1 mem=1024M"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "constraints", "cores=1 mem=1024M"),
@@ -56,6 +66,91 @@ func TestAcc_ResourceModel(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceModel_NewerConstraints(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resourceName := "juju_model.model"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConstraintsModel(modelName, testingCloud.CloudName(),
+					"arch=arm64 image-id=ubuntu-bf2 root-disk-source=default allocate-public-ip=true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "constraints",
+						"arch=arm64 image-id=ubuntu-bf2 root-disk-source=default allocate-public-ip=true"),
+				),
+			},
+			{
+				// The constraints read back from the controller produce no diff.
+				Config: testAccConstraintsModel(modelName, testingCloud.CloudName(),
+					"allocate-public-ip=true root-disk-source=default image-id=ubuntu-bf2 arch=arm64"),
+				PlanOnly: true,
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateVerifyIgnore: []string{
+					"constraints"},
+				ImportStateId: modelName,
+				ResourceName:  resourceName,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if constraints := states[0].Attributes["constraints"]; constraints !=
+						"arch=arm64 root-disk-source=default allocate-public-ip=true image-id=ubuntu-bf2" {
+						return fmt.Errorf("unexpected imported constraints %q", constraints)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceModel_ConstraintsMap(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resourceName := "juju_model.model"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConstraintsMap(modelName, "mem = 1024\n    cores = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "constraints_map.mem", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "constraints_map.cores", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "constraints_map.arch"),
+				),
+			},
+			{
+				Config: testAccResourceModelConstraintsMap(modelName, "mem = 2048\n    spaces = [\"alpha\"]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "constraints_map.mem", "2048"),
+					resource.TestCheckNoResourceAttr(resourceName, "constraints_map.cores"),
+					resource.TestCheckResourceAttr(resourceName, "constraints_map.spaces.0", "alpha"),
+				),
+			},
+			{
+				Config:      testAccResourceModelConstraintsMap(modelName, "mem = -1"),
+				ExpectError: regexp.MustCompile("Attribute constraints_map.mem value must be at least 0"),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConstraintsMap(modelName, constraintsMap string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "model" {
+  name = %q
+
+  constraints_map = {
+    %s
+  }
+}`, modelName, constraintsMap)
+}
+
 func TestAcc_ResourceModel_UnsetConfig(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-model")
 
@@ -93,6 +188,545 @@ resource "juju_model" "this" {
 	})
 }
 
+func TestAcc_ResourceModel_ConfigDrift(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	proxy := "http://proxy.example.com:3128"
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelTypedConfig(modelName, proxy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.development", "True"),
+					resource.TestCheckResourceAttr(resourceName, "config.apt-http-proxy", proxy),
+				),
+			},
+			{
+				// Typed values equal to the model config do not diff.
+				Config:   testAccResourceModelTypedConfig(modelName, proxy),
+				PlanOnly: true,
+			},
+			{
+				PreConfig:          func() { testAccSetModelConfig(t, modelName, "apt-http-proxy", "http://other.example.com:3128") },
+				Config:             testAccResourceModelTypedConfig(modelName, proxy),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceModelTypedConfig(modelName, proxy),
+				Check:  resource.TestCheckResourceAttr(resourceName, "config.apt-http-proxy", proxy),
+			},
+		},
+	})
+}
+
+// testAccSetModelConfig changes the model config outside of terraform.
+func testAccSetModelConfig(t *testing.T, modelName, key, value string) {
+	conn, err := TestClient.Models.GetConnection(&modelName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err := modelconfig.NewClient(conn).ModelSet(map[string]interface{}{key: value}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAcc_ResourceModel_ConstraintsDrift(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConstraintsDrift(modelName, "error"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "constraints", "mem=1G"),
+					resource.TestCheckResourceAttr(resourceName, "constraints_drift", "error"),
+				),
+			},
+			{
+				PreConfig:   func() { testAccSetModelConstraints(t, modelName, "mem=2G") },
+				Config:      testAccResourceModelConstraintsDrift(modelName, "error"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Constraints Drift"),
+			},
+			{
+				// The constraints of the state are kept.
+				Config: testAccResourceModelConstraintsDrift(modelName, "ignore"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "constraints", "mem=1G"),
+			},
+			{
+				Config: testAccResourceModelConstraintsDrift(modelName, "update"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "constraints_drift", "update"),
+			},
+			{
+				// The constraints are read back from the controller.
+				Config:             testAccResourceModelConstraintsDrift(modelName, "update"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceModelConstraintsDrift(modelName, "update"),
+				Check:  testAccCheckModelConstraints(modelName, "mem=1024M"),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConstraintsDrift(modelName, policy string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name              = %q
+  constraints       = "mem=1G"
+  constraints_drift = %q
+}`, modelName, policy)
+}
+
+// testAccSetModelConstraints changes the model constraints outside of
+// terraform.
+func testAccSetModelConstraints(t *testing.T, modelName, value string) {
+	conn, err := TestClient.Models.GetConnection(&modelName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err := modelconfig.NewClient(conn).SetModelConstraints(constraints.MustParse(value)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testAccCheckModelConstraints checks the constraints of the model
+// are the given ones.
+func testAccCheckModelConstraints(modelName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := TestClient.Models.GetConnection(&modelName)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = conn.Close() }()
+
+		value, err := modelconfig.NewClient(conn).GetModelConstraints()
+		if err != nil {
+			return err
+		}
+		if value.String() != expected {
+			return fmt.Errorf("expected model constraints %q, got %q", expected, value.String())
+		}
+		return nil
+	}
+}
+
+func testAccResourceModelTypedConfig(modelName, proxy string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+
+  config = {
+    development    = "True"
+    apt-http-proxy = %q
+  }
+}`, modelName, proxy)
+}
+
+func TestAcc_ResourceModel_DestroyOptions(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destroy_storage", "true"),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "destroy_timeout"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+
+  destroy_storage = false
+  force           = true
+  destroy_timeout = "10m"
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destroy_storage", "false"),
+					resource.TestCheckResourceAttr(resourceName, "force", "true"),
+					resource.TestCheckResourceAttr(resourceName, "destroy_timeout", "10m"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceModel_Annotations(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelAnnotations(modelName, `team = "platform"
+    ticket = "OPS-1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "annotations.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.team", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.ticket", "OPS-1"),
+				),
+			},
+			{
+				Config: testAccResourceModelAnnotations(modelName, `team = "observability"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.team", "observability"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     modelName,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceModelAnnotations(modelName, annotations string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+
+  annotations = {
+    %s
+  }
+}`, modelName, annotations)
+}
+
+func TestAcc_ResourceModel_SecretBackend(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}`, modelName),
+				Check: resource.TestCheckResourceAttr(resourceName, "secret_backend", "auto"),
+			},
+			{
+				Config: testAccResourceModelSecretBackend(modelName, "internal"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "secret_backend", "internal"),
+			},
+			{
+				Config:      testAccResourceModelSecretBackend(modelName, "not-a-backend"),
+				ExpectError: regexp.MustCompile(`secret backend "not-a-backend" not found`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelSecretBackend(modelName, secretBackend string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name           = %q
+  secret_backend = %q
+}`, modelName, secretBackend)
+}
+
+func TestAcc_ResourceModel_Owner(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelOwner(userName, userPassword, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", modelName),
+					resource.TestCheckResourceAttr(resourceName, "owner", userName),
+					resource.TestCheckResourceAttrPair("data.juju_model.this", "uuid", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.juju_model.this", "owner", userName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", userName, modelName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceModelOwner(userName, userPassword, modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "this" {
+  name     = %q
+  password = %q
+}
+
+resource "juju_model" "this" {
+  name  = %q
+  owner = juju_user.this.name
+}
+
+data "juju_model" "this" {
+  name = "${juju_model.this.owner}/${juju_model.this.name}"
+}`, userName, userPassword, modelName)
+}
+
+func TestAcc_ResourceModel_AgentVersion(t *testing.T) {
+	agentVersion := os.Getenv(TestUpgradeAgentVersionEnvKey)
+	if agentVersion == "" {
+		t.Skip(t.Name() + " only runs with " + TestUpgradeAgentVersionEnvKey + " set")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}`, modelName),
+				Check: resource.TestCheckResourceAttrSet(resourceName, "agent_version"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name          = %q
+  agent_version = %q
+}`, modelName, agentVersion),
+				Check: resource.TestCheckResourceAttr(resourceName, "agent_version", agentVersion),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceModel_DisabledCommands(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelDisabledCommands(modelName, `{
+    destroy-model = "protected by terraform"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled_commands.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "disabled_commands.destroy-model", "protected by terraform"),
+				),
+			},
+			{
+				Config: testAccResourceModelDisabledCommands(modelName, `{
+    remove-object = "no removals"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled_commands.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "disabled_commands.remove-object", "no removals"),
+				),
+			},
+			{
+				Config:      testAccResourceModelDisabledCommands(modelName, `{ remove-everything = "" }`),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				// Enable the commands again so the model can be destroyed.
+				Config: testAccResourceModelDisabledCommands(modelName, "{}"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "disabled_commands.%", "0"),
+			},
+		},
+	})
+}
+
+func testAccResourceModelDisabledCommands(modelName, disabledCommands string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name              = %q
+  disabled_commands = %s
+}`, modelName, disabledCommands)
+}
+
+func TestAcc_ResourceModel_DeletionProtection(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelDeletionProtection(modelName, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+			},
+			{
+				// Removing the model from the configuration fails the plan.
+				Config:      `locals {}`,
+				ExpectError: regexp.MustCompile(`deletion_protection is set`),
+			},
+			{
+				Config:      testAccResourceModelDeletionProtection(modelName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion_protection is set`),
+			},
+			{
+				// Unset the protection so the model can be destroyed.
+				Config: testAccResourceModelDeletionProtection(modelName, false),
+				Check:  resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+			},
+		},
+	})
+}
+
+func testAccResourceModelDeletionProtection(modelName string, protected bool) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name                = %q
+  deletion_protection = %t
+}`, modelName, protected)
+}
+
+func TestAcc_ResourceModel_InvalidCloud(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	cloudName := testingCloud.CloudName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceModelCloud(modelName, "not-a-cloud", "localhost"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`cloud "not-a-cloud" not found`),
+			},
+			{
+				Config:      testAccResourceModelCloud(modelName, cloudName, "not-a-region"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`region "not-a-region" not found`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelCloud(modelName, cloudName, region string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+
+  cloud {
+    name   = %q
+    region = %q
+  }
+}`, modelName, cloudName, region)
+}
+
+func TestAcc_ResourceModel_SLALevel(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}`, modelName),
+				Check: resource.TestCheckResourceAttr(resourceName, "sla_level", "unsupported"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name      = %q
+  sla_level = "essential"
+}`, modelName),
+				Check: resource.TestCheckResourceAttr(resourceName, "sla_level", "essential"),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceModel_LoggingConfig(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resourceName := "juju_model.this"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelLoggingConfig(modelName, `{
+    "<root>" = "WARNING"
+    unit     = "DEBUG"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logging_config.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.<root>", "WARNING"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.unit", "DEBUG"),
+				),
+			},
+			{
+				Config: testAccResourceModelLoggingConfig(modelName, `{
+    "<root>"      = "INFO"
+    "juju.worker" = "TRACE"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logging_config.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.<root>", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.juju.worker", "TRACE"),
+				),
+			},
+			{
+				Config:      testAccResourceModelLoggingConfig(modelName, `{ unit = "VERBOSE" }`),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config:      testAccResourceModelLoggingConfig(modelName, `{ "Unit Logs" = "INFO" }`),
+				ExpectError: regexp.MustCompile(`must be <root>, a dotted module name`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelLoggingConfig(modelName, loggingConfig string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name           = %q
+  logging_config = %s
+}`, modelName, loggingConfig)
+}
+
 func TestAcc_ResourceModel_Minimal(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-model")
 	resource.Test(t, resource.TestCase{
@@ -106,6 +740,10 @@ resource "juju_model" "testmodel" {
 }`, modelName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("juju_model.testmodel", "name", modelName),
+					resource.TestCheckResourceAttr("juju_model.testmodel", "life", "alive"),
+					resource.TestCheckResourceAttr("juju_model.testmodel", "status", "available"),
+					resource.TestCheckResourceAttrSet("juju_model.testmodel", "agent_version"),
+					resource.TestCheckResourceAttrPair("juju_model.testmodel", "uuid", "juju_model.testmodel", "id"),
 				),
 			},
 		},
@@ -8,15 +8,23 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/utils/v3"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -41,8 +49,14 @@ type offerResourceModel struct {
 	ModelName       types.String `tfsdk:"model"`
 	OfferName       types.String `tfsdk:"name"`
 	ApplicationName types.String `tfsdk:"application_name"`
+	ConnectionCount types.Int64  `tfsdk:"connection_count"`
+	Consumers       types.List   `tfsdk:"consumers"`
 	EndpointName    types.String `tfsdk:"endpoint"`
+	Endpoints       types.Set    `tfsdk:"endpoints"`
+	EndpointURLs    types.Map    `tfsdk:"endpoint_urls"`
 	URL             types.String `tfsdk:"url"`
+	Users           types.Map    `tfsdk:"users"`
+	Force           types.Bool   `tfsdk:"force"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -56,11 +70,14 @@ func (o *offerResource) Schema(_ context.Context, req resource.SchemaRequest, re
 		Description: "A resource that represent a Juju Offer.",
 		Attributes: map[string]schema.Attribute{
 			"model": schema.StringAttribute{
-				Description: "The name of the model to operate in.",
+				Description: "The name or UUID of the model to operate in.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the offer.",
@@ -71,6 +88,9 @@ func (o *offerResource) Schema(_ context.Context, req resource.SchemaRequest, re
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					offerNameValidator(),
+				},
 			},
 			"application_name": schema.StringAttribute{
 				Description: "The name of the application.",
@@ -78,18 +98,96 @@ func (o *offerResource) Schema(_ context.Context, req resource.SchemaRequest, re
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					applicationNameValidator(),
+				},
 			},
 			"endpoint": schema.StringAttribute{
 				Description: "The endpoint name.",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					endpointNameValidator(),
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("endpoints"),
+					}...),
+				},
+				DeprecationMessage: "Configure endpoints instead. This attribute will be removed in the next major version of the provider.",
+			},
+			"endpoints": schema.SetAttribute{
+				Description: "The endpoint names to offer, e.g. `juju offer app:ep1,ep2`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(endpointNameValidator()),
+					setvalidator.SizeAtLeast(1),
 				},
 			},
 			"url": schema.StringAttribute{
 				Description: "The offer URL.",
 				Computed:    true,
 			},
+			"endpoint_urls": schema.MapAttribute{
+				Description: "The offer URL of each offered endpoint, keyed by endpoint name.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_count": schema.Int64Attribute{
+				Description: "The number of connections made to the offer.",
+				Computed:    true,
+			},
+			"consumers": schema.ListNestedAttribute{
+				Description: "The connections made to the offer by consuming models.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_model_uuid": schema.StringAttribute{
+							Description: "The UUID of the consuming model.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The user consuming the offer.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The offered endpoint in use.",
+							Computed:    true,
+						},
+						"relation_id": schema.Int64Attribute{
+							Description: "The id of the relation for this connection.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the connection.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"users": schema.MapAttribute{
+				Description: "The access of the users to the offer, keyed by user name, e.g. `consume`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"force": schema.BoolAttribute{
+				Description: "Destroy the offer at once, even if it has consumers, removing their " +
+					"connections, as `juju remove-offer --force` does. The consumers are listed in " +
+					"`consumers`. When false, the offer is destroyed once its consumers are removed, and " +
+					"forcibly if they are not removed within 5 minutes.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -133,12 +231,23 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 		offerName = plan.ApplicationName.ValueString()
 	}
 
+	// endpoints is computed from the deprecated endpoint when not configured.
+	var endpoints []string
+	if plan.Endpoints.IsUnknown() || plan.Endpoints.IsNull() {
+		endpoints = []string{plan.EndpointName.ValueString()}
+	} else {
+		resp.Diagnostics.Append(plan.Endpoints.ElementsAs(ctx, &endpoints, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	response, errs := o.client.Offers.CreateOffer(&juju.CreateOfferInput{
 		ModelName:       modelName,
 		ModelOwner:      modelOwner,
 		Name:            offerName,
 		ApplicationName: plan.ApplicationName.ValueString(),
-		Endpoint:        plan.EndpointName.ValueString(),
+		Endpoints:       endpoints,
 	})
 	if errs != nil {
 		// TODO 10-Aug-2023
@@ -154,10 +263,41 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	o.trace(fmt.Sprintf("create offer %q at %q", response.Name, response.OfferURL))
 
+	var dErr diag.Diagnostics
+	plan.EndpointName = offerEndpointValue(endpoints)
+	plan.Endpoints, dErr = types.SetValueFrom(ctx, types.StringType, endpoints)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	plan.EndpointURLs, dErr = offerEndpointURLsValue(ctx, response.OfferURL, endpoints)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	// a new offer has no consumers yet.
+	plan.ConnectionCount = types.Int64Value(0)
+	plan.Consumers, dErr = offerConsumersValue(ctx, nil)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
 	plan.OfferName = types.StringValue(response.Name)
 	plan.URL = types.StringValue(response.OfferURL)
 	plan.ID = types.StringValue(response.OfferURL)
 
+	// The creator of the offer is granted admin access by Juju.
+	plan.Users = types.MapNull(types.StringType)
+	readResponse, err := o.client.Offers.ReadOffer(&juju.ReadOfferInput{
+		OfferURL: response.OfferURL,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read offer users, got error: %s", err))
+	} else {
+		plan.Users, dErr = types.MapValueFrom(ctx, types.StringType, readResponse.Users)
+		resp.Diagnostics.Append(dErr...)
+	}
+
 	// Set the plan onto the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -168,6 +308,11 @@ func (o *offerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		addClientNotConfiguredError(&resp.Diagnostics, "offer", "read")
 		return
 	}
+
+	if o.client.Offline {
+		return
+	}
+
 	var state offerResourceModel
 
 	// Get the Terraform state from the request into the plan
@@ -179,25 +324,68 @@ func (o *offerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		OfferURL: state.ID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.Append(handleOfferNotFoundError(ctx, err, &resp.State)...)
+		resp.Diagnostics.Append(handleOfferNotFoundError(ctx, o.client, err, &resp.State)...)
 		return
 	}
 
 	o.trace(fmt.Sprintf("read offer %q at %q", response.Name, response.OfferURL))
 
-	state.ModelName = types.StringValue(response.ModelName)
+	// A model given by its UUID is kept as is.
+	if !utils.IsValidUUIDString(state.ModelName.ValueString()) {
+		state.ModelName = types.StringValue(response.ModelName)
+	}
 	state.OfferName = types.StringValue(response.Name)
 	state.ApplicationName = types.StringValue(response.ApplicationName)
-	state.EndpointName = types.StringValue(response.Endpoint)
+	state.EndpointName = offerEndpointValue(response.Endpoints)
+	endpoints, dErr := types.SetValueFrom(ctx, types.StringType, response.Endpoints)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	state.Endpoints = endpoints
+	state.EndpointURLs, dErr = offerEndpointURLsValue(ctx, response.OfferURL, response.Endpoints)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	state.ConnectionCount = types.Int64Value(int64(len(response.Connections)))
+	state.Consumers, dErr = offerConsumersValue(ctx, response.Connections)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
 	state.URL = types.StringValue(response.OfferURL)
 	state.ID = types.StringValue(response.OfferURL)
+	state.Users, dErr = types.MapValueFrom(ctx, types.StringType, response.Users)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	if state.Force.IsNull() {
+		state.Force = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (o *offerResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
-	// There's no non-Computed attribute that's not RequiresReplace
-	// So no in-place update can happen on any field on this resource
+func (o *offerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configured change requires replacement. The only in-place
+	// updates are moving from endpoint to endpoints with the same value,
+	// which does not change the offer, and force, which is only used
+	// when the offer is destroyed.
+	var plan, state offerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.EndpointName = state.EndpointName
+	plan.Endpoints = state.Endpoints
+	plan.EndpointURLs = state.EndpointURLs
+	plan.ConnectionCount = state.ConnectionCount
+	plan.Consumers = state.Consumers
+	plan.Users = state.Users
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -225,6 +413,7 @@ func (o *offerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := o.client.Offers.DestroyOffer(&juju.DestroyOfferInput{
 		OfferURL: plan.URL.ValueString(),
+		Force:    plan.Force.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete offer, got error: %s", err))
@@ -268,15 +457,69 @@ func (o *offerResource) trace(msg string, additionalFields ...map[string]interfa
 	tflog.SubsystemTrace(o.subCtx, LogResourceOffer, msg, additionalFields...)
 }
 
+// offerEndpointValue returns the value of the deprecated endpoint
+// attribute, which is only set for an offer of a single endpoint.
+func offerEndpointValue(endpoints []string) types.String {
+	if len(endpoints) != 1 {
+		return types.StringNull()
+	}
+	return types.StringValue(endpoints[0])
+}
+
+// nestedOfferConsumer represents an element of the consumers list
+// of the offer resource and data source schemas.
+type nestedOfferConsumer struct {
+	SourceModelUUID types.String `tfsdk:"source_model_uuid"`
+	Username        types.String `tfsdk:"username"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	RelationID      types.Int64  `tfsdk:"relation_id"`
+	Status          types.String `tfsdk:"status"`
+}
+
+var offerConsumerType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"source_model_uuid": types.StringType,
+		"username":          types.StringType,
+		"endpoint":          types.StringType,
+		"relation_id":       types.Int64Type,
+		"status":            types.StringType,
+	},
+}
+
+// offerConsumersValue returns the consumers list value for the
+// given offer connections.
+func offerConsumersValue(ctx context.Context, connections []juju.OfferConnection) (types.List, diag.Diagnostics) {
+	consumers := make([]nestedOfferConsumer, 0, len(connections))
+	for _, conn := range connections {
+		consumers = append(consumers, nestedOfferConsumer{
+			SourceModelUUID: types.StringValue(conn.SourceModelUUID),
+			Username:        types.StringValue(conn.Username),
+			Endpoint:        types.StringValue(conn.Endpoint),
+			RelationID:      types.Int64Value(int64(conn.RelationID)),
+			Status:          types.StringValue(conn.Status),
+		})
+	}
+	return types.ListValueFrom(ctx, offerConsumerType, consumers)
+}
+
+// offerEndpointURLsValue returns the offer URL of each endpoint,
+// as used by `juju consume` and `juju integrate`.
+func offerEndpointURLsValue(ctx context.Context, offerURL string, endpoints []string) (types.Map, diag.Diagnostics) {
+	urls := make(map[string]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		urls[endpoint] = fmt.Sprintf("%s:%s", offerURL, endpoint)
+	}
+	return types.MapValueFrom(ctx, types.StringType, urls)
+}
+
 func isOfferNotFound(err error) bool {
 	return strings.Contains(err.Error(), "expected to find one result for url")
 }
 
-func handleOfferNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
+func handleOfferNotFoundError(ctx context.Context, client *juju.Client, err error, st *tfsdk.State) diag.Diagnostics {
 	if isOfferNotFound(err) {
 		// Offer manually removed
-		st.RemoveResource(ctx)
-		return diag.Diagnostics{}
+		return removeMissingResource(ctx, client, st, "offer", err)
 	}
 
 	var diags diag.Diagnostics
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -54,6 +55,123 @@ func TestAcc_ResourceOffer(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceOffer_Force(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-offer")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOfferForce(modelName, false),
+				Check:  resource.TestCheckResourceAttr("juju_offer.this", "force", "false"),
+			},
+			{
+				// force is only used on destroy, the offer is
+				// updated in place.
+				Config: testAccResourceOfferForce(modelName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "force", "true"),
+					resource.TestCheckResourceAttr("juju_offer.this", "id", fmt.Sprintf("admin/%s.this", modelName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceOfferForce(modelName string, force bool) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "this" {
+	model = juju_model.this.name
+	name  = "this"
+
+	charm {
+		name = "postgresql"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "this" {
+	model            = juju_model.this.name
+	application_name = juju_application.this.name
+	endpoints        = ["db"]
+	force            = %t
+}
+`, modelName, force)
+}
+
+func TestAcc_ResourceOffer_MultipleEndpoints(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-offer")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOfferEndpoints(modelName, "db", "db-admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoints.#", "2"),
+					resource.TestCheckTypeSetElemAttr("juju_offer.this", "endpoints.*", "db"),
+					resource.TestCheckTypeSetElemAttr("juju_offer.this", "endpoints.*", "db-admin"),
+					resource.TestCheckNoResourceAttr("juju_offer.this", "endpoint"),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoint_urls.db", fmt.Sprintf("admin/%s.this:db", modelName)),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoint_urls.db-admin", fmt.Sprintf("admin/%s.this:db-admin", modelName)),
+					resource.TestCheckResourceAttr("juju_offer.this", "connection_count", "0"),
+					resource.TestCheckResourceAttr("juju_offer.this", "consumers.#", "0"),
+					resource.TestCheckResourceAttr("juju_offer.this", "users.admin", "admin"),
+					resource.TestCheckResourceAttr("juju_offer.this", "force", "false"),
+					resource.TestCheckResourceAttr("data.juju_offer.this", "endpoints.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceOfferEndpoints(modelName, "db"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoints.#", "1"),
+					resource.TestCheckResourceAttr("juju_offer.this", "endpoint", "db"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceOfferEndpoints(modelName string, endpoints ...string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "this" {
+	model = juju_model.this.name
+	name  = "this"
+
+	charm {
+		name = "postgresql"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "this" {
+	model            = juju_model.this.name
+	application_name = juju_application.this.name
+	endpoints        = [%s]
+}
+
+data "juju_offer" "this" {
+	url = juju_offer.this.url
+}
+`, modelName, `"`+strings.Join(endpoints, `", "`)+`"`)
+}
+
 func testAccResourceOfferXIntegration(srcModelName string, destModelName string) string {
 	return fmt.Sprintf(`
 resource "juju_model" "modelone" {
@@ -0,0 +1,327 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &secretAccessResource{}
+var _ resource.ResourceWithConfigure = &secretAccessResource{}
+var _ resource.ResourceWithImportState = &secretAccessResource{}
+var _ resource.ResourceWithModifyPlan = &secretAccessResource{}
+
+func NewSecretAccessResource() resource.Resource {
+	return &secretAccessResource{}
+}
+
+type secretAccessResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type secretAccessResourceModel struct {
+	ModelName    types.String `tfsdk:"model"`
+	SecretId     types.String `tfsdk:"secret_id"`
+	Applications types.Set    `tfsdk:"applications"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (s *secretAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_access"
+}
+
+func (s *secretAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the access of applications to a user secret, as " +
+			"`juju grant-secret` and `juju revoke-secret` do. The controller does not report the " +
+			"applications granted access to a secret, grants made out of band are not detected.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model the secret belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "The URI of the secret, e.g. `secret:coj8mulh8b41e8nv6p90`, or its ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"applications": schema.SetAttribute{
+				Description: "The applications to grant access to the secret.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(applicationNameValidator()),
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *secretAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	s.client = client
+	s.subCtx = tflog.NewSubsystem(ctx, LogResourceSecretAccess)
+}
+
+// ModifyPlan fails the plan when the controller does not support
+// user secrets.
+func (s *secretAccessResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(checkJujuVersion(s.client, "User secrets", userSecretsJujuVersion)...)
+}
+
+// ImportState reads the model, secret and applications from an ID of
+// the form <model>:<secret_id>:<app1,app2>.
+func (s *secretAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	modelName, secretId, applications, ok := secretAccessDataFromID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"ImportState Failure",
+			fmt.Sprintf("Malformed SecretAccess ID %q, please use format '<model>:<secret_id>:<app1,app2>'", req.ID),
+		)
+		return
+	}
+	applicationsValue, errDiag := types.SetValueFrom(ctx, types.StringType, applications)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model"), modelName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret_id"), secretId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("applications"), applicationsValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (s *secretAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if s.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret access", "create")
+		return
+	}
+
+	var plan secretAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var applications []string
+	resp.Diagnostics.Append(plan.Applications.ElementsAs(ctx, &applications, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.client.Secrets.GrantSecretAccess(juju.GrantSecretAccessInput{
+		ModelName:    plan.ModelName.ValueString(),
+		SecretId:     plan.SecretId.ValueString(),
+		Applications: applications,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret access resource, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(newSecretAccessIDFrom(plan.ModelName.ValueString(), plan.SecretId.ValueString(), applications))
+	s.trace(fmt.Sprintf("secret access created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read only checks the secret still exists, the applications granted
+// access to it cannot be read back from the controller.
+func (s *secretAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if s.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret access", "read")
+		return
+	}
+
+	if s.client.Offline {
+		return
+	}
+
+	var state secretAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.client.Secrets.ReadSecret(juju.ReadSecretInput{
+		ModelName: state.ModelName.ValueString(),
+		SecretId:  state.SecretId.ValueString(),
+	})
+	if errors.Is(err, errors.NotFound) {
+		// Secret manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret access resource, got error: %s", err))
+		return
+	}
+	s.trace(fmt.Sprintf("read secret access: %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (s *secretAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if s.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret access", "update")
+		return
+	}
+
+	var plan, state secretAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planApplications, stateApplications []string
+	resp.Diagnostics.Append(plan.Applications.ElementsAs(ctx, &planApplications, false)...)
+	resp.Diagnostics.Append(state.Applications.ElementsAs(ctx, &stateApplications, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if revoked := getMissingUsers(stateApplications, planApplications); len(revoked) > 0 {
+		err := s.client.Secrets.RevokeSecretAccess(juju.RevokeSecretAccessInput{
+			ModelName:    plan.ModelName.ValueString(),
+			SecretId:     plan.SecretId.ValueString(),
+			Applications: revoked,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret access resource, got error: %s", err))
+			return
+		}
+	}
+
+	if granted := getMissingUsers(planApplications, stateApplications); len(granted) > 0 {
+		err := s.client.Secrets.GrantSecretAccess(juju.GrantSecretAccessInput{
+			ModelName:    plan.ModelName.ValueString(),
+			SecretId:     plan.SecretId.ValueString(),
+			Applications: granted,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret access resource, got error: %s", err))
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(newSecretAccessIDFrom(plan.ModelName.ValueString(), plan.SecretId.ValueString(), planApplications))
+	s.trace(fmt.Sprintf("secret access updated: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (s *secretAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if s.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret access", "delete")
+		return
+	}
+
+	var state secretAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var applications []string
+	resp.Diagnostics.Append(state.Applications.ElementsAs(ctx, &applications, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.client.Secrets.RevokeSecretAccess(juju.RevokeSecretAccessInput{
+		ModelName:    state.ModelName.ValueString(),
+		SecretId:     state.SecretId.ValueString(),
+		Applications: applications,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret access resource, got error: %s", err))
+		return
+	}
+	s.trace(fmt.Sprintf("secret access deleted: %q", state.ID.ValueString()))
+}
+
+func (s *secretAccessResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if s.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(s.subCtx, LogResourceSecretAccess, msg, additionalFields...)
+}
+
+func newSecretAccessIDFrom(modelName, secretId string, applications []string) string {
+	sorted := append([]string(nil), applications...)
+	sort.Strings(sorted)
+	return fmt.Sprintf("%s:%s:%s", modelName, secretId, strings.Join(sorted, ","))
+}
+
+// secretAccessDataFromID splits an ID of the form
+// <model>:<secret_id>:<app1,app2>, the secret URI holding a colon.
+func secretAccessDataFromID(id string) (string, string, []string, bool) {
+	modelName, rest, ok := strings.Cut(id, ":")
+	if !ok || modelName == "" {
+		return "", "", nil, false
+	}
+	secretId, applicationsStr, ok := cutLast(rest, ":")
+	if !ok || secretId == "" || applicationsStr == "" {
+		return "", "", nil, false
+	}
+	return modelName, secretId, strings.Split(applicationsStr, ","), true
+}
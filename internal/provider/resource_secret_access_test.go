@@ -0,0 +1,116 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apisecrets "github.com/juju/juju/api/client/secrets"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func TestAcc_ResourceSecretAccess(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-secretaccess")
+
+	// User secrets cannot be added with the provider, the model and
+	// the secret are added out of band.
+	secretURI := testAccCreateModelWithSecret(t, modelName)
+
+	resourceName := "juju_secret_access.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecretAccess(modelName, secretURI, "juju_application.one.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_id", secretURI),
+					resource.TestCheckResourceAttr(resourceName, "applications.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applications.*", "one"),
+				),
+			},
+			{
+				Config: testAccResourceSecretAccess(modelName, secretURI, "juju_application.one.name, juju_application.two.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "applications.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:%s:one,two", modelName, secretURI)),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s:one,two", modelName, secretURI),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+// testAccCreateModelWithSecret adds a model holding a user secret,
+// before resource.Test runs. It skips the test unless acceptance tests
+// are enabled, as resource.Test does.
+func testAccCreateModelWithSecret(t *testing.T, modelName string) string {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	testAccPreCheck(t)
+
+	model, err := TestClient.Models.CreateModel(juju.CreateModelInput{
+		Name:      modelName,
+		CloudName: testingCloud.CloudName(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = TestClient.Models.DestroyModel(juju.DestroyModelInput{UUID: model.UUID})
+	})
+
+	conn, err := TestClient.Models.GetConnection(&modelName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	secretURI, err := apisecrets.NewClient(conn).CreateSecret("tls", "", map[string]string{"cert": "Y2VydA=="})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return secretURI
+}
+
+func testAccResourceSecretAccess(modelName, secretURI, applications string) string {
+	return fmt.Sprintf(`
+resource "juju_application" "one" {
+  model = %[1]q
+  name  = "one"
+
+  charm {
+    name = "jameinel-ubuntu-lite"
+  }
+}
+
+resource "juju_application" "two" {
+  model = %[1]q
+  name  = "two"
+
+  charm {
+    name = "jameinel-ubuntu-lite"
+  }
+}
+
+resource "juju_secret_access" "test" {
+  model        = %[1]q
+  secret_id    = %[2]q
+  applications = [%[3]s]
+}`, modelName, secretURI, applications)
+}
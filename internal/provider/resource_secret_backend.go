@@ -0,0 +1,351 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &secretBackendResource{}
+var _ resource.ResourceWithConfigure = &secretBackendResource{}
+var _ resource.ResourceWithImportState = &secretBackendResource{}
+var _ resource.ResourceWithModifyPlan = &secretBackendResource{}
+
+func NewSecretBackendResource() resource.Resource {
+	return &secretBackendResource{}
+}
+
+type secretBackendResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type secretBackendResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	BackendType         types.String `tfsdk:"backend_type"`
+	Config              types.Map    `tfsdk:"config"`
+	TokenRotateInterval types.String `tfsdk:"token_rotate_interval"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *secretBackendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_backend"
+}
+
+func (r *secretBackendResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents an external secret backend of the controller, as " +
+			"`juju add-secret-backend` adds it. Models use the backend with the secret_backend " +
+			"attribute of juju_model.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the secret backend.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backend_type": schema.StringAttribute{
+				Description: "The type of the secret backend.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("vault", "kubernetes"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "The configuration of the secret backend, e.g. `endpoint`, `token` and " +
+					"`ca-cert` for vault. Removed keys are reset to their default.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token_rotate_interval": schema.StringAttribute{
+				Description: "How often the token of the secret backend is rotated, e.g. `24h`. " +
+					"The token is not rotated if unset.",
+				Optional: true,
+				Validators: []validator.String{
+					stringIsDurationValidator{},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *secretBackendResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceSecretBackend)
+}
+
+// ModifyPlan fails the plan when the controller does not support
+// secret backends.
+func (r *secretBackendResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(checkJujuVersion(r.client, "Secret backends", secretBackendsJujuVersion)...)
+}
+
+// ImportState imports a secret backend by its name, all of its
+// configuration is read back.
+func (r *secretBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *secretBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret_backend", "create")
+		return
+	}
+
+	var plan secretBackendResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := map[string]string{}
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &config, false)...)
+	interval, dErr := tokenRotateInterval(plan.TokenRotateInterval)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SecretBackends.CreateSecretBackend(juju.CreateSecretBackendInput{
+		Name:                plan.Name.ValueString(),
+		BackendType:         plan.BackendType.ValueString(),
+		TokenRotateInterval: interval,
+		Config:              config,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret backend, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Name.ValueString())
+	r.trace(fmt.Sprintf("secret backend created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read only reads back the configuration keys of the state, the
+// controller reports the defaults of the backend too. All of the keys
+// are read on import.
+func (r *secretBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret_backend", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state secretBackendResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.SecretBackends.ReadSecretBackend(juju.ReadSecretBackendInput{
+		Name: state.ID.ValueString(),
+	})
+	if errors.Is(err, errors.NotFound) {
+		// Secret backend manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret backend, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read secret backend: %q", state.ID.ValueString()))
+
+	config := response.Config
+	if !state.Name.IsNull() {
+		stateConfig := map[string]string{}
+		resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config = map[string]string{}
+		for key := range stateConfig {
+			if value, ok := response.Config[key]; ok {
+				config[key] = value
+			}
+		}
+	}
+	if len(config) > 0 || !state.Config.IsNull() {
+		configValue, dErr := types.MapValueFrom(ctx, types.StringType, config)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Config = configValue
+	}
+
+	// Keep the interval as written in the state when it is the same
+	// duration, e.g. 24h is reported as 24h0m0s.
+	interval, dErr := tokenRotateInterval(state.TokenRotateInterval)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if response.TokenRotateInterval == nil || *response.TokenRotateInterval == 0 {
+		state.TokenRotateInterval = types.StringNull()
+	} else if interval == nil || *interval != *response.TokenRotateInterval {
+		state.TokenRotateInterval = types.StringValue(response.TokenRotateInterval.String())
+	}
+
+	state.Name = types.StringValue(response.Name)
+	state.BackendType = types.StringValue(response.BackendType)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *secretBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret_backend", "update")
+		return
+	}
+
+	var plan, state secretBackendResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planConfig, stateConfig := map[string]string{}, map[string]string{}
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &planConfig, false)...)
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	interval, dErr := tokenRotateInterval(plan.TokenRotateInterval)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := map[string]string{}
+	for key, value := range planConfig {
+		if stateValue, ok := stateConfig[key]; !ok || stateValue != value {
+			changed[key] = value
+		}
+	}
+	var reset []string
+	for key := range stateConfig {
+		if _, ok := planConfig[key]; !ok {
+			reset = append(reset, key)
+		}
+	}
+	if plan.TokenRotateInterval.IsNull() && !state.TokenRotateInterval.IsNull() {
+		// A zero interval stops the rotation of the token.
+		zero := time.Duration(0)
+		interval = &zero
+	}
+
+	if err := r.client.SecretBackends.UpdateSecretBackend(juju.UpdateSecretBackendInput{
+		Name:                plan.ID.ValueString(),
+		TokenRotateInterval: interval,
+		Config:              changed,
+		Reset:               reset,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret backend, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("secret backend updated: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the secret backend from the controller. It fails while
+// the backend holds secrets or models use it.
+func (r *secretBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret_backend", "delete")
+		return
+	}
+
+	var state secretBackendResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SecretBackends.DestroySecretBackend(juju.DestroySecretBackendInput{
+		Name: state.ID.ValueString(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret backend, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("secret backend deleted: %q", state.ID.ValueString()))
+}
+
+func (r *secretBackendResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceSecretBackend, msg, additionalFields...)
+}
+
+// tokenRotateInterval parses the token rotate interval, nil if unset.
+func tokenRotateInterval(value types.String) (*time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+	interval, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddError("Provider Error", fmt.Sprintf("Unable to parse token rotate interval, got error: %s", err))
+		return nil, diags
+	}
+	return &interval, diags
+}
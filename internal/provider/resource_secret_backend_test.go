@@ -0,0 +1,69 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceSecretBackend(t *testing.T) {
+	vaultAddr, vaultToken := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if vaultAddr == "" || vaultToken == "" {
+		t.Skip(t.Name() + " requires VAULT_ADDR and VAULT_TOKEN to be set")
+	}
+	backendName := acctest.RandomWithPrefix("tf-test-vault")
+
+	resourceName := "juju_secret_backend.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecretBackend(backendName, vaultAddr, vaultToken, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", backendName),
+					resource.TestCheckResourceAttr(resourceName, "backend_type", "vault"),
+					resource.TestCheckResourceAttr(resourceName, "config.endpoint", vaultAddr),
+					resource.TestCheckNoResourceAttr(resourceName, "token_rotate_interval"),
+				),
+			},
+			{
+				Config: testAccResourceSecretBackend(backendName, vaultAddr, vaultToken, "24h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "token_rotate_interval", "24h"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     backendName,
+				ResourceName:      resourceName,
+				// All of the configuration is read back on import.
+				ImportStateVerifyIgnore: []string{"config", "token_rotate_interval"},
+			},
+		},
+	})
+}
+
+func testAccResourceSecretBackend(name, vaultAddr, vaultToken, tokenRotateInterval string) string {
+	interval := ""
+	if tokenRotateInterval != "" {
+		interval = fmt.Sprintf("token_rotate_interval = %q", tokenRotateInterval)
+	}
+	return fmt.Sprintf(`
+resource "juju_secret_backend" "test" {
+  name         = %q
+  backend_type = "vault"
+  config = {
+    endpoint = %q
+    token    = %q
+  }
+  %s
+}`, name, vaultAddr, vaultToken, interval)
+}
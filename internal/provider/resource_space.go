@@ -0,0 +1,266 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &spaceResource{}
+var _ resource.ResourceWithConfigure = &spaceResource{}
+var _ resource.ResourceWithImportState = &spaceResource{}
+
+func NewSpaceResource() resource.Resource {
+	return &spaceResource{}
+}
+
+type spaceResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type spaceResourceModel struct {
+	ModelName types.String `tfsdk:"model"`
+	Name      types.String `tfsdk:"name"`
+	Subnets   types.Set    `tfsdk:"subnets"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *spaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space"
+}
+
+func (r *spaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a network space of a model. Subnets are moved to the space " +
+			"with juju_subnet resources.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model the space belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the space.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					spaceNameValidator(),
+				},
+			},
+			"subnets": schema.SetAttribute{
+				Description: "The CIDRs of the subnets in the space.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *spaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceSpace)
+}
+
+// ImportState is called when the provider must import the state of a
+// resource instance. The ID holds the model and the name of the space,
+// read back by Read.
+func (r *spaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "create")
+		return
+	}
+
+	var plan spaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := plan.ModelName.ValueString()
+	name := plan.Name.ValueString()
+	if err := r.client.Spaces.CreateSpace(juju.CreateSpaceInput{
+		ModelName: modelName,
+		Name:      name,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create space, got error: %s", err))
+		return
+	}
+
+	plan.Subnets = types.SetValueMust(types.StringType, nil)
+	plan.ID = types.StringValue(newSpaceID(modelName, name))
+	r.trace(fmt.Sprintf("space created: %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state spaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName, name := modelAndNameFromSpaceID(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Spaces.ReadSpace(juju.ReadSpaceInput{
+		ModelName: modelName,
+		Name:      name,
+	})
+	if errors.Is(err, errors.NotFound) {
+		// Space manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read space: %q", state.ID.ValueString()))
+
+	subnets, dErr := types.SetValueFrom(ctx, types.StringType, response.Subnets)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ModelName = types.StringValue(modelName)
+	state.Name = types.StringValue(response.Name)
+	state.Subnets = subnets
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, all of the configurable attributes require
+// the resource to be replaced.
+func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan spaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the space from the model, its subnets are moved back to
+// the alpha space. It fails while applications are bound to the space or
+// constraints refer to it.
+func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "delete")
+		return
+	}
+
+	var state spaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName, name := modelAndNameFromSpaceID(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Spaces.DestroySpace(juju.DestroySpaceInput{
+		ModelName: modelName,
+		Name:      name,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("space deleted: %q", state.ID.ValueString()))
+}
+
+func (r *spaceResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceSpace, msg, additionalFields...)
+}
+
+func newSpaceID(modelName, name string) string {
+	return fmt.Sprintf("%s:%s", modelName, name)
+}
+
+// Spaces can be imported using the format: `model_name:space_name`.
+func modelAndNameFromSpaceID(value string, diags *diag.Diagnostics) (string, string) {
+	id := strings.Split(value, ":")
+	//If importing with an incorrect ID we need to catch and provide a user-friendly error
+	if len(id) != 2 {
+		diags.AddError("Malformed ID", fmt.Sprintf("unable to parse model name and space name from provided ID: %q", value))
+		return "", ""
+	}
+	return id[0], id[1]
+}
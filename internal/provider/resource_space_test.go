@@ -0,0 +1,55 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceSpace(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-space")
+	spaceName := acctest.RandomWithPrefix("space")
+
+	resourceName := "juju_space.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSpace(modelName, spaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model", modelName),
+					resource.TestCheckResourceAttr(resourceName, "name", spaceName),
+					resource.TestCheckResourceAttr(resourceName, "subnets.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:%s", modelName, spaceName)),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s", modelName, spaceName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceSpace(modelName, spaceName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_space" "test" {
+  model = juju_model.test.name
+  name  = %q
+}`, modelName, spaceName)
+}
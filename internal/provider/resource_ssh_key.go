@@ -14,8 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 	"github.com/juju/terraform-provider-juju/internal/utils"
@@ -38,8 +40,9 @@ type sshKeyResource struct {
 }
 
 type sshKeyResourceModel struct {
-	ModelName types.String `tfsdk:"model"`
-	Payload   types.String `tfsdk:"payload"`
+	ModelName   types.String `tfsdk:"model"`
+	Payload     types.String `tfsdk:"payload"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -73,11 +76,17 @@ func (s *sshKeyResource) Metadata(_ context.Context, req resource.MetadataReques
 
 func (s *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Resource representing an SSH key.",
+		Description: "Resource representing an SSH key authorized on a model, identified by its fingerprint.",
 		Attributes: map[string]schema.Attribute{
 			"model": schema.StringAttribute{
-				Description: "The name of the model to operate in.",
+				Description: "The name or UUID of the model to operate in.",
 				Required:    true,
+				Validators: []validator.String{
+					modelValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"payload": schema.StringAttribute{
 				Description: "SSH key payload.",
@@ -87,6 +96,13 @@ func (s *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"fingerprint": schema.StringAttribute{
+				Description: "The fingerprint of the SSH key, as listed by `juju ssh-keys`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -113,9 +129,9 @@ func (s *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	payload := plan.Payload.ValueString()
-	keyIdentifier := utils.GetKeyIdentifierFromSSHKey(payload)
-	if keyIdentifier == "" {
-		resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("malformed SSH key : %q", payload))
+	fingerprint, err := utils.GetKeyFingerprintFromSSHKey(payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Provider Error", fmt.Sprintf("malformed SSH key : %q, got error: %s", payload, err))
 		return
 	}
 
@@ -128,9 +144,10 @@ func (s *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ssh_key, got error %s", err))
 		return
 	}
-	s.trace(fmt.Sprintf("created ssh_key for: %q", keyIdentifier))
+	s.trace(fmt.Sprintf("created ssh_key for: %q", fingerprint))
 
-	plan.ID = types.StringValue(newSSHKeyID(modelName, keyIdentifier))
+	plan.Fingerprint = types.StringValue(fingerprint)
+	plan.ID = types.StringValue(newSSHKeyID(modelName, fingerprint))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -138,12 +155,13 @@ func newSSHKeyID(modelName string, keyIdentifier string) string {
 	return fmt.Sprintf("sshkey:%s:%s", modelName, keyIdentifier)
 }
 
-// Keys can be imported with the name of the model and the identifier of the key
-// ssh_key:<modelName>:<ssh-key-identifier>
-// the key identifier is currently based on the comment section of the ssh key
-// (e.g. user@hostname) (TODO: issue #267)
+// Keys can be imported with the name of the model and the fingerprint of the key
+// sshkey:<modelName>:<ssh-key-fingerprint>
+// the fingerprint holds colons, so only the first two separators are split.
+// IDs holding the comment section of the key (e.g. user@hostname) instead of
+// the fingerprint are still accepted, and are replaced once read.
 func retrieveModelKeyNameFromID(id string, d *diag.Diagnostics) (string, string) {
-	tokens := strings.Split(id, ":")
+	tokens := strings.SplitN(id, ":", 3)
 	//If importing with an incorrect ID we need to catch and provide a user-friendly error
 	if len(tokens) != 3 {
 		d.AddError("Malformed ID", fmt.Sprintf("unable to parse model name and user from provided ID: %q", id))
@@ -159,6 +177,10 @@ func (s *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	if s.client.Offline {
+		return
+	}
+
 	var plan sshKeyResourceModel
 
 	// Read Terraform configuration from the request into the model
@@ -176,7 +198,11 @@ func (s *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		ModelName:     modelName,
 		KeyIdentifier: keyIdentifier,
 	})
-	if err != nil {
+	if errors.Is(err, errors.NotFound) {
+		// Key manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ssh key, got error: %s", err))
 		return
 	}
@@ -184,62 +210,22 @@ func (s *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	plan.ModelName = types.StringValue(result.ModelName)
 	plan.Payload = types.StringValue(result.Payload)
+	plan.Fingerprint = types.StringValue(result.Fingerprint)
+	plan.ID = types.StringValue(newSSHKeyID(modelName, result.Fingerprint))
 
 	// Set the plan onto the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (s *sshKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Prevent panic if the provider has not been configured.
-	if s.client == nil {
-		addClientNotConfiguredError(&resp.Diagnostics, "ssh_key", "update")
-		return
-	}
-
-	var plan, state sshKeyResourceModel
-
-	// Get the Terraform state from the request into the state model
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	// Read Terraform configuration from the request into the plan model
+	// Changing the model or the payload requires replacement, the id
+	// holds both the model and the fingerprint of the key. There is
+	// nothing to update in place.
+	var plan sshKeyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// Return early if nothing has changed
-	if plan.Payload.Equal(state.Payload) && plan.ModelName.Equal(state.ModelName) {
-		return
-	}
-
-	modelName, keyIdentifier := retrieveModelKeyNameFromID(plan.ID.ValueString(), &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Delete the key
-	if err := s.client.SSHKeys.DeleteSSHKey(&juju.DeleteSSHKeyInput{
-		ModelName:     modelName,
-		KeyIdentifier: keyIdentifier,
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ssh key for updating, got error: %s", err))
-		return
-	}
-	s.trace(fmt.Sprintf("ssh key deleted : %q", state.ID.ValueString()))
-
-	// Create a new key
-	if err := s.client.SSHKeys.CreateSSHKey(&juju.CreateSSHKeyInput{
-		ModelName: plan.ModelName.ValueString(),
-		Payload:   plan.Payload.ValueString(),
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ssh key for updating, got error: %s", err))
-		return
-	}
-	s.trace(fmt.Sprintf("ssh key created : %q", plan.ID.ValueString()))
-
-	// Set the plan onto the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/juju/terraform-provider-juju/internal/utils"
 )
 
 func TestAcc_ResourceSSHKey(t *testing.T) {
@@ -62,6 +64,38 @@ func TestAcc_ResourceSSHKey_ED25519(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceSSHKey_ImportByFingerprint(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-sshkey")
+	// The key has no comment, so it can only be identified by its fingerprint.
+	sshKey1 := `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAID3gjJTJtYZU55HTUr+hu0JF9p152yiC9czJi9nKojuW`
+	fingerprint, err := utils.GetKeyFingerprintFromSSHKey(sshKey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSSHKey(modelName, sshKey1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_ssh_key.this", "fingerprint", fingerprint),
+					resource.TestCheckResourceAttr("juju_ssh_key.this", "id", fmt.Sprintf("sshkey:%s:%s", modelName, fingerprint))),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("sshkey:%s:%s", modelName, fingerprint),
+				ResourceName:      "juju_ssh_key.this",
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceSSHKey_UpgradeProvider(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
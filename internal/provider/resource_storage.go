@@ -0,0 +1,407 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &storageResource{}
+var _ resource.ResourceWithConfigure = &storageResource{}
+var _ resource.ResourceWithImportState = &storageResource{}
+
+func NewStorageResource() resource.Resource {
+	return &storageResource{}
+}
+
+type storageResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type storageResourceModel struct {
+	ModelName    types.String `tfsdk:"model"`
+	Name         types.String `tfsdk:"name"`
+	Unit         types.String `tfsdk:"unit"`
+	Attached     types.Bool   `tfsdk:"attached"`
+	Pool         types.String `tfsdk:"pool"`
+	Size         types.Int64  `tfsdk:"size"`
+	StorageID    types.String `tfsdk:"storage_id"`
+	Kind         types.String `tfsdk:"kind"`
+	Status       types.String `tfsdk:"status"`
+	Persistent   types.Bool   `tfsdk:"persistent"`
+	Location     types.String `tfsdk:"location"`
+	VolumeID     types.String `tfsdk:"volume_id"`
+	FilesystemID types.String `tfsdk:"filesystem_id"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *storageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage"
+}
+
+func (r *storageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a storage instance of a unit, as `juju add-storage` does. " +
+			"The storage can be detached from its unit, and attached to another unit, as " +
+			"`juju detach-storage` and `juju attach-storage` do. Destroying the resource destroys the storage.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the storage.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the storage of the charm, e.g. `data`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Description: "The unit the storage is added to, e.g. `postgresql/0`. Changing it detaches " +
+					"the storage and attaches it to the new unit.",
+				Required: true,
+			},
+			"attached": schema.BoolAttribute{
+				Description: "Whether the storage is attached to the unit. The storage is kept when it " +
+					"is detached, to be attached again later.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"pool": schema.StringAttribute{
+				Description: "The storage pool of the storage. Defaults to the pool of the storage " +
+					"constraints of the application.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the storage, in MiB. Defaults to the size of the storage " +
+					"constraints of the application.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"storage_id": schema.StringAttribute{
+				Description: "The ID of the storage, e.g. `data/0`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of the storage, block or filesystem.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the storage, e.g. attached or detached.",
+				Computed:    true,
+			},
+			"persistent": schema.BoolAttribute{
+				Description: "Whether the storage outlives the machine it is attached to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "Where the storage is mounted, or the device path of block storage.",
+				Computed:    true,
+			},
+			"volume_id": schema.StringAttribute{
+				Description: "The ID of the volume of the storage in the cloud.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filesystem_id": schema.StringAttribute{
+				Description: "The ID of the filesystem of the storage in the cloud.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *storageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceStorage)
+}
+
+// ImportState is called when the provider must import the state of a
+// resource instance. The ID is `model_name:storage_id`.
+func (r *storageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *storageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "storage", "create")
+		return
+	}
+
+	var plan storageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := plan.ModelName.ValueString()
+	response, err := r.client.Storage.CreateStorage(juju.CreateStorageInput{
+		ModelName: modelName,
+		Unit:      plan.Unit.ValueString(),
+		Name:      plan.Name.ValueString(),
+		Pool:      plan.Pool.ValueString(),
+		Size:      uint64(plan.Size.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add storage, got error: %s", err))
+		return
+	}
+	plan.ID = types.StringValue(newStorageID(modelName, response.StorageID))
+	r.trace(fmt.Sprintf("storage added: %q", plan.ID.ValueString()))
+
+	if !plan.Attached.ValueBool() {
+		if err := r.client.Storage.DetachStorage(ctx, juju.DetachStorageInput{
+			ModelName: modelName,
+			StorageID: response.StorageID,
+		}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach storage, got error: %s", err))
+		}
+	}
+
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage, got error: %s", err))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "storage", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state storageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName, storageID, err := modelAndStorageIDFromID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Malformed ID", err.Error())
+		return
+	}
+	state.ModelName = types.StringValue(modelName)
+	state.StorageID = types.StringValue(storageID)
+
+	if err := r.read(&state); errors.Is(err, errors.NotFound) {
+		// Storage manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read storage: %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update detaches the storage from its unit, and attaches it to the
+// unit of the plan when it is attached.
+func (r *storageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "storage", "update")
+		return
+	}
+
+	var plan, state storageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := state.ModelName.ValueString()
+	storageID := state.StorageID.ValueString()
+	wasAttached := state.Attached.ValueBool()
+	attach := plan.Attached.ValueBool()
+	if wasAttached && (!attach || !plan.Unit.Equal(state.Unit)) {
+		if err := r.client.Storage.DetachStorage(ctx, juju.DetachStorageInput{
+			ModelName: modelName,
+			StorageID: storageID,
+		}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach storage, got error: %s", err))
+			return
+		}
+		wasAttached = false
+		r.trace(fmt.Sprintf("storage detached: %q", state.ID.ValueString()))
+	}
+	if attach && !wasAttached {
+		if err := r.client.Storage.AttachStorage(juju.AttachStorageInput{
+			ModelName: modelName,
+			StorageID: storageID,
+			Unit:      plan.Unit.ValueString(),
+		}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach storage, got error: %s", err))
+			return
+		}
+		r.trace(fmt.Sprintf("storage attached: %q", state.ID.ValueString()))
+	}
+
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage, got error: %s", err))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete detaches the storage and destroys it.
+func (r *storageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "storage", "delete")
+		return
+	}
+
+	var state storageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Storage.DestroyStorage(juju.DestroyStorageInput{
+		ModelName: state.ModelName.ValueString(),
+		StorageID: state.StorageID.ValueString(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove storage, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("storage removed: %q", state.ID.ValueString()))
+}
+
+// read sets the computed attributes of the storage. The unit is only
+// set while the storage is attached, a detached storage keeps the unit
+// it is attached to again.
+func (r *storageResource) read(model *storageResourceModel) error {
+	_, storageID, err := modelAndStorageIDFromID(model.ID.ValueString())
+	if err != nil {
+		return err
+	}
+	response, err := r.client.Storage.ReadStorage(juju.ReadStorageInput{
+		ModelName: model.ModelName.ValueString(),
+		StorageID: storageID,
+	})
+	if err != nil {
+		return err
+	}
+
+	model.StorageID = types.StringValue(response.StorageID)
+	model.Name = types.StringValue(response.Name)
+	model.Attached = types.BoolValue(response.Unit != "")
+	if response.Unit != "" || model.Unit.IsNull() {
+		model.Unit = types.StringValue(response.Unit)
+	}
+	model.Pool = types.StringValue(response.Pool)
+	model.Size = types.Int64Value(int64(response.Size))
+	model.Kind = types.StringValue(response.Kind)
+	model.Status = types.StringValue(response.Status)
+	model.Persistent = types.BoolValue(response.Persistent)
+	model.Location = types.StringValue(response.Location)
+	model.VolumeID = types.StringValue(response.VolumeID)
+	model.FilesystemID = types.StringValue(response.FilesystemID)
+	return nil
+}
+
+func (r *storageResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceStorage, msg, additionalFields...)
+}
+
+func newStorageID(modelName, storageID string) string {
+	return fmt.Sprintf("%s:%s", modelName, storageID)
+}
+
+// Storage can be imported using the format: `model_name:storage_id`.
+func modelAndStorageIDFromID(value string) (string, string, error) {
+	id := strings.Split(value, ":")
+	//If importing with an incorrect ID we need to catch and provide a user-friendly error
+	if len(id) != 2 {
+		return "", "", fmt.Errorf("unable to parse model name and storage ID from provided ID: %q", value)
+	}
+	return id[0], id[1], nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceStorage(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-storage")
+
+	resourceName := "juju_storage.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStorage(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unit", "test-app/0"),
+					resource.TestCheckResourceAttr(resourceName, "attached", "true"),
+					resource.TestCheckResourceAttr(resourceName, "pool", "tmpfs"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "filesystem"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "filesystem_id"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceStorage(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_application" "test" {
+  model = juju_model.test.name
+  name  = "test-app"
+  units = 1
+
+  charm {
+    name = "ubuntu"
+  }
+}
+
+resource "juju_storage" "test" {
+  model = juju_model.test.name
+  name  = "files"
+  unit  = "${juju_application.test.name}/0"
+  pool  = "tmpfs"
+  size  = 1024
+}`, modelName)
+}
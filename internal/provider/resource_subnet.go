@@ -0,0 +1,324 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &subnetResource{}
+var _ resource.ResourceWithConfigure = &subnetResource{}
+var _ resource.ResourceWithImportState = &subnetResource{}
+
+func NewSubnetResource() resource.Resource {
+	return &subnetResource{}
+}
+
+type subnetResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type subnetResourceModel struct {
+	ModelName  types.String `tfsdk:"model"`
+	CIDR       types.String `tfsdk:"cidr"`
+	Space      types.String `tfsdk:"space"`
+	ProviderId types.String `tfsdk:"provider_id"`
+	Zones      types.Set    `tfsdk:"zones"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *subnetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnet"
+}
+
+func (r *subnetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the space of a subnet of a model, as `juju move-to-space` " +
+			"sets it. The subnet itself is discovered from the cloud provider, it is not created: destroying " +
+			"the resource moves the subnet back to the alpha space.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model the subnet is known to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					modelValidator(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				Description: "The CIDR of the subnet.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space": schema.StringAttribute{
+				Description: "The name of the space the subnet is moved to.",
+				Required:    true,
+				Validators: []validator.String{
+					spaceNameValidator(),
+				},
+			},
+			"provider_id": schema.StringAttribute{
+				Description: "The identifier of the subnet in the cloud provider.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zones": schema.SetAttribute{
+				Description: "The availability zones of the subnet.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *subnetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceSubnet)
+}
+
+// ImportState is called when the provider must import the state of a
+// resource instance. The ID holds the model and the CIDR of the subnet,
+// read back by Read.
+func (r *subnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *subnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "subnet", "create")
+		return
+	}
+
+	var plan subnetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := plan.ModelName.ValueString()
+	cidr := plan.CIDR.ValueString()
+	if err := r.client.Spaces.MoveSubnet(juju.MoveSubnetInput{
+		ModelName: modelName,
+		CIDR:      cidr,
+		Space:     plan.Space.ValueString(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move subnet, got error: %s", err))
+		return
+	}
+	plan.ID = types.StringValue(newSubnetID(modelName, cidr))
+	r.trace(fmt.Sprintf("subnet moved: %q", plan.ID.ValueString()))
+
+	found, diags := r.read(ctx, modelName, cidr, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subnet %q once moved", cidr))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *subnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "subnet", "read")
+		return
+	}
+
+	if r.client.Offline {
+		return
+	}
+
+	var state subnetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName, cidr := modelAndCIDRFromSubnetID(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, modelName, cidr, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		// Subnet no longer known to the model
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.trace(fmt.Sprintf("read subnet: %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read fills the resource model with the subnet as known to the model,
+// it reports whether the subnet was found.
+func (r *subnetResource) read(ctx context.Context, modelName, cidr string, data *subnetResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	response, err := r.client.Spaces.ReadSubnet(juju.ReadSubnetInput{
+		ModelName: modelName,
+		CIDR:      cidr,
+	})
+	if errors.Is(err, errors.NotFound) {
+		return false, diags
+	} else if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read subnet, got error: %s", err))
+		return false, diags
+	}
+
+	zones, dErr := types.SetValueFrom(ctx, types.StringType, response.Zones)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return false, diags
+	}
+	data.ModelName = types.StringValue(modelName)
+	data.CIDR = types.StringValue(response.CIDR)
+	data.Space = types.StringValue(response.Space)
+	data.ProviderId = types.StringValue(response.ProviderId)
+	data.Zones = zones
+	return true, diags
+}
+
+func (r *subnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "subnet", "update")
+		return
+	}
+
+	var plan, state subnetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Space.Equal(state.Space) {
+		if err := r.client.Spaces.MoveSubnet(juju.MoveSubnetInput{
+			ModelName: plan.ModelName.ValueString(),
+			CIDR:      plan.CIDR.ValueString(),
+			Space:     plan.Space.ValueString(),
+		}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move subnet, got error: %s", err))
+			return
+		}
+		r.trace(fmt.Sprintf("subnet moved: %q", plan.ID.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete moves the subnet back to the alpha space, the subnet itself
+// stays known to the model.
+func (r *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "subnet", "delete")
+		return
+	}
+
+	var state subnetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName, cidr := modelAndCIDRFromSubnetID(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Spaces.MoveSubnet(juju.MoveSubnetInput{
+		ModelName: modelName,
+		CIDR:      cidr,
+	})
+	if err != nil && !errors.Is(err, errors.NotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move subnet to the alpha space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("subnet moved to the alpha space: %q", state.ID.ValueString()))
+}
+
+func (r *subnetResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceSubnet, msg, additionalFields...)
+}
+
+func newSubnetID(modelName, cidr string) string {
+	return fmt.Sprintf("%s:%s", modelName, cidr)
+}
+
+// Subnets can be imported using the format: `model_name:cidr`. IPv6 CIDRs
+// hold colons, only the first one separates the model name.
+func modelAndCIDRFromSubnetID(value string, diags *diag.Diagnostics) (string, string) {
+	modelName, cidr, ok := strings.Cut(value, ":")
+	//If importing with an incorrect ID we need to catch and provide a user-friendly error
+	if !ok || modelName == "" || cidr == "" {
+		diags.AddError("Malformed ID", fmt.Sprintf("unable to parse model name and cidr from provided ID: %q", value))
+		return "", ""
+	}
+	return modelName, cidr
+}
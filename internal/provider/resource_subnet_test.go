@@ -0,0 +1,74 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceSubnet(t *testing.T) {
+	// The subnet is discovered from the cloud provider, it has to be
+	// known beforehand.
+	cidr := os.Getenv("TEST_SUBNET_CIDR")
+	if cidr == "" {
+		t.Skip(t.Name() + " requires TEST_SUBNET_CIDR to be set")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-subnet")
+
+	resourceName := "juju_subnet.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSubnet(modelName, cidr, "juju_space.one.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cidr", cidr),
+					resource.TestCheckResourceAttr(resourceName, "space", "one"),
+				),
+			},
+			{
+				Config: testAccResourceSubnet(modelName, cidr, "juju_space.two.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "space", "two"),
+					resource.TestCheckResourceAttr("juju_space.two", "subnets.#", "1"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s", modelName, cidr),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceSubnet(modelName, cidr, space string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "test" {
+  name = %q
+}
+
+resource "juju_space" "one" {
+  model = juju_model.test.name
+  name  = "one"
+}
+
+resource "juju_space" "two" {
+  model = juju_model.test.name
+  name  = "two"
+}
+
+resource "juju_subnet" "test" {
+  model = juju_model.test.name
+  cidr  = %q
+  space = %s
+}`, modelName, cidr, space)
+}
@@ -12,10 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -43,6 +46,7 @@ type userResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	DisplayName types.String `tfsdk:"display_name"`
 	Password    types.String `tfsdk:"password"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -59,26 +63,36 @@ func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 		// This description is used by the documentation generator and the language server.
 		Description: "A resource that represents a Juju User.",
 		Attributes: map[string]schema.Attribute{
-			// TODO hml 25-Jul-2023
-			// Name and Display Name should be ForceNew, the
-			// terraform method to say the items are immutable.
-			// Juju has no way to update a username today.
+			// Juju has no way to update a username or a display
+			// name today, changing them replaces the user.
 			"name": schema.StringAttribute{
 				Description: "The name to be assigned to the user",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					userNameValidator(),
+				},
 			},
 			"display_name": schema.StringAttribute{
 				Description: "The display name to be assigned to the user (optional)",
 				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"password": schema.StringAttribute{
 				Description: "The password to be assigned to the user",
 				Required:    true,
 				Sensitive:   true,
 			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the user is enabled. A disabled user cannot log in to the controller.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -138,6 +152,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Name:        data.Name.ValueString(),
 		DisplayName: data.DisplayName.ValueString(),
 		Password:    data.Password.ValueString(),
+		Disabled:    !data.Enabled.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user resource, got error: %s", err))
@@ -165,6 +180,10 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	if r.client.Offline {
+		return
+	}
+
 	var data userResourceModel
 
 	// Read Terraform prior state data into the model
@@ -181,11 +200,11 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 	response, err := r.client.Users.ReadUser(userName)
-	if err != nil {
-		// TODO (hmlanigan) 2023-06-14
-		// Add a user NotFound error type to the client.
-		// On read, if NotFound, remove the resource:
-		// resp.State.RemoveResource()
+	if errors.Is(err, errors.NotFound) {
+		// User manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user resource, got error: %s", err))
 		return
 	}
@@ -195,6 +214,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	plan := userResourceModel{
 		Name:     types.StringValue(response.UserInfo.Username),
 		Password: data.Password,
+		Enabled:  types.BoolValue(!response.UserInfo.Disabled),
 		ID:       types.StringValue(newIDFromUserName(response.UserInfo.Username)),
 	}
 	// Display name is optional, therefore if it doesn't exist in the plan,
@@ -231,22 +251,24 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	if data.Name.Equal(state.Name) || data.DisplayName.Equal(state.DisplayName) {
-		// This does violates terraform's declarative model. There is a
-		// todo to make both values ForceNew in the future.
-		resp.Diagnostics.AddWarning("Not Supported", "Unable to update name %q or display name %q")
-	}
-	if data.Password.Equal(state.Password) {
-		r.info(fmt.Sprintf("Password not different, no updates for user %q made", data.Name.ValueString()))
+	if data.Password.Equal(state.Password) && data.Enabled.Equal(state.Enabled) {
+		r.info(fmt.Sprintf("Password and enabled not different, no updates for user %q made", data.Name.ValueString()))
 		return
 	}
-	// Update user can only change the user's password. It is not currently
-	// possible to change the display name via terraform after the user is
-	// created. Nor is it possible to change an existing username.
-	if err := r.client.Users.UpdateUser(juju.UpdateUserInput{
-		Name:     data.Name.ValueString(),
-		Password: data.Password.ValueString(),
-	}); err != nil {
+	// Update user can only change the user's password and enable or
+	// disable the user. Changes of the name and display name replace
+	// the user.
+	input := juju.UpdateUserInput{
+		Name: data.Name.ValueString(),
+	}
+	if !data.Password.Equal(state.Password) {
+		input.Password = data.Password.ValueString()
+	}
+	if !data.Enabled.Equal(state.Enabled) {
+		disabled := !data.Enabled.ValueBool()
+		input.Disabled = &disabled
+	}
+	if err := r.client.Users.UpdateUser(input); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user resource, got error: %s", err))
 		return
 	}
@@ -258,6 +280,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Name:        types.StringValue(data.Name.ValueString()),
 		DisplayName: data.DisplayName,
 		Password:    types.StringValue(data.Password.ValueString()),
+		Enabled:     data.Enabled,
 		ID:          types.StringValue(newIDFromUserName(data.Name.ValueString())),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -48,6 +48,48 @@ resource "juju_user" "user" {
 }`, userName, userPassword)
 }
 
+func TestAcc_ResourceUser_Enabled(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+
+	resourceName := "juju_user.user"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserEnabled(userName, userPassword, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", userName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccResourceUserEnabled(userName, userPassword, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"password"},
+				ImportStateId:           fmt.Sprintf("user:%s", userName),
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceUserEnabled(userName, userPassword string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "juju_user" "user" {
+  name     = %q
+  password = %q
+  enabled  = %t
+}`, userName, userPassword, enabled)
+}
+
 func TestAcc_ResourceUser_UpgradeProvider(t *testing.T) {
 	userName := acctest.RandomWithPrefix("tfuser")
 	userPassword := acctest.RandomWithPrefix("tf-test-user")
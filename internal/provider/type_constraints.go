@@ -0,0 +1,513 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/constraints"
+)
+
+var (
+	_ basetypes.StringTypable                    = ConstraintsType{}
+	_ xattr.TypeWithValidate                     = ConstraintsType{}
+	_ basetypes.StringValuableWithSemanticEquals = ConstraintsValue{}
+)
+
+// ConstraintsType is a string type holding juju constraints. Values
+// are compared by their meaning rather than their representation,
+// e.g. "mem=16G cores=4" equals "cores=4 mem=16384M".
+type ConstraintsType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ConstraintsType) String() string {
+	return "ConstraintsType"
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ConstraintsType) Equal(o attr.Type) bool {
+	other, ok := o.(ConstraintsType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueType returns the Value type.
+func (t ConstraintsType) ValueType(_ context.Context) attr.Value {
+	return ConstraintsValue{}
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ConstraintsType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ConstraintsValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t ConstraintsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// conflictingConstraints holds the constraints the instance type
+// already sets, which most clouds reject together with instance-type.
+// The arch and cpu-power constraints are left out, clouds such as EC2
+// and OpenStack accept them alongside an instance type.
+var conflictingConstraints = []string{
+	constraints.Cores,
+	constraints.Mem,
+}
+
+// Validate returns an error when the value is not valid juju
+// constraints, e.g. when it holds negative values, and a warning when
+// it holds both instance-type and constraints the instance type sets.
+func (t ConstraintsType) Validate(ctx context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if in.IsNull() || !in.IsKnown() {
+		return diags
+	}
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(valuePath, "Constraints Type Validation Error",
+			fmt.Sprintf("Unable to convert the value to a string, got error: %s", err))
+		return diags
+	}
+	parsed, err := constraints.Parse(value)
+	if err != nil {
+		diags.AddAttributeError(valuePath, "Invalid Constraints",
+			fmt.Sprintf("Unable to parse constraints %q, got error: %s", value, err))
+		return diags
+	}
+	if parsed.HasInstanceType() {
+		var conflicts []string
+		for _, name := range conflictingConstraints {
+			if hasConstraint(parsed, name) {
+				conflicts = append(conflicts, name)
+			}
+		}
+		if len(conflicts) > 0 {
+			diags.AddAttributeWarning(valuePath, "Conflicting Constraints",
+				fmt.Sprintf("Constraints %q conflict with %q, the instance type sets them; the cloud may reject them.",
+					strings.Join(conflicts, ", "), constraints.InstanceType))
+		}
+	}
+	return diags
+}
+
+// hasConstraint returns true if the constraint of the given name is
+// set in the given constraints.
+func hasConstraint(value constraints.Value, name string) bool {
+	switch name {
+	case constraints.Arch:
+		return value.HasArch()
+	case constraints.Cores:
+		return value.HasCpuCores()
+	case constraints.CpuPower:
+		return value.HasCpuPower()
+	case constraints.Mem:
+		return value.HasMem()
+	case constraints.VirtType:
+		return value.HasVirtType()
+	case constraints.Container:
+		return value.HasContainer()
+	case constraints.InstanceType:
+		return value.HasInstanceType()
+	case constraints.Spaces:
+		return value.HasSpaces()
+	case constraints.AllocatePublicIP:
+		return value.HasAllocatePublicIP()
+	case constraints.ImageID:
+		return value.HasImageID()
+	}
+	return false
+}
+
+// unsupportedKubernetesConstraints holds the constraints Kubernetes
+// models ignore, as the Kubernetes provider of juju does.
+var unsupportedKubernetesConstraints = []string{
+	constraints.Cores,
+	constraints.VirtType,
+	constraints.Container,
+	constraints.InstanceType,
+	constraints.Spaces,
+	constraints.AllocatePublicIP,
+	constraints.ImageID,
+}
+
+// ConstraintsValue is the value of a ConstraintsType.
+type ConstraintsValue struct {
+	basetypes.StringValue
+}
+
+// NewConstraintsValue returns a known ConstraintsValue holding the
+// normalized representation of the given constraints.
+func NewConstraintsValue(value constraints.Value) ConstraintsValue {
+	return ConstraintsValue{StringValue: basetypes.NewStringValue(value.String())}
+}
+
+// Type returns a ConstraintsType.
+func (v ConstraintsValue) Type(_ context.Context) attr.Type {
+	return ConstraintsType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ConstraintsValue) Equal(o attr.Value) bool {
+	other, ok := o.(ConstraintsValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values parse to the
+// same constraints, regardless of their ordering and units. Zones,
+// tags and spaces are compared as sets, e.g. "zones=a,b" equals
+// "zones=b,a".
+func (v ConstraintsValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ConstraintsValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldConstraints, err := constraints.Parse(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newConstraints, err := constraints.Parse(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return normalizedConstraints(oldConstraints) == normalizedConstraints(newConstraints), diags
+}
+
+// UnsupportedOnKubernetes returns the names of the constraints of the
+// value which Kubernetes models ignore.
+func (v ConstraintsValue) UnsupportedOnKubernetes() []string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	parsed, err := constraints.Parse(v.ValueString())
+	if err != nil {
+		return nil
+	}
+	var unsupported []string
+	for _, name := range unsupportedKubernetesConstraints {
+		if hasConstraint(parsed, name) {
+			unsupported = append(unsupported, name)
+		}
+	}
+	return unsupported
+}
+
+// normalizedConstraints returns the representation of the given
+// constraints with the values of the list constraints sorted.
+func normalizedConstraints(value constraints.Value) string {
+	for _, list := range []*[]string{value.Zones, value.Tags, value.Spaces} {
+		if list == nil {
+			continue
+		}
+		sorted := append([]string(nil), *list...)
+		sort.Strings(sorted)
+		*list = sorted
+	}
+	return value.String()
+}
+
+// constraintsMapAttribute returns the constraints_map attribute of a
+// resource, the structured alternative of its constraints attribute.
+// Memory and disk sizes are in MiB.
+func constraintsMapAttribute(description string, planModifiers ...planmodifier.Object) schema.SingleNestedAttribute {
+	sizeValidators := []validator.Int64{int64validator.AtLeast(0)}
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"allocate_public_ip": schema.BoolAttribute{
+				Description: "Whether the machines get a public IP address.",
+				Optional:    true,
+			},
+			"arch": schema.StringAttribute{
+				Description: "The architecture of the machines.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(arch.AllSupportedArches...)},
+			},
+			"container": schema.StringAttribute{
+				Description: "The type of container the machines are, e.g. `lxd`.",
+				Optional:    true,
+			},
+			"cores": schema.Int64Attribute{
+				Description: "The minimum number of CPU cores.",
+				Optional:    true,
+				Validators:  sizeValidators,
+			},
+			"cpu_power": schema.Int64Attribute{
+				Description: "The minimum CPU power, 100 being one core of a reference machine.",
+				Optional:    true,
+				Validators:  sizeValidators,
+			},
+			"image_id": schema.StringAttribute{
+				Description: "The image the machines are started from.",
+				Optional:    true,
+			},
+			"instance_role": schema.StringAttribute{
+				Description: "The role of the machines, e.g. an AWS instance profile.",
+				Optional:    true,
+			},
+			"instance_type": schema.StringAttribute{
+				Description: "The instance type of the machines. It conflicts with cores and mem.",
+				Optional:    true,
+			},
+			"mem": schema.Int64Attribute{
+				Description: "The minimum memory, in MiB.",
+				Optional:    true,
+				Validators:  sizeValidators,
+			},
+			"root_disk": schema.Int64Attribute{
+				Description: "The minimum size of the root disk, in MiB.",
+				Optional:    true,
+				Validators:  sizeValidators,
+			},
+			"root_disk_source": schema.StringAttribute{
+				Description: "Where the root disk is created, e.g. a storage pool.",
+				Optional:    true,
+			},
+			"spaces": schema.ListAttribute{
+				Description: "The spaces the machines must, or with a `^` prefix must not, be connected to.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "The tags the machines must, or with a `^` prefix must not, have.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"virt_type": schema.StringAttribute{
+				Description: "The virtualization type of the machines, e.g. `virtual-machine`.",
+				Optional:    true,
+			},
+			"zones": schema.ListAttribute{
+				Description: "The availability zones the machines are started in.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRoot("constraints")),
+		},
+		PlanModifiers: planModifiers,
+	}
+}
+
+// constraintsFromMap returns the constraints of a constraints_map
+// attribute value, parsed as juju does to validate them.
+func constraintsFromMap(ctx context.Context, value types.Object) (constraints.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return constraints.Value{}, diags
+	}
+	var args []string
+	for name, element := range value.Attributes() {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+		key := strings.ReplaceAll(name, "_", "-")
+		switch v := element.(type) {
+		case types.Bool:
+			args = append(args, fmt.Sprintf("%s=%t", key, v.ValueBool()))
+		case types.String:
+			args = append(args, fmt.Sprintf("%s=%s", key, v.ValueString()))
+		case types.Int64:
+			if name == "mem" || name == "root_disk" {
+				args = append(args, fmt.Sprintf("%s=%dM", key, v.ValueInt64()))
+			} else {
+				args = append(args, fmt.Sprintf("%s=%d", key, v.ValueInt64()))
+			}
+		case types.List:
+			var elements []string
+			diags.Append(v.ElementsAs(ctx, &elements, false)...)
+			args = append(args, fmt.Sprintf("%s=%s", key, strings.Join(elements, ",")))
+		}
+	}
+	if diags.HasError() {
+		return constraints.Value{}, diags
+	}
+	sort.Strings(args)
+	parsed, err := constraints.Parse(args...)
+	if err != nil {
+		diags.AddAttributeError(path.Root("constraints_map"), "Invalid Constraints",
+			fmt.Sprintf("Unable to parse constraints, got error: %s", err))
+	}
+	return parsed, diags
+}
+
+// constraintsMapFromValue returns the constraints_map attribute value
+// of the given constraints. Only the attributes set in the prior value
+// are read back, the others are kept null: constraints the controller
+// adds, e.g. the default architecture, do not show as changes.
+func constraintsMapFromValue(ctx context.Context, prior types.Object, value constraints.Value) (types.Object, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior, nil
+	}
+	parsed, diags := newParsedConstraints(ctx, value)
+	if diags.HasError() {
+		return prior, diags
+	}
+	object, dErr := types.ObjectValueFrom(ctx, parsedConstraintsAttributeTypes, parsed)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return prior, diags
+	}
+	attributes := object.Attributes()
+	for name, priorValue := range prior.Attributes() {
+		if priorValue.IsNull() {
+			attributes[name] = priorValue
+		}
+	}
+	result, dErr := types.ObjectValue(parsedConstraintsAttributeTypes, attributes)
+	diags.Append(dErr...)
+	return result, diags
+}
+
+// The policies of the constraints_drift attribute, what happens when
+// the constraints reported by the controller differ from those of the
+// state, e.g. after `juju set-constraints` or by an autoscaler.
+const (
+	// ConstraintsDriftUpdate reads the constraints back from the
+	// controller, the next apply sets the configured constraints.
+	ConstraintsDriftUpdate = "update"
+	// ConstraintsDriftIgnore keeps the constraints of the state.
+	ConstraintsDriftIgnore = "ignore"
+	// ConstraintsDriftError keeps the constraints of the state and
+	// fails the plan.
+	ConstraintsDriftError = "error"
+)
+
+// constraintsDriftAttribute returns the constraints_drift attribute of
+// a resource.
+func constraintsDriftAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("What happens when the constraints reported by the controller differ from the "+
+			"configured ones, e.g. after `juju set-constraints` or by an autoscaler: %q reads them back and the "+
+			"next apply sets the configured constraints, %q keeps the configured constraints in the state and "+
+			"%q fails the plan until the policy is changed. Defaults to %q.",
+			ConstraintsDriftUpdate, ConstraintsDriftIgnore, ConstraintsDriftError, ConstraintsDriftUpdate),
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(ConstraintsDriftUpdate),
+		Validators: []validator.String{
+			stringvalidator.OneOf(ConstraintsDriftUpdate, ConstraintsDriftIgnore, ConstraintsDriftError),
+		},
+	}
+}
+
+// constraintsDriftPrivateKey is the private state key holding the
+// constraints reported by the controller, when they drift and the
+// constraints_drift policy is to fail.
+const constraintsDriftPrivateKey = "constraints_drift"
+
+// privateState is the private state data of a resource.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// keepStateConstraints returns true if the constraints of the state
+// are kept rather than those read from the controller, following the
+// given constraints_drift policy. When the policy is to fail and the
+// constraints differ, the constraints of the controller are recorded
+// in the private state for the plan to fail.
+func keepStateConstraints(ctx context.Context, policy types.String, state, read ConstraintsValue, stateMap, readMap types.Object, private privateState) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch policy.ValueString() {
+	case ConstraintsDriftIgnore:
+		diags.Append(private.SetKey(ctx, constraintsDriftPrivateKey, nil)...)
+		return true, diags
+	case ConstraintsDriftError:
+		drifted := !stateMap.Equal(readMap)
+		if !state.IsNull() {
+			equal, dErr := state.StringSemanticEquals(ctx, read)
+			diags.Append(dErr...)
+			drifted = drifted || !equal
+		}
+		if drifted {
+			value, err := json.Marshal(read.ValueString())
+			if err != nil {
+				diags.AddError("Provider Error", fmt.Sprintf("Unable to record constraints drift, got error: %s", err))
+				return true, diags
+			}
+			diags.Append(private.SetKey(ctx, constraintsDriftPrivateKey, value)...)
+			return true, diags
+		}
+	}
+	diags.Append(private.SetKey(ctx, constraintsDriftPrivateKey, nil)...)
+	return false, diags
+}
+
+// checkConstraintsDrift fails the plan when the constraints drifted on
+// the last refresh and the planned constraints_drift policy is to fail.
+func checkConstraintsDrift(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+	var policy types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("constraints_drift"), &policy)...)
+	if diags.HasError() || policy.ValueString() != ConstraintsDriftError {
+		return diags
+	}
+	value, dErr := req.Private.GetKey(ctx, constraintsDriftPrivateKey)
+	diags.Append(dErr...)
+	if diags.HasError() || len(value) == 0 {
+		return diags
+	}
+	var read string
+	if err := json.Unmarshal(value, &read); err != nil {
+		diags.AddError("Provider Error", fmt.Sprintf("Unable to read constraints drift, got error: %s", err))
+		return diags
+	}
+	diags.AddAttributeError(path.Root("constraints"), "Constraints Drift",
+		fmt.Sprintf("The constraints reported by the controller, %q, differ from the configured constraints. "+
+			"Set constraints_drift to %q to set them back, or to %q to ignore the change.",
+			read, ConstraintsDriftUpdate, ConstraintsDriftIgnore))
+	return diags
+}
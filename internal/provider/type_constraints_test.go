@@ -0,0 +1,190 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/juju/juju/core/constraints"
+)
+
+func TestConstraintsValueStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		old, new string
+		equal    bool
+	}{
+		{"mem=16G cores=4", "cores=4 mem=16384M", true},
+		{"mem=16384", "mem=16G", true},
+		{"root-disk=1T", "root-disk=1048576M", true},
+		{"root-disk=1.5G", "root-disk=1536M", true},
+		{"root-disk=1G", "root-disk=1000M", false},
+		{"arch=arm64 image-id=ubuntu-bf2", "image-id=ubuntu-bf2 arch=arm64", true},
+		{"root-disk-source=volume allocate-public-ip=true", "allocate-public-ip=true root-disk-source=volume", true},
+		{"allocate-public-ip=true", "allocate-public-ip=false", false},
+		{"arch=arm64", "arch=amd64", false},
+		{"zones=a,b", "zones=b,a", true},
+		{"tags=x,^y spaces=s1,^s2", "spaces=^s2,s1 tags=^y,x", true},
+		{"zones=a,b", "zones=a,c", false},
+		{"zones=a,b", "zones=a", false},
+	}
+	for _, test := range tests {
+		oldValue := ConstraintsValue{StringValue: types.StringValue(test.old)}
+		newValue := ConstraintsValue{StringValue: types.StringValue(test.new)}
+		equal, diags := oldValue.StringSemanticEquals(context.Background(), newValue)
+		if diags.HasError() {
+			t.Fatalf("unexpected error comparing %q and %q: %v", test.old, test.new, diags)
+		}
+		if equal != test.equal {
+			t.Errorf("comparing %q and %q: expected %v, got %v", test.old, test.new, test.equal, equal)
+		}
+	}
+}
+
+func TestConstraintsTypeValidate(t *testing.T) {
+	tests := []struct {
+		value string
+		err   string
+		warn  string
+	}{
+		{value: "mem=16G cores=4"},
+		{value: "instance-type=m5.large root-disk=16G"},
+		{value: "arch=arm64 image-id=ubuntu-bf2 root-disk-source=volume allocate-public-ip=true"},
+		{value: "arch=aarch64", err: "Unable to parse constraints"},
+		{value: "cores=-1", err: "Unable to parse constraints"},
+		{value: "mem=-16G", err: "Unable to parse constraints"},
+		{value: "instance-type=m5.large arch=amd64 cpu-power=100"},
+		{value: "instance-type=m5.large mem=16G cores=4", warn: `Constraints "cores, mem" conflict with "instance-type"`},
+	}
+	for _, test := range tests {
+		diags := ConstraintsType{}.Validate(context.Background(), tftypes.NewValue(tftypes.String, test.value), path.Root("constraints"))
+		if test.warn == "" && diags.WarningsCount() > 0 {
+			t.Errorf("unexpected warning validating %q: %v", test.value, diags)
+		}
+		if test.warn != "" && (diags.WarningsCount() == 0 || !strings.Contains(diags.Warnings()[0].Detail(), test.warn)) {
+			t.Errorf("validating %q: expected warning %q, got %v", test.value, test.warn, diags)
+		}
+		if test.err == "" {
+			if diags.HasError() {
+				t.Errorf("unexpected error validating %q: %v", test.value, diags)
+			}
+			continue
+		}
+		if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), test.err) {
+			t.Errorf("validating %q: expected error %q, got %v", test.value, test.err, diags)
+		}
+	}
+}
+
+func TestConstraintsValueUnsupportedOnKubernetes(t *testing.T) {
+	value := ConstraintsValue{StringValue: types.StringValue("mem=1G cores=2 virt-type=kvm")}
+	unsupported := value.UnsupportedOnKubernetes()
+	if strings.Join(unsupported, ",") != "cores,virt-type" {
+		t.Errorf("expected cores and virt-type to be unsupported, got %v", unsupported)
+	}
+	if unsupported := (ConstraintsValue{StringValue: types.StringValue("mem=1G")}).UnsupportedOnKubernetes(); len(unsupported) != 0 {
+		t.Errorf("expected no unsupported constraints, got %v", unsupported)
+	}
+}
+
+func TestConstraintsMap(t *testing.T) {
+	ctx := context.Background()
+	nulls := map[string]attr.Value{}
+	for name, attrType := range parsedConstraintsAttributeTypes {
+		nulls[name] = newNullValue(ctx, t, attrType)
+	}
+	attributes := map[string]attr.Value{}
+	for name, value := range nulls {
+		attributes[name] = value
+	}
+	attributes["mem"] = types.Int64Value(16384)
+	attributes["arch"] = types.StringValue("arm64")
+	attributes["zones"] = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("b"), types.StringValue("a")})
+	attributes["allocate_public_ip"] = types.BoolValue(true)
+	object := types.ObjectValueMust(parsedConstraintsAttributeTypes, attributes)
+
+	value, diags := constraintsFromMap(ctx, object)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := value.String(); got != "arch=arm64 mem=16384M zones=b,a allocate-public-ip=true" {
+		t.Errorf("unexpected constraints %q", got)
+	}
+
+	// Only the attributes set in the prior value are read back.
+	read, diags := constraintsMapFromValue(ctx, object, constraints.MustParse("arch=arm64 mem=32G cores=4 zones=b,a allocate-public-ip=true"))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	readAttributes := read.Attributes()
+	if got := readAttributes["mem"]; !got.Equal(types.Int64Value(32768)) {
+		t.Errorf("expected mem to be read back, got %s", got)
+	}
+	if got := readAttributes["cores"]; !got.IsNull() {
+		t.Errorf("expected cores to be null, got %s", got)
+	}
+}
+
+func newNullValue(ctx context.Context, t *testing.T, attrType attr.Type) attr.Value {
+	value, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return value
+}
+
+// testPrivateState is an in memory privateState.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+	return nil
+}
+
+func TestKeepStateConstraints(t *testing.T) {
+	ctx := context.Background()
+	state := ConstraintsValue{StringValue: types.StringValue("mem=1G")}
+	same := ConstraintsValue{StringValue: types.StringValue("mem=1024M")}
+	drifted := ConstraintsValue{StringValue: types.StringValue("mem=2048M")}
+	nullMap := types.ObjectNull(parsedConstraintsAttributeTypes)
+
+	tests := []struct {
+		policy   string
+		read     ConstraintsValue
+		keep     bool
+		recorded bool
+	}{
+		{policy: ConstraintsDriftUpdate, read: drifted},
+		{policy: ConstraintsDriftIgnore, read: drifted, keep: true},
+		{policy: ConstraintsDriftError, read: same},
+		{policy: ConstraintsDriftError, read: drifted, keep: true, recorded: true},
+	}
+	for _, test := range tests {
+		private := testPrivateState{}
+		keep, diags := keepStateConstraints(ctx, types.StringValue(test.policy), state, test.read, nullMap, nullMap, private)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if keep != test.keep {
+			t.Errorf("policy %q reading %q: expected keep %v, got %v", test.policy, test.read.ValueString(), test.keep, keep)
+		}
+		if _, recorded := private[constraintsDriftPrivateKey]; recorded != test.recorded {
+			t.Errorf("policy %q reading %q: expected recorded %v, got %v", test.policy, test.read.ValueString(), test.recorded, recorded)
+		}
+	}
+}
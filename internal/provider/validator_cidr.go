@@ -0,0 +1,73 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type stringIsCIDRValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v stringIsCIDRValidator) Description(context.Context) string {
+	return "string must be a CIDR, e.g. 10.0.0.0/8"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v stringIsCIDRValidator) MarkdownDescription(context.Context) string {
+	return "string must be a CIDR, e.g. `10.0.0.0/8`"
+}
+
+// Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v stringIsCIDRValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			"String must be a CIDR, e.g. 10.0.0.0/8 or ::/0",
+		)
+		return
+	}
+}
+
+type stringIsCIDRListValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v stringIsCIDRListValidator) Description(context.Context) string {
+	return "string must be a comma separated list of CIDRs, e.g. 10.0.0.0/24,10.0.1.0/24"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v stringIsCIDRListValidator) MarkdownDescription(context.Context) string {
+	return "string must be a comma separated list of CIDRs, e.g. `10.0.0.0/24,10.0.1.0/24`"
+}
+
+// Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v stringIsCIDRListValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, cidr := range strings.Split(req.ConfigValue.ValueString(), ",") {
+		if _, _, err := net.ParseCIDR(strings.TrimSpace(cidr)); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid CIDR",
+				fmt.Sprintf("%q is not a CIDR, the string must be a comma separated list of CIDRs, e.g. 10.0.0.0/24,10.0.1.0/24", cidr),
+			)
+			return
+		}
+	}
+}
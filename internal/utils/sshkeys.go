@@ -3,7 +3,11 @@
 
 package utils
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/juju/utils/v3/ssh"
+)
 
 // GetKeyIdentifierFromSSHKey returns the identifier of the key,
 // which is currently based on the comment field (TODO issue #267)
@@ -19,3 +23,11 @@ func GetKeyIdentifierFromSSHKey(key string) string {
 		return components[2]
 	}
 }
+
+// GetKeyFingerprintFromSSHKey returns the fingerprint of the key,
+// as listed by `juju ssh-keys`. Unlike the comment field, the
+// fingerprint identifies the key itself.
+func GetKeyFingerprintFromSSHKey(key string) (string, error) {
+	fingerprint, _, err := ssh.KeyFingerprint(key)
+	return fingerprint, err
+}